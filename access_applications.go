@@ -0,0 +1,290 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type AccessService service
+
+// AccessApplicationType is the kind of resource an Access application
+// protects.
+type AccessApplicationType string
+
+const (
+	AccessApplicationTypeSelfHosted  AccessApplicationType = "self_hosted"
+	AccessApplicationTypeSaaS        AccessApplicationType = "saas"
+	AccessApplicationTypeSSH         AccessApplicationType = "ssh"
+	AccessApplicationTypeVNC         AccessApplicationType = "vnc"
+	AccessApplicationTypeAppLauncher AccessApplicationType = "app_launcher"
+	AccessApplicationTypeWarp        AccessApplicationType = "warp"
+	AccessApplicationTypeBISO        AccessApplicationType = "biso"
+	AccessApplicationTypeBookmark    AccessApplicationType = "bookmark"
+)
+
+// AccessApplication is an application protected by Cloudflare Access.
+//
+// SessionDuration is how long an authenticated session lasts, written as a
+// Go style duration such as "24h" or "30m".
+type AccessApplication struct {
+	ID                       string                        `json:"id,omitempty"`
+	AUD                      string                        `json:"aud,omitempty"`
+	Name                     string                        `json:"name"`
+	Domain                   string                        `json:"domain,omitempty"`
+	SelfHostedDomains        []string                      `json:"self_hosted_domains,omitempty"`
+	Type                     AccessApplicationType         `json:"type,omitempty"`
+	SessionDuration          string                        `json:"session_duration,omitempty"`
+	AllowedIdPs              []string                      `json:"allowed_idps,omitempty"`
+	AutoRedirectToIdentity   *bool                         `json:"auto_redirect_to_identity,omitempty"`
+	AppLauncherVisible       *bool                         `json:"app_launcher_visible,omitempty"`
+	SkipInterstitial         *bool                         `json:"skip_interstitial,omitempty"`
+	EnableBindingCookie      *bool                         `json:"enable_binding_cookie,omitempty"`
+	HTTPOnlyCookieAttribute  *bool                         `json:"http_only_cookie_attribute,omitempty"`
+	PathCookieAttribute      *bool                         `json:"path_cookie_attribute,omitempty"`
+	SameSiteCookieAttribute  string                        `json:"same_site_cookie_attribute,omitempty"`
+	ServiceAuth401Redirect   *bool                         `json:"service_auth_401_redirect,omitempty"`
+	CustomDenyMessage        string                        `json:"custom_deny_message,omitempty"`
+	CustomDenyURL            string                        `json:"custom_deny_url,omitempty"`
+	CustomNonIdentityDenyURL string                        `json:"custom_non_identity_deny_url,omitempty"`
+	LogoURL                  string                        `json:"logo_url,omitempty"`
+	Tags                     []string                      `json:"tags,omitempty"`
+	CORSHeaders              *AccessApplicationCORSHeaders `json:"cors_headers,omitempty"`
+	SaaSApplication          *AccessSaaSApplication        `json:"saas_app,omitempty"`
+	Policies                 []AccessPolicyReference       `json:"policies,omitempty"`
+	CreatedAt                *time.Time                    `json:"created_at,omitempty"`
+	UpdatedAt                *time.Time                    `json:"updated_at,omitempty"`
+}
+
+// AccessApplicationCORSHeaders controls the CORS preflight responses Access
+// sends on behalf of an application.
+type AccessApplicationCORSHeaders struct {
+	AllowedMethods   []string `json:"allowed_methods,omitempty"`
+	AllowedOrigins   []string `json:"allowed_origins,omitempty"`
+	AllowedHeaders   []string `json:"allowed_headers,omitempty"`
+	AllowAllMethods  bool     `json:"allow_all_methods,omitempty"`
+	AllowAllHeaders  bool     `json:"allow_all_headers,omitempty"`
+	AllowAllOrigins  bool     `json:"allow_all_origins,omitempty"`
+	AllowCredentials bool     `json:"allow_credentials,omitempty"`
+	MaxAge           int      `json:"max_age,omitempty"`
+}
+
+// AccessSaaSApplication configures single sign-on into a SaaS application
+// over SAML or OIDC.
+type AccessSaaSApplication struct {
+	AuthType           string                      `json:"auth_type,omitempty"`
+	AppLauncherURL     string                      `json:"app_launcher_url,omitempty"`
+	ConsumerServiceURL string                      `json:"consumer_service_url,omitempty"`
+	SPEntityID         string                      `json:"sp_entity_id,omitempty"`
+	IDPEntityID        string                      `json:"idp_entity_id,omitempty"`
+	PublicKey          string                      `json:"public_key,omitempty"`
+	NameIDFormat       string                      `json:"name_id_format,omitempty"`
+	SSOEndpoint        string                      `json:"sso_endpoint,omitempty"`
+	CustomAttributes   []AccessSAMLAttributeConfig `json:"custom_attributes,omitempty"`
+	ClientID           string                      `json:"client_id,omitempty"`
+	ClientSecret       string                      `json:"client_secret,omitempty"`
+	RedirectURIs       []string                    `json:"redirect_uris,omitempty"`
+	GrantTypes         []string                    `json:"grant_types,omitempty"`
+	Scopes             []string                    `json:"scopes,omitempty"`
+	GroupFilterRegex   string                      `json:"group_filter_regex,omitempty"`
+}
+
+// AccessSAMLAttributeConfig maps an identity provider attribute onto a SAML
+// attribute sent to a SaaS application.
+type AccessSAMLAttributeConfig struct {
+	Name       string                    `json:"name,omitempty"`
+	NameFormat string                    `json:"name_format,omitempty"`
+	Source     AccessSAMLAttributeSource `json:"source"`
+}
+
+// AccessSAMLAttributeSource is the identity provider attribute a SAML
+// attribute is read from.
+type AccessSAMLAttributeSource struct {
+	Name string `json:"name,omitempty"`
+}
+
+// AccessApplicationResponse represents the response from the Access
+// applications endpoint containing a single application.
+type AccessApplicationResponse struct {
+	Response
+	Result AccessApplication `json:"result"`
+}
+
+// AccessApplicationsResponse represents the response from the Access
+// applications endpoint containing multiple applications.
+type AccessApplicationsResponse struct {
+	Response
+	Result     []AccessApplication `json:"result"`
+	ResultInfo ResultInfo          `json:"result_info"`
+}
+
+// AccessApplicationListParams contains the options available when listing
+// Access applications.
+type AccessApplicationListParams struct {
+	PaginationParams
+}
+
+// ListApplications returns the Access applications of an account or zone.
+//
+// API reference: https://api.cloudflare.com/#access-applications-list-access-applications
+func (s *AccessService) ListApplications(ctx context.Context, rc *ResourceContainer, params AccessApplicationListParams) ([]AccessApplication, ResultInfo, error) {
+	var applications []AccessApplication
+	var info ResultInfo
+	err := s.ForEachApplicationsPage(ctx, rc, params, func(page []AccessApplication, pageInfo ResultInfo) bool {
+		applications = append(applications, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessApplication{}, ResultInfo{}, err
+	}
+
+	return applications, info, nil
+}
+
+// ForEachApplicationsPage is like ListApplications but passes each page of
+// results to fn as it is fetched instead of collecting them. Paging stops
+// early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#access-applications-list-access-applications
+func (s *AccessService) ForEachApplicationsPage(ctx context.Context, rc *ResourceContainer, params AccessApplicationListParams, fn func(items []AccessApplication, info ResultInfo) bool) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	return s.client.listPages(ctx, rc.URLFragment()+"/access/apps", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessApplicationsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access application JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetApplication fetches a single Access application.
+//
+// API reference: https://api.cloudflare.com/#access-applications-get-an-access-application
+func (s *AccessService) GetApplication(ctx context.Context, rc *ResourceContainer, applicationID string) (AccessApplication, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessApplication{}, err
+	}
+
+	if applicationID == "" {
+		return AccessApplication{}, fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/access/apps/"+applicationID, nil)
+	if err != nil {
+		return AccessApplication{}, err
+	}
+
+	var r AccessApplicationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessApplication{}, fmt.Errorf("failed to unmarshal access application JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateApplication creates a new Access application.
+//
+// API reference: https://api.cloudflare.com/#access-applications-add-an-application
+func (s *AccessService) CreateApplication(ctx context.Context, rc *ResourceContainer, app AccessApplication) (AccessApplication, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessApplication{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/apps", app)
+	if err != nil {
+		return AccessApplication{}, err
+	}
+
+	var r AccessApplicationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessApplication{}, fmt.Errorf("failed to unmarshal access application JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateApplication replaces the configuration of an existing Access
+// application.
+//
+// API reference: https://api.cloudflare.com/#access-applications-update-an-access-application
+func (s *AccessService) UpdateApplication(ctx context.Context, rc *ResourceContainer, applicationID string, app AccessApplication) (AccessApplication, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessApplication{}, err
+	}
+
+	if applicationID == "" {
+		return AccessApplication{}, fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/access/apps/"+applicationID, app)
+	if err != nil {
+		return AccessApplication{}, err
+	}
+
+	var r AccessApplicationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessApplication{}, fmt.Errorf("failed to unmarshal access application JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteApplication removes an Access application.
+//
+// API reference: https://api.cloudflare.com/#access-applications-delete-an-access-application
+func (s *AccessService) DeleteApplication(ctx context.Context, rc *ResourceContainer, applicationID string) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if applicationID == "" {
+		return fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/access/apps/"+applicationID, nil)
+	return err
+}
+
+// RevokeApplicationTokens revokes every session token issued for an Access
+// application, forcing users to authenticate again.
+//
+// API reference: https://api.cloudflare.com/#access-applications-revoke-service-tokens
+func (s *AccessService) RevokeApplicationTokens(ctx context.Context, rc *ResourceContainer, applicationID string) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if applicationID == "" {
+		return fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/apps/"+applicationID+"/revoke_tokens", nil)
+	return err
+}
+
+// validateAccessContainer ensures Access resources are only requested for
+// accounts or zones.
+func validateAccessContainer(rc *ResourceContainer) error {
+	if err := rc.validate(); err != nil {
+		return err
+	}
+
+	if rc.Level == UserRouteType {
+		return errors.New("access resources must belong to an account or zone")
+	}
+
+	return nil
+}
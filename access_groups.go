@@ -0,0 +1,174 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AccessGroup is a named set of rules that policies can match with
+// AccessPolicyGroup. A user belongs to the group when they satisfy any
+// Include rule, every Require rule and no Exclude rule.
+type AccessGroup struct {
+	ID        string             `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	Include   []AccessPolicyRule `json:"include"`
+	Exclude   []AccessPolicyRule `json:"exclude"`
+	Require   []AccessPolicyRule `json:"require"`
+	IsDefault bool               `json:"is_default,omitempty"`
+	CreatedAt *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// AccessGroupResponse represents the response from the Access groups
+// endpoint containing a single group.
+type AccessGroupResponse struct {
+	Response
+	Result AccessGroup `json:"result"`
+}
+
+// AccessGroupsResponse represents the response from the Access groups
+// endpoint containing multiple groups.
+type AccessGroupsResponse struct {
+	Response
+	Result     []AccessGroup `json:"result"`
+	ResultInfo ResultInfo    `json:"result_info"`
+}
+
+// AccessGroupListParams contains the options available when listing Access
+// groups.
+type AccessGroupListParams struct {
+	PaginationParams
+}
+
+// ListGroups returns the Access groups of an account or zone.
+//
+// API reference: https://api.cloudflare.com/#access-groups-list-access-groups
+func (s *AccessService) ListGroups(ctx context.Context, rc *ResourceContainer, params AccessGroupListParams) ([]AccessGroup, ResultInfo, error) {
+	var groups []AccessGroup
+	var info ResultInfo
+	err := s.ForEachGroupsPage(ctx, rc, params, func(page []AccessGroup, pageInfo ResultInfo) bool {
+		groups = append(groups, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessGroup{}, ResultInfo{}, err
+	}
+
+	return groups, info, nil
+}
+
+// ForEachGroupsPage is like ListGroups but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#access-groups-list-access-groups
+func (s *AccessService) ForEachGroupsPage(ctx context.Context, rc *ResourceContainer, params AccessGroupListParams, fn func(items []AccessGroup, info ResultInfo) bool) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	return s.client.listPages(ctx, rc.URLFragment()+"/access/groups", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessGroupsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access group JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetGroup fetches a single Access group.
+//
+// API reference: https://api.cloudflare.com/#access-groups-get-an-access-group
+func (s *AccessService) GetGroup(ctx context.Context, rc *ResourceContainer, groupID string) (AccessGroup, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessGroup{}, err
+	}
+
+	if groupID == "" {
+		return AccessGroup{}, fmt.Errorf(errMissingResourceID, "access group")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/access/groups/"+groupID, nil)
+	if err != nil {
+		return AccessGroup{}, err
+	}
+
+	var r AccessGroupResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessGroup{}, fmt.Errorf("failed to unmarshal access group JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateGroup creates a new Access group.
+//
+// API reference: https://api.cloudflare.com/#access-groups-create-an-access-group
+func (s *AccessService) CreateGroup(ctx context.Context, rc *ResourceContainer, group AccessGroup) (AccessGroup, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessGroup{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/groups", group)
+	if err != nil {
+		return AccessGroup{}, err
+	}
+
+	var r AccessGroupResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessGroup{}, fmt.Errorf("failed to unmarshal access group JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateGroup replaces the rules of an existing Access group.
+//
+// API reference: https://api.cloudflare.com/#access-groups-update-an-access-group
+func (s *AccessService) UpdateGroup(ctx context.Context, rc *ResourceContainer, groupID string, group AccessGroup) (AccessGroup, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessGroup{}, err
+	}
+
+	if groupID == "" {
+		return AccessGroup{}, fmt.Errorf(errMissingResourceID, "access group")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/access/groups/"+groupID, group)
+	if err != nil {
+		return AccessGroup{}, err
+	}
+
+	var r AccessGroupResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessGroup{}, fmt.Errorf("failed to unmarshal access group JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteGroup removes an Access group.
+//
+// API reference: https://api.cloudflare.com/#access-groups-delete-an-access-group
+func (s *AccessService) DeleteGroup(ctx context.Context, rc *ResourceContainer, groupID string) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if groupID == "" {
+		return fmt.Errorf(errMissingResourceID, "access group")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/access/groups/"+groupID, nil)
+	return err
+}
@@ -0,0 +1,252 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AccessIdentityProviderType is the kind of identity provider users log in
+// with.
+type AccessIdentityProviderType string
+
+const (
+	AccessIdentityProviderAzureAD    AccessIdentityProviderType = "azureAD"
+	AccessIdentityProviderOkta       AccessIdentityProviderType = "okta"
+	AccessIdentityProviderGoogle     AccessIdentityProviderType = "google"
+	AccessIdentityProviderGoogleApps AccessIdentityProviderType = "google-apps"
+	AccessIdentityProviderGitHub     AccessIdentityProviderType = "github"
+	AccessIdentityProviderOIDC       AccessIdentityProviderType = "oidc"
+	AccessIdentityProviderSAML       AccessIdentityProviderType = "saml"
+	AccessIdentityProviderOneLogin   AccessIdentityProviderType = "onelogin"
+	AccessIdentityProviderPingOne    AccessIdentityProviderType = "pingone"
+	AccessIdentityProviderCentrify   AccessIdentityProviderType = "centrify"
+	AccessIdentityProviderOneTimePin AccessIdentityProviderType = "onetimepin"
+)
+
+// AccessIdentityProvider is an identity provider users authenticate with.
+type AccessIdentityProvider struct {
+	ID         string                            `json:"id,omitempty"`
+	Name       string                            `json:"name"`
+	Type       AccessIdentityProviderType        `json:"type"`
+	Config     AccessIdentityProviderConfig      `json:"config"`
+	ScimConfig *AccessIdentityProviderScimConfig `json:"scim_config,omitempty"`
+}
+
+// AccessIdentityProviderConfig holds the provider specific settings of an
+// identity provider. Only the fields relevant to the provider's type should
+// be set.
+type AccessIdentityProviderConfig struct {
+	// Shared by the OAuth based providers.
+	ClientID       string   `json:"client_id,omitempty"`
+	ClientSecret   string   `json:"client_secret,omitempty"`
+	Claims         []string `json:"claims,omitempty"`
+	EmailClaimName string   `json:"email_claim_name,omitempty"`
+
+	// Azure AD.
+	DirectoryID              string `json:"directory_id,omitempty"`
+	SupportGroups            bool   `json:"support_groups,omitempty"`
+	ConditionalAccessEnabled bool   `json:"conditional_access_enabled,omitempty"`
+	Prompt                   string `json:"prompt,omitempty"`
+
+	// Okta.
+	OktaAccount           string `json:"okta_account,omitempty"`
+	AuthorizationServerID string `json:"authorization_server_id,omitempty"`
+
+	// Google Workspace.
+	AppsDomain string `json:"apps_domain,omitempty"`
+
+	// Generic OIDC.
+	AuthURL     string   `json:"auth_url,omitempty"`
+	TokenURL    string   `json:"token_url,omitempty"`
+	CertsURL    string   `json:"certs_url,omitempty"`
+	Scopes      []string `json:"scopes,omitempty"`
+	PKCEEnabled *bool    `json:"pkce_enabled,omitempty"`
+
+	// Generic SAML.
+	IssuerURL          string                                  `json:"issuer_url,omitempty"`
+	SSOTargetURL       string                                  `json:"sso_target_url,omitempty"`
+	IDPPublicCerts     []string                                `json:"idp_public_certs,omitempty"`
+	Attributes         []string                                `json:"attributes,omitempty"`
+	EmailAttributeName string                                  `json:"email_attribute_name,omitempty"`
+	SignRequest        bool                                    `json:"sign_request,omitempty"`
+	HeaderAttributes   []AccessIdentityProviderHeaderAttribute `json:"header_attributes,omitempty"`
+
+	// OneLogin, PingOne and Centrify.
+	OneLoginAccount string `json:"onelogin_account,omitempty"`
+	PingEnvID       string `json:"ping_env_id,omitempty"`
+	CentrifyAccount string `json:"centrify_account,omitempty"`
+	CentrifyAppID   string `json:"centrify_app_id,omitempty"`
+}
+
+// AccessIdentityProviderHeaderAttribute forwards a SAML attribute to the
+// origin as a request header.
+type AccessIdentityProviderHeaderAttribute struct {
+	AttributeName string `json:"attribute_name"`
+	HeaderName    string `json:"header_name"`
+}
+
+// AccessIdentityProviderScimConfig controls SCIM provisioning of users and
+// groups from an identity provider.
+type AccessIdentityProviderScimConfig struct {
+	Enabled                bool   `json:"enabled"`
+	Secret                 string `json:"secret,omitempty"`
+	UserDeprovision        bool   `json:"user_deprovision"`
+	SeatDeprovision        bool   `json:"seat_deprovision"`
+	GroupMemberDeprovision bool   `json:"group_member_deprovision"`
+	IdentityUpdateBehavior string `json:"identity_update_behavior,omitempty"`
+}
+
+// AccessIdentityProviderResponse represents the response from the identity
+// providers endpoint containing a single provider.
+type AccessIdentityProviderResponse struct {
+	Response
+	Result AccessIdentityProvider `json:"result"`
+}
+
+// AccessIdentityProvidersResponse represents the response from the identity
+// providers endpoint containing multiple providers.
+type AccessIdentityProvidersResponse struct {
+	Response
+	Result     []AccessIdentityProvider `json:"result"`
+	ResultInfo ResultInfo               `json:"result_info"`
+}
+
+// AccessIdentityProviderListParams contains the options available when
+// listing identity providers.
+type AccessIdentityProviderListParams struct {
+	PaginationParams
+}
+
+// ListIdentityProviders returns the identity providers of an account or
+// zone.
+//
+// API reference: https://api.cloudflare.com/#access-identity-providers-list-access-identity-providers
+func (s *AccessService) ListIdentityProviders(ctx context.Context, rc *ResourceContainer, params AccessIdentityProviderListParams) ([]AccessIdentityProvider, ResultInfo, error) {
+	var providers []AccessIdentityProvider
+	var info ResultInfo
+	err := s.ForEachIdentityProvidersPage(ctx, rc, params, func(page []AccessIdentityProvider, pageInfo ResultInfo) bool {
+		providers = append(providers, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessIdentityProvider{}, ResultInfo{}, err
+	}
+
+	return providers, info, nil
+}
+
+// ForEachIdentityProvidersPage is like ListIdentityProviders but passes each
+// page of results to fn as it is fetched instead of collecting them. Paging
+// stops early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#access-identity-providers-list-access-identity-providers
+func (s *AccessService) ForEachIdentityProvidersPage(ctx context.Context, rc *ResourceContainer, params AccessIdentityProviderListParams, fn func(items []AccessIdentityProvider, info ResultInfo) bool) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	return s.client.listPages(ctx, rc.URLFragment()+"/access/identity_providers", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessIdentityProvidersResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access identity provider JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetIdentityProvider fetches a single identity provider.
+//
+// API reference: https://api.cloudflare.com/#access-identity-providers-get-an-access-identity-provider
+func (s *AccessService) GetIdentityProvider(ctx context.Context, rc *ResourceContainer, identityProviderID string) (AccessIdentityProvider, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessIdentityProvider{}, err
+	}
+
+	if identityProviderID == "" {
+		return AccessIdentityProvider{}, fmt.Errorf(errMissingResourceID, "identity provider")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/access/identity_providers/"+identityProviderID, nil)
+	if err != nil {
+		return AccessIdentityProvider{}, err
+	}
+
+	var r AccessIdentityProviderResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessIdentityProvider{}, fmt.Errorf("failed to unmarshal access identity provider JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateIdentityProvider adds a new identity provider.
+//
+// API reference: https://api.cloudflare.com/#access-identity-providers-add-an-access-identity-provider
+func (s *AccessService) CreateIdentityProvider(ctx context.Context, rc *ResourceContainer, provider AccessIdentityProvider) (AccessIdentityProvider, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessIdentityProvider{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/identity_providers", provider)
+	if err != nil {
+		return AccessIdentityProvider{}, err
+	}
+
+	var r AccessIdentityProviderResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessIdentityProvider{}, fmt.Errorf("failed to unmarshal access identity provider JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateIdentityProvider replaces the configuration of an identity
+// provider.
+//
+// API reference: https://api.cloudflare.com/#access-identity-providers-update-an-access-identity-provider
+func (s *AccessService) UpdateIdentityProvider(ctx context.Context, rc *ResourceContainer, identityProviderID string, provider AccessIdentityProvider) (AccessIdentityProvider, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessIdentityProvider{}, err
+	}
+
+	if identityProviderID == "" {
+		return AccessIdentityProvider{}, fmt.Errorf(errMissingResourceID, "identity provider")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/access/identity_providers/"+identityProviderID, provider)
+	if err != nil {
+		return AccessIdentityProvider{}, err
+	}
+
+	var r AccessIdentityProviderResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessIdentityProvider{}, fmt.Errorf("failed to unmarshal access identity provider JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteIdentityProvider removes an identity provider.
+//
+// API reference: https://api.cloudflare.com/#access-identity-providers-delete-an-access-identity-provider
+func (s *AccessService) DeleteIdentityProvider(ctx context.Context, rc *ResourceContainer, identityProviderID string) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if identityProviderID == "" {
+		return fmt.Errorf(errMissingResourceID, "identity provider")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/access/identity_providers/"+identityProviderID, nil)
+	return err
+}
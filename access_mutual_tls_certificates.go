@@ -0,0 +1,262 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AccessMutualTLSCertificate is a CA certificate used to validate client
+// certificates presented to Access.
+type AccessMutualTLSCertificate struct {
+	ID                  string     `json:"id,omitempty"`
+	Name                string     `json:"name"`
+	Fingerprint         string     `json:"fingerprint,omitempty"`
+	AssociatedHostnames []string   `json:"associated_hostnames"`
+	ExpiresOn           *time.Time `json:"expires_on,omitempty"`
+	CreatedAt           *time.Time `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time `json:"updated_at,omitempty"`
+}
+
+// AccessMutualTLSHostnameSettings controls certificate based authentication
+// for a single hostname.
+type AccessMutualTLSHostnameSettings struct {
+	Hostname                    string `json:"hostname"`
+	ChinaNetwork                bool   `json:"china_network"`
+	ClientCertificateForwarding bool   `json:"client_certificate_forwarding"`
+}
+
+// AccessMutualTLSCertificateResponse represents the response from the Access
+// certificates endpoint containing a single certificate.
+type AccessMutualTLSCertificateResponse struct {
+	Response
+	Result AccessMutualTLSCertificate `json:"result"`
+}
+
+// AccessMutualTLSCertificatesResponse represents the response from the
+// Access certificates endpoint containing multiple certificates.
+type AccessMutualTLSCertificatesResponse struct {
+	Response
+	Result     []AccessMutualTLSCertificate `json:"result"`
+	ResultInfo ResultInfo                   `json:"result_info"`
+}
+
+// AccessMutualTLSHostnameSettingsResponse represents the response from the
+// Access certificate settings endpoint.
+type AccessMutualTLSHostnameSettingsResponse struct {
+	Response
+	Result []AccessMutualTLSHostnameSettings `json:"result"`
+}
+
+// AccessMutualTLSCertificateListParams contains the options available when
+// listing Access certificates.
+type AccessMutualTLSCertificateListParams struct {
+	PaginationParams
+}
+
+// AccessMutualTLSCertificateCreateParams contains the details needed to
+// upload a CA certificate. Certificate is PEM encoded.
+type AccessMutualTLSCertificateCreateParams struct {
+	Name                string   `json:"name"`
+	Certificate         string   `json:"certificate"`
+	AssociatedHostnames []string `json:"associated_hostnames,omitempty"`
+}
+
+// AccessMutualTLSCertificateUpdateParams contains the fields that can be
+// changed on an uploaded CA certificate.
+type AccessMutualTLSCertificateUpdateParams struct {
+	Name                string   `json:"name,omitempty"`
+	AssociatedHostnames []string `json:"associated_hostnames"`
+}
+
+// accessMutualTLSHostnameSettingsParams is the request body used to update
+// hostname settings.
+type accessMutualTLSHostnameSettingsParams struct {
+	Settings []AccessMutualTLSHostnameSettings `json:"settings"`
+}
+
+// ListMutualTLSCertificates returns the CA certificates uploaded to an
+// account or zone.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-list-mtls-certificates
+func (s *AccessService) ListMutualTLSCertificates(ctx context.Context, rc *ResourceContainer, params AccessMutualTLSCertificateListParams) ([]AccessMutualTLSCertificate, ResultInfo, error) {
+	var certificates []AccessMutualTLSCertificate
+	var info ResultInfo
+	err := s.ForEachMutualTLSCertificatesPage(ctx, rc, params, func(page []AccessMutualTLSCertificate, pageInfo ResultInfo) bool {
+		certificates = append(certificates, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessMutualTLSCertificate{}, ResultInfo{}, err
+	}
+
+	return certificates, info, nil
+}
+
+// ForEachMutualTLSCertificatesPage is like ListMutualTLSCertificates but
+// passes each page of results to fn as it is fetched instead of collecting
+// them. Paging stops early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-list-mtls-certificates
+func (s *AccessService) ForEachMutualTLSCertificatesPage(ctx context.Context, rc *ResourceContainer, params AccessMutualTLSCertificateListParams, fn func(items []AccessMutualTLSCertificate, info ResultInfo) bool) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	return s.client.listPages(ctx, rc.URLFragment()+"/access/certificates", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessMutualTLSCertificatesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access mutual tls certificate JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetMutualTLSCertificate fetches a single CA certificate.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-get-an-mtls-certificate
+func (s *AccessService) GetMutualTLSCertificate(ctx context.Context, rc *ResourceContainer, certificateID string) (AccessMutualTLSCertificate, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessMutualTLSCertificate{}, err
+	}
+
+	if certificateID == "" {
+		return AccessMutualTLSCertificate{}, fmt.Errorf(errMissingResourceID, "access certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/access/certificates/"+certificateID, nil)
+	if err != nil {
+		return AccessMutualTLSCertificate{}, err
+	}
+
+	var r AccessMutualTLSCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessMutualTLSCertificate{}, fmt.Errorf("failed to unmarshal access mutual tls certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateMutualTLSCertificate uploads a CA certificate used to validate
+// client certificates for the associated hostnames.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-add-an-mtls-certificate
+func (s *AccessService) CreateMutualTLSCertificate(ctx context.Context, rc *ResourceContainer, params AccessMutualTLSCertificateCreateParams) (AccessMutualTLSCertificate, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessMutualTLSCertificate{}, err
+	}
+
+	if params.Name == "" || params.Certificate == "" {
+		return AccessMutualTLSCertificate{}, errors.New("name and certificate are required to upload an mTLS certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/certificates", params)
+	if err != nil {
+		return AccessMutualTLSCertificate{}, err
+	}
+
+	var r AccessMutualTLSCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessMutualTLSCertificate{}, fmt.Errorf("failed to unmarshal access mutual tls certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateMutualTLSCertificate renames a CA certificate or changes the
+// hostnames it applies to.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-update-an-mtls-certificate
+func (s *AccessService) UpdateMutualTLSCertificate(ctx context.Context, rc *ResourceContainer, certificateID string, params AccessMutualTLSCertificateUpdateParams) (AccessMutualTLSCertificate, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessMutualTLSCertificate{}, err
+	}
+
+	if certificateID == "" {
+		return AccessMutualTLSCertificate{}, fmt.Errorf(errMissingResourceID, "access certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/access/certificates/"+certificateID, params)
+	if err != nil {
+		return AccessMutualTLSCertificate{}, err
+	}
+
+	var r AccessMutualTLSCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessMutualTLSCertificate{}, fmt.Errorf("failed to unmarshal access mutual tls certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteMutualTLSCertificate removes a CA certificate. Certificates must
+// have no associated hostnames before they can be deleted.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-delete-an-mtls-certificate
+func (s *AccessService) DeleteMutualTLSCertificate(ctx context.Context, rc *ResourceContainer, certificateID string) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if certificateID == "" {
+		return fmt.Errorf(errMissingResourceID, "access certificate")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/access/certificates/"+certificateID, nil)
+	return err
+}
+
+// GetMutualTLSHostnameSettings returns the mTLS settings of every hostname.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-list-mtls-certificates-hostname-settings
+func (s *AccessService) GetMutualTLSHostnameSettings(ctx context.Context, rc *ResourceContainer) ([]AccessMutualTLSHostnameSettings, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return []AccessMutualTLSHostnameSettings{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/access/certificates"+"/settings", nil)
+	if err != nil {
+		return []AccessMutualTLSHostnameSettings{}, err
+	}
+
+	var r AccessMutualTLSHostnameSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []AccessMutualTLSHostnameSettings{}, fmt.Errorf("failed to unmarshal access mutual tls hostname settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateMutualTLSHostnameSettings replaces the mTLS settings of every
+// hostname.
+//
+// API reference: https://api.cloudflare.com/#access-mtls-authentication-update-an-mtls-certificate-settings
+func (s *AccessService) UpdateMutualTLSHostnameSettings(ctx context.Context, rc *ResourceContainer, settings []AccessMutualTLSHostnameSettings) ([]AccessMutualTLSHostnameSettings, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return []AccessMutualTLSHostnameSettings{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/access/certificates"+"/settings", accessMutualTLSHostnameSettingsParams{Settings: settings})
+	if err != nil {
+		return []AccessMutualTLSHostnameSettings{}, err
+	}
+
+	var r AccessMutualTLSHostnameSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []AccessMutualTLSHostnameSettings{}, fmt.Errorf("failed to unmarshal access mutual tls hostname settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
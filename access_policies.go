@@ -0,0 +1,612 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AccessPolicyDecision is the action taken when a policy matches.
+type AccessPolicyDecision string
+
+const (
+	AccessPolicyDecisionAllow       AccessPolicyDecision = "allow"
+	AccessPolicyDecisionDeny        AccessPolicyDecision = "deny"
+	AccessPolicyDecisionBypass      AccessPolicyDecision = "bypass"
+	AccessPolicyDecisionNonIdentity AccessPolicyDecision = "non_identity"
+)
+
+// AccessPolicy decides who can reach an Access application. A request
+// matches when it satisfies any Include rule, every Require rule and no
+// Exclude rule.
+type AccessPolicy struct {
+	ID                           string                `json:"id,omitempty"`
+	Name                         string                `json:"name"`
+	Decision                     AccessPolicyDecision  `json:"decision"`
+	Precedence                   int                   `json:"precedence,omitempty"`
+	Include                      []AccessPolicyRule    `json:"include"`
+	Exclude                      []AccessPolicyRule    `json:"exclude"`
+	Require                      []AccessPolicyRule    `json:"require"`
+	SessionDuration              string                `json:"session_duration,omitempty"`
+	IsolationRequired            *bool                 `json:"isolation_required,omitempty"`
+	PurposeJustificationRequired *bool                 `json:"purpose_justification_required,omitempty"`
+	PurposeJustificationPrompt   string                `json:"purpose_justification_prompt,omitempty"`
+	ApprovalRequired             *bool                 `json:"approval_required,omitempty"`
+	ApprovalGroups               []AccessApprovalGroup `json:"approval_groups,omitempty"`
+	Reusable                     bool                  `json:"reusable,omitempty"`
+	CreatedAt                    *time.Time            `json:"created_at,omitempty"`
+	UpdatedAt                    *time.Time            `json:"updated_at,omitempty"`
+}
+
+// AccessApprovalGroup is a set of approvers for access requests.
+type AccessApprovalGroup struct {
+	EmailListUUID   string   `json:"email_list_uuid,omitempty"`
+	EmailAddresses  []string `json:"email_addresses,omitempty"`
+	ApprovalsNeeded int      `json:"approvals_needed,omitempty"`
+}
+
+// AccessPolicyReference attaches a reusable policy to an application.
+type AccessPolicyReference struct {
+	ID         string `json:"id"`
+	Precedence int    `json:"precedence,omitempty"`
+}
+
+// AccessPolicyRule is a single condition of an Access policy or group.
+// Exactly one field is set; the AccessPolicy* builder functions construct
+// each kind of rule.
+type AccessPolicyRule struct {
+	Email                *AccessPolicyRuleEmail         `json:"email,omitempty"`
+	EmailDomain          *AccessPolicyRuleEmailDomain   `json:"email_domain,omitempty"`
+	EmailList            *AccessPolicyRuleID            `json:"email_list,omitempty"`
+	Everyone             *AccessPolicyRuleEmpty         `json:"everyone,omitempty"`
+	IP                   *AccessPolicyRuleIP            `json:"ip,omitempty"`
+	IPList               *AccessPolicyRuleID            `json:"ip_list,omitempty"`
+	Geo                  *AccessPolicyRuleGeo           `json:"geo,omitempty"`
+	Group                *AccessPolicyRuleID            `json:"group,omitempty"`
+	ServiceToken         *AccessPolicyRuleServiceToken  `json:"service_token,omitempty"`
+	AnyValidServiceToken *AccessPolicyRuleEmpty         `json:"any_valid_service_token,omitempty"`
+	Certificate          *AccessPolicyRuleEmpty         `json:"certificate,omitempty"`
+	CommonName           *AccessPolicyRuleCommonName    `json:"common_name,omitempty"`
+	LoginMethod          *AccessPolicyRuleID            `json:"login_method,omitempty"`
+	AuthMethod           *AccessPolicyRuleAuthMethod    `json:"auth_method,omitempty"`
+	DevicePosture        *AccessPolicyRuleDevicePosture `json:"device_posture,omitempty"`
+	AzureAD              *AccessPolicyRuleAzureAD       `json:"azureAD,omitempty"`
+	Okta                 *AccessPolicyRuleOkta          `json:"okta,omitempty"`
+	GSuite               *AccessPolicyRuleGSuite        `json:"gsuite,omitempty"`
+	GitHubOrganization   *AccessPolicyRuleGitHub        `json:"github-organization,omitempty"`
+	SAML                 *AccessPolicyRuleSAML          `json:"saml,omitempty"`
+	OIDC                 *AccessPolicyRuleOIDC          `json:"oidc,omitempty"`
+}
+
+// AccessPolicyRuleEmpty is the body of rules that take no options.
+type AccessPolicyRuleEmpty struct{}
+
+// AccessPolicyRuleID matches a list, group or login method by ID.
+type AccessPolicyRuleID struct {
+	ID string `json:"id"`
+}
+
+// AccessPolicyRuleEmail matches a single email address.
+type AccessPolicyRuleEmail struct {
+	Email string `json:"email"`
+}
+
+// AccessPolicyRuleEmailDomain matches every email address of a domain.
+type AccessPolicyRuleEmailDomain struct {
+	Domain string `json:"domain"`
+}
+
+// AccessPolicyRuleIP matches an IP address or CIDR range.
+type AccessPolicyRuleIP struct {
+	IP string `json:"ip"`
+}
+
+// AccessPolicyRuleGeo matches requests from a country.
+type AccessPolicyRuleGeo struct {
+	CountryCode string `json:"country_code"`
+}
+
+// AccessPolicyRuleServiceToken matches a single service token.
+type AccessPolicyRuleServiceToken struct {
+	TokenID string `json:"token_id"`
+}
+
+// AccessPolicyRuleCommonName matches the common name of a client
+// certificate.
+type AccessPolicyRuleCommonName struct {
+	CommonName string `json:"common_name"`
+}
+
+// AccessPolicyRuleAuthMethod matches the AMR method used to log in, such as
+// "mfa".
+type AccessPolicyRuleAuthMethod struct {
+	AuthMethod string `json:"auth_method"`
+}
+
+// AccessPolicyRuleDevicePosture matches devices passing a posture check.
+type AccessPolicyRuleDevicePosture struct {
+	IntegrationUID string `json:"integration_uid"`
+}
+
+// AccessPolicyRuleAzureAD matches members of an Azure AD group.
+type AccessPolicyRuleAzureAD struct {
+	ID                 string `json:"id"`
+	IdentityProviderID string `json:"identity_provider_id"`
+}
+
+// AccessPolicyRuleOkta matches members of an Okta group.
+type AccessPolicyRuleOkta struct {
+	Name               string `json:"name"`
+	IdentityProviderID string `json:"identity_provider_id"`
+}
+
+// AccessPolicyRuleGSuite matches members of a Google Workspace group.
+type AccessPolicyRuleGSuite struct {
+	Email              string `json:"email"`
+	IdentityProviderID string `json:"identity_provider_id"`
+}
+
+// AccessPolicyRuleGitHub matches members of a GitHub organization, or of a
+// team within it when Team is set.
+type AccessPolicyRuleGitHub struct {
+	Name               string `json:"name"`
+	Team               string `json:"team,omitempty"`
+	IdentityProviderID string `json:"identity_provider_id"`
+}
+
+// AccessPolicyRuleSAML matches a SAML attribute asserted by an identity
+// provider.
+type AccessPolicyRuleSAML struct {
+	AttributeName      string `json:"attribute_name"`
+	AttributeValue     string `json:"attribute_value"`
+	IdentityProviderID string `json:"identity_provider_id,omitempty"`
+}
+
+// AccessPolicyRuleOIDC matches a claim in the OIDC token of an identity
+// provider.
+type AccessPolicyRuleOIDC struct {
+	ClaimName          string `json:"claim_name"`
+	ClaimValue         string `json:"claim_value"`
+	IdentityProviderID string `json:"identity_provider_id"`
+}
+
+// AccessPolicyEmail returns a rule matching a single email address.
+func AccessPolicyEmail(email string) AccessPolicyRule {
+	return AccessPolicyRule{Email: &AccessPolicyRuleEmail{Email: email}}
+}
+
+// AccessPolicyEmails returns a rule for each email address.
+func AccessPolicyEmails(emails ...string) []AccessPolicyRule {
+	rules := make([]AccessPolicyRule, 0, len(emails))
+	for _, email := range emails {
+		rules = append(rules, AccessPolicyEmail(email))
+	}
+	return rules
+}
+
+// AccessPolicyEmailDomain returns a rule matching every address of a domain.
+func AccessPolicyEmailDomain(domain string) AccessPolicyRule {
+	return AccessPolicyRule{EmailDomain: &AccessPolicyRuleEmailDomain{Domain: domain}}
+}
+
+// AccessPolicyEmailList returns a rule matching the addresses in a Zero
+// Trust list.
+func AccessPolicyEmailList(listID string) AccessPolicyRule {
+	return AccessPolicyRule{EmailList: &AccessPolicyRuleID{ID: listID}}
+}
+
+// AccessPolicyEveryone returns a rule matching every request.
+func AccessPolicyEveryone() AccessPolicyRule {
+	return AccessPolicyRule{Everyone: &AccessPolicyRuleEmpty{}}
+}
+
+// AccessPolicyIP returns a rule matching an IP address or CIDR range.
+func AccessPolicyIP(cidr string) AccessPolicyRule {
+	return AccessPolicyRule{IP: &AccessPolicyRuleIP{IP: cidr}}
+}
+
+// AccessPolicyIPs returns a rule for each IP address or CIDR range.
+func AccessPolicyIPs(cidrs ...string) []AccessPolicyRule {
+	rules := make([]AccessPolicyRule, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		rules = append(rules, AccessPolicyIP(cidr))
+	}
+	return rules
+}
+
+// AccessPolicyIPList returns a rule matching the ranges in a Zero Trust
+// list.
+func AccessPolicyIPList(listID string) AccessPolicyRule {
+	return AccessPolicyRule{IPList: &AccessPolicyRuleID{ID: listID}}
+}
+
+// AccessPolicyCountry returns a rule matching requests from a country, given
+// as an ISO 3166-1 alpha-2 code.
+func AccessPolicyCountry(countryCode string) AccessPolicyRule {
+	return AccessPolicyRule{Geo: &AccessPolicyRuleGeo{CountryCode: countryCode}}
+}
+
+// AccessPolicyGroup returns a rule matching members of an Access group.
+func AccessPolicyGroup(groupID string) AccessPolicyRule {
+	return AccessPolicyRule{Group: &AccessPolicyRuleID{ID: groupID}}
+}
+
+// AccessPolicyServiceToken returns a rule matching a single service token.
+func AccessPolicyServiceToken(tokenID string) AccessPolicyRule {
+	return AccessPolicyRule{ServiceToken: &AccessPolicyRuleServiceToken{TokenID: tokenID}}
+}
+
+// AccessPolicyAnyValidServiceToken returns a rule matching any service token
+// of the account.
+func AccessPolicyAnyValidServiceToken() AccessPolicyRule {
+	return AccessPolicyRule{AnyValidServiceToken: &AccessPolicyRuleEmpty{}}
+}
+
+// AccessPolicyCertificate returns a rule matching any valid client
+// certificate.
+func AccessPolicyCertificate() AccessPolicyRule {
+	return AccessPolicyRule{Certificate: &AccessPolicyRuleEmpty{}}
+}
+
+// AccessPolicyCommonName returns a rule matching the common name of a client
+// certificate.
+func AccessPolicyCommonName(commonName string) AccessPolicyRule {
+	return AccessPolicyRule{CommonName: &AccessPolicyRuleCommonName{CommonName: commonName}}
+}
+
+// AccessPolicyLoginMethod returns a rule matching users who logged in with
+// an identity provider.
+func AccessPolicyLoginMethod(identityProviderID string) AccessPolicyRule {
+	return AccessPolicyRule{LoginMethod: &AccessPolicyRuleID{ID: identityProviderID}}
+}
+
+// AccessPolicyAuthMethod returns a rule matching the authentication method
+// reported by the identity provider.
+func AccessPolicyAuthMethod(method string) AccessPolicyRule {
+	return AccessPolicyRule{AuthMethod: &AccessPolicyRuleAuthMethod{AuthMethod: method}}
+}
+
+// AccessPolicyDevicePosture returns a rule matching devices that pass a
+// posture check.
+func AccessPolicyDevicePosture(integrationUID string) AccessPolicyRule {
+	return AccessPolicyRule{DevicePosture: &AccessPolicyRuleDevicePosture{IntegrationUID: integrationUID}}
+}
+
+// AccessPolicyAzureADGroup returns a rule matching members of an Azure AD
+// group.
+func AccessPolicyAzureADGroup(groupID, identityProviderID string) AccessPolicyRule {
+	return AccessPolicyRule{AzureAD: &AccessPolicyRuleAzureAD{ID: groupID, IdentityProviderID: identityProviderID}}
+}
+
+// AccessPolicyOktaGroup returns a rule matching members of an Okta group.
+func AccessPolicyOktaGroup(name, identityProviderID string) AccessPolicyRule {
+	return AccessPolicyRule{Okta: &AccessPolicyRuleOkta{Name: name, IdentityProviderID: identityProviderID}}
+}
+
+// AccessPolicyGSuiteGroup returns a rule matching members of a Google
+// Workspace group.
+func AccessPolicyGSuiteGroup(email, identityProviderID string) AccessPolicyRule {
+	return AccessPolicyRule{GSuite: &AccessPolicyRuleGSuite{Email: email, IdentityProviderID: identityProviderID}}
+}
+
+// AccessPolicyGitHubOrganization returns a rule matching members of a GitHub
+// organization. Pass an empty team to match the whole organization.
+func AccessPolicyGitHubOrganization(organization, team, identityProviderID string) AccessPolicyRule {
+	return AccessPolicyRule{GitHubOrganization: &AccessPolicyRuleGitHub{Name: organization, Team: team, IdentityProviderID: identityProviderID}}
+}
+
+// AccessPolicySAML returns a rule matching a SAML attribute.
+func AccessPolicySAML(attributeName, attributeValue, identityProviderID string) AccessPolicyRule {
+	return AccessPolicyRule{SAML: &AccessPolicyRuleSAML{AttributeName: attributeName, AttributeValue: attributeValue, IdentityProviderID: identityProviderID}}
+}
+
+// AccessPolicyOIDCClaim returns a rule matching a claim of an OIDC identity
+// provider.
+func AccessPolicyOIDCClaim(claimName, claimValue, identityProviderID string) AccessPolicyRule {
+	return AccessPolicyRule{OIDC: &AccessPolicyRuleOIDC{ClaimName: claimName, ClaimValue: claimValue, IdentityProviderID: identityProviderID}}
+}
+
+// AccessPolicyResponse represents the response from the Access policies
+// endpoint containing a single policy.
+type AccessPolicyResponse struct {
+	Response
+	Result AccessPolicy `json:"result"`
+}
+
+// AccessPoliciesResponse represents the response from the Access policies
+// endpoint containing multiple policies.
+type AccessPoliciesResponse struct {
+	Response
+	Result     []AccessPolicy `json:"result"`
+	ResultInfo ResultInfo     `json:"result_info"`
+}
+
+// AccessPolicyListParams contains the options available when listing Access
+// policies.
+type AccessPolicyListParams struct {
+	PaginationParams
+}
+
+// ListPolicies returns the policies of an Access application.
+//
+// API reference: https://api.cloudflare.com/#access-policies-list-access-policies
+func (s *AccessService) ListPolicies(ctx context.Context, rc *ResourceContainer, applicationID string, params AccessPolicyListParams) ([]AccessPolicy, ResultInfo, error) {
+	var policies []AccessPolicy
+	var info ResultInfo
+	err := s.ForEachPoliciesPage(ctx, rc, applicationID, params, func(page []AccessPolicy, pageInfo ResultInfo) bool {
+		policies = append(policies, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessPolicy{}, ResultInfo{}, err
+	}
+
+	return policies, info, nil
+}
+
+// ForEachPoliciesPage is like ListPolicies but passes each page of results to
+// fn as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#access-policies-list-access-policies
+func (s *AccessService) ForEachPoliciesPage(ctx context.Context, rc *ResourceContainer, applicationID string, params AccessPolicyListParams, fn func(items []AccessPolicy, info ResultInfo) bool) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if applicationID == "" {
+		return fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	return s.client.listPages(ctx, rc.URLFragment()+"/access/apps/"+applicationID+"/policies", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessPoliciesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetPolicy fetches a single policy of an Access application.
+//
+// API reference: https://api.cloudflare.com/#access-policies-get-an-access-policy
+func (s *AccessService) GetPolicy(ctx context.Context, rc *ResourceContainer, applicationID, policyID string) (AccessPolicy, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessPolicy{}, err
+	}
+
+	if applicationID == "" {
+		return AccessPolicy{}, fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	if policyID == "" {
+		return AccessPolicy{}, fmt.Errorf(errMissingResourceID, "access policy")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/access/apps/"+applicationID+"/policies/"+policyID, nil)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+
+	var r AccessPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreatePolicy adds a policy to an Access application.
+//
+// API reference: https://api.cloudflare.com/#access-policies-create-an-access-policy
+func (s *AccessService) CreatePolicy(ctx context.Context, rc *ResourceContainer, applicationID string, policy AccessPolicy) (AccessPolicy, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessPolicy{}, err
+	}
+
+	if applicationID == "" {
+		return AccessPolicy{}, fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/apps/"+applicationID+"/policies", policy)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+
+	var r AccessPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdatePolicy replaces a policy of an Access application.
+//
+// API reference: https://api.cloudflare.com/#access-policies-update-an-access-policy
+func (s *AccessService) UpdatePolicy(ctx context.Context, rc *ResourceContainer, applicationID, policyID string, policy AccessPolicy) (AccessPolicy, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessPolicy{}, err
+	}
+
+	if applicationID == "" {
+		return AccessPolicy{}, fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	if policyID == "" {
+		return AccessPolicy{}, fmt.Errorf(errMissingResourceID, "access policy")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/access/apps/"+applicationID+"/policies/"+policyID, policy)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+
+	var r AccessPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeletePolicy removes a policy from an Access application.
+//
+// API reference: https://api.cloudflare.com/#access-policies-delete-an-access-policy
+func (s *AccessService) DeletePolicy(ctx context.Context, rc *ResourceContainer, applicationID, policyID string) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if applicationID == "" {
+		return fmt.Errorf(errMissingResourceID, "access application")
+	}
+
+	if policyID == "" {
+		return fmt.Errorf(errMissingResourceID, "access policy")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/access/apps/"+applicationID+"/policies/"+policyID, nil)
+	return err
+}
+
+// ListReusablePolicies returns the reusable Access policies of an account.
+//
+// API reference: https://api.cloudflare.com/#access-reusable-policies-list-access-reusable-policies
+func (s *AccessService) ListReusablePolicies(ctx context.Context, accountID string, params AccessPolicyListParams) ([]AccessPolicy, ResultInfo, error) {
+	var policies []AccessPolicy
+	var info ResultInfo
+	err := s.ForEachReusablePoliciesPage(ctx, accountID, params, func(page []AccessPolicy, pageInfo ResultInfo) bool {
+		policies = append(policies, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessPolicy{}, ResultInfo{}, err
+	}
+
+	return policies, info, nil
+}
+
+// ForEachReusablePoliciesPage is like ListReusablePolicies but passes each
+// page of results to fn as it is fetched instead of collecting them. Paging
+// stops early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#access-reusable-policies-list-access-reusable-policies
+func (s *AccessService) ForEachReusablePoliciesPage(ctx context.Context, accountID string, params AccessPolicyListParams, fn func(items []AccessPolicy, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/access/policies", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessPoliciesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetReusablePolicy fetches a single reusable Access policy.
+//
+// API reference: https://api.cloudflare.com/#access-reusable-policies-get-an-access-reusable-policy
+func (s *AccessService) GetReusablePolicy(ctx context.Context, accountID, policyID string) (AccessPolicy, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AccessPolicy{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if policyID == "" {
+		return AccessPolicy{}, fmt.Errorf(errMissingResourceID, "access policy")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/access/policies/"+policyID, nil)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+
+	var r AccessPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateReusablePolicy creates a policy that can be attached to any number
+// of applications with AccessApplication.Policies.
+//
+// API reference: https://api.cloudflare.com/#access-reusable-policies-create-an-access-reusable-policy
+func (s *AccessService) CreateReusablePolicy(ctx context.Context, accountID string, policy AccessPolicy) (AccessPolicy, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AccessPolicy{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/access/policies", policy)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+
+	var r AccessPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateReusablePolicy replaces a reusable Access policy.
+//
+// API reference: https://api.cloudflare.com/#access-reusable-policies-update-an-access-reusable-policy
+func (s *AccessService) UpdateReusablePolicy(ctx context.Context, accountID, policyID string, policy AccessPolicy) (AccessPolicy, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AccessPolicy{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if policyID == "" {
+		return AccessPolicy{}, fmt.Errorf(errMissingResourceID, "access policy")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/access/policies/"+policyID, policy)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+
+	var r AccessPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessPolicy{}, fmt.Errorf("failed to unmarshal access policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteReusablePolicy removes a reusable Access policy. Policies still
+// attached to applications cannot be deleted.
+//
+// API reference: https://api.cloudflare.com/#access-reusable-policies-delete-an-access-reusable-policy
+func (s *AccessService) DeleteReusablePolicy(ctx context.Context, accountID, policyID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if policyID == "" {
+		return fmt.Errorf(errMissingResourceID, "access policy")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/access/policies/"+policyID, nil)
+	return err
+}
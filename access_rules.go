@@ -0,0 +1,233 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type AccessRulesService service
+
+// AccessRuleMode is the action applied to requests matching an IP Access
+// rule.
+type AccessRuleMode string
+
+const (
+	AccessRuleModeBlock            AccessRuleMode = "block"
+	AccessRuleModeChallenge        AccessRuleMode = "challenge"
+	AccessRuleModeJSChallenge      AccessRuleMode = "js_challenge"
+	AccessRuleModeManagedChallenge AccessRuleMode = "managed_challenge"
+	AccessRuleModeWhitelist        AccessRuleMode = "whitelist"
+)
+
+// AccessRuleTarget is the kind of value an IP Access rule matches on.
+type AccessRuleTarget string
+
+const (
+	AccessRuleTargetIP      AccessRuleTarget = "ip"
+	AccessRuleTargetIPv6    AccessRuleTarget = "ip6"
+	AccessRuleTargetIPRange AccessRuleTarget = "ip_range"
+	AccessRuleTargetASN     AccessRuleTarget = "asn"
+	AccessRuleTargetCountry AccessRuleTarget = "country"
+)
+
+// AccessRule represents a firewall access rule.
+type AccessRule struct {
+	ID            string                  `json:"id,omitempty"`
+	Notes         string                  `json:"notes,omitempty"`
+	AllowedModes  []AccessRuleMode        `json:"allowed_modes,omitempty"`
+	Mode          AccessRuleMode          `json:"mode,omitempty"`
+	Configuration AccessRuleConfiguration `json:"configuration,omitempty"`
+	Scope         *AccessRuleScope        `json:"scope,omitempty"`
+	CreatedOn     *time.Time              `json:"created_on,omitempty"`
+	ModifiedOn    *time.Time              `json:"modified_on,omitempty"`
+}
+
+// AccessRuleConfiguration represents the configuration of a firewall access
+// rule.
+type AccessRuleConfiguration struct {
+	Target AccessRuleTarget `json:"target,omitempty"`
+	Value  string           `json:"value,omitempty"`
+}
+
+// AccessRuleScope represents the scope of a firewall access rule.
+type AccessRuleScope struct {
+	ID    string `json:"id,omitempty"`
+	Email string `json:"email,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Type  string `json:"type,omitempty"`
+}
+
+// AccessRuleResponse represents the response from the firewall access rule
+// endpoint containing a single rule.
+type AccessRuleResponse struct {
+	Response
+	Result AccessRule `json:"result"`
+}
+
+// AccessRulesResponse represents the response from the firewall access rule
+// endpoint containing multiple rules.
+type AccessRulesResponse struct {
+	Response
+	Result     []AccessRule `json:"result"`
+	ResultInfo ResultInfo   `json:"result_info"`
+}
+
+// AccessRuleListParams contains the filters available when listing IP Access
+// rules. Match may be "all" or "any" to control how multiple filters combine.
+type AccessRuleListParams struct {
+	Mode                AccessRuleMode   `url:"mode,omitempty"`
+	ConfigurationTarget AccessRuleTarget `url:"configuration.target,omitempty"`
+	ConfigurationValue  string           `url:"configuration.value,omitempty"`
+	Notes               string           `url:"notes,omitempty"`
+	Match               string           `url:"match,omitempty"`
+	Order               string           `url:"order,omitempty"`
+
+	PaginationParams
+}
+
+// AccessRuleParams contains the fields used to create or update an IP Access
+// rule. The configuration can only be set on creation.
+type AccessRuleParams struct {
+	Mode          AccessRuleMode           `json:"mode"`
+	Configuration *AccessRuleConfiguration `json:"configuration,omitempty"`
+	Notes         string                   `json:"notes,omitempty"`
+}
+
+// List returns the IP Access rules of the user, account or zone that match the
+// provided `AccessRuleListParams`.
+//
+// API reference: https://api.cloudflare.com/#ip-access-rules-for-a-zone-list-ip-access-rules
+func (s *AccessRulesService) List(ctx context.Context, rc *ResourceContainer, params AccessRuleListParams) ([]AccessRule, ResultInfo, error) {
+	var rules []AccessRule
+	var info ResultInfo
+	err := s.ForEachPage(ctx, rc, params, func(page []AccessRule, pageInfo ResultInfo) bool {
+		rules = append(rules, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessRule{}, ResultInfo{}, err
+	}
+
+	return rules, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#ip-access-rules-for-a-zone-list-ip-access-rules
+func (s *AccessRulesService) ForEachPage(ctx context.Context, rc *ResourceContainer, params AccessRuleListParams, fn func(items []AccessRule, info ResultInfo) bool) error {
+	if err := rc.validate(); err != nil {
+		return err
+	}
+
+	return s.client.listPages(ctx, rc.URLFragment()+"/firewall/access_rules/rules", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessRulesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access rule JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single IP Access rule.
+//
+// API reference: https://api.cloudflare.com/#ip-access-rules-for-an-account-get-an-ip-access-rule
+func (s *AccessRulesService) Get(ctx context.Context, rc *ResourceContainer, accessRuleID string) (AccessRule, error) {
+	if err := rc.validate(); err != nil {
+		return AccessRule{}, err
+	}
+
+	if accessRuleID == "" {
+		return AccessRule{}, fmt.Errorf(errMissingResourceID, "access rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/firewall/access_rules/rules/"+accessRuleID, nil)
+	if err != nil {
+		return AccessRule{}, err
+	}
+
+	var r AccessRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessRule{}, fmt.Errorf("failed to unmarshal access rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new IP Access rule.
+//
+// API reference: https://api.cloudflare.com/#ip-access-rules-for-a-zone-create-an-ip-access-rule
+func (s *AccessRulesService) Create(ctx context.Context, rc *ResourceContainer, params AccessRuleParams) (AccessRule, error) {
+	if err := rc.validate(); err != nil {
+		return AccessRule{}, err
+	}
+
+	if params.Mode == "" || params.Configuration == nil {
+		return AccessRule{}, errors.New("mode and configuration are required to create an access rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/firewall/access_rules/rules", params)
+	if err != nil {
+		return AccessRule{}, err
+	}
+
+	var r AccessRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessRule{}, fmt.Errorf("failed to unmarshal access rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the mode or notes of an IP Access rule.
+//
+// API reference: https://api.cloudflare.com/#ip-access-rules-for-a-zone-update-an-ip-access-rule
+func (s *AccessRulesService) Update(ctx context.Context, rc *ResourceContainer, accessRuleID string, params AccessRuleParams) (AccessRule, error) {
+	if err := rc.validate(); err != nil {
+		return AccessRule{}, err
+	}
+
+	if accessRuleID == "" {
+		return AccessRule{}, fmt.Errorf(errMissingResourceID, "access rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, rc.URLFragment()+"/firewall/access_rules/rules/"+accessRuleID, params)
+	if err != nil {
+		return AccessRule{}, err
+	}
+
+	var r AccessRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessRule{}, fmt.Errorf("failed to unmarshal access rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes an IP Access rule.
+//
+// API reference: https://api.cloudflare.com/#ip-access-rules-for-a-zone-delete-an-ip-access-rule
+func (s *AccessRulesService) Delete(ctx context.Context, rc *ResourceContainer, accessRuleID string) error {
+	if err := rc.validate(); err != nil {
+		return err
+	}
+
+	if accessRuleID == "" {
+		return fmt.Errorf(errMissingResourceID, "access rule")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/firewall/access_rules/rules/"+accessRuleID, nil)
+	return err
+}
@@ -0,0 +1,232 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// AccessServiceToken lets automated systems authenticate to Access
+// applications with a client ID and secret.
+//
+// Duration is how long the token is valid for, such as "8760h". It defaults
+// to one year.
+type AccessServiceToken struct {
+	ID         string     `json:"id,omitempty"`
+	Name       string     `json:"name"`
+	ClientID   string     `json:"client_id,omitempty"`
+	Duration   string     `json:"duration,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastSeenAt *time.Time `json:"last_seen_at,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// AccessServiceTokenSecret is a service token along with its client secret.
+// The secret is only returned when a token is created or rotated and cannot
+// be retrieved afterwards.
+type AccessServiceTokenSecret struct {
+	AccessServiceToken
+	ClientSecret string `json:"client_secret"`
+}
+
+// AccessServiceTokenResponse represents the response from the service tokens
+// endpoint containing a single token.
+type AccessServiceTokenResponse struct {
+	Response
+	Result AccessServiceToken `json:"result"`
+}
+
+// AccessServiceTokenSecretResponse represents the response from creating or
+// rotating a service token.
+type AccessServiceTokenSecretResponse struct {
+	Response
+	Result AccessServiceTokenSecret `json:"result"`
+}
+
+// AccessServiceTokensResponse represents the response from the service
+// tokens endpoint containing multiple tokens.
+type AccessServiceTokensResponse struct {
+	Response
+	Result     []AccessServiceToken `json:"result"`
+	ResultInfo ResultInfo           `json:"result_info"`
+}
+
+// AccessServiceTokenListParams contains the options available when listing
+// service tokens.
+type AccessServiceTokenListParams struct {
+	PaginationParams
+}
+
+// AccessServiceTokenParams contains the fields used to create or update a
+// service token.
+type AccessServiceTokenParams struct {
+	Name     string `json:"name,omitempty"`
+	Duration string `json:"duration,omitempty"`
+}
+
+// ListServiceTokens returns the service tokens of an account or zone.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-list-service-tokens
+func (s *AccessService) ListServiceTokens(ctx context.Context, rc *ResourceContainer, params AccessServiceTokenListParams) ([]AccessServiceToken, ResultInfo, error) {
+	var tokens []AccessServiceToken
+	var info ResultInfo
+	err := s.ForEachServiceTokensPage(ctx, rc, params, func(page []AccessServiceToken, pageInfo ResultInfo) bool {
+		tokens = append(tokens, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccessServiceToken{}, ResultInfo{}, err
+	}
+
+	return tokens, info, nil
+}
+
+// ForEachServiceTokensPage is like ListServiceTokens but passes each page of
+// results to fn as it is fetched instead of collecting them. Paging stops
+// early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-list-service-tokens
+func (s *AccessService) ForEachServiceTokensPage(ctx context.Context, rc *ResourceContainer, params AccessServiceTokenListParams, fn func(items []AccessServiceToken, info ResultInfo) bool) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	return s.client.listPages(ctx, rc.URLFragment()+"/access/service_tokens", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccessServiceTokensResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal access service token JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// CreateServiceToken creates a new service token. The returned client secret
+// must be stored by the caller as it cannot be retrieved again.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-create-a-service-token
+func (s *AccessService) CreateServiceToken(ctx context.Context, rc *ResourceContainer, params AccessServiceTokenParams) (AccessServiceTokenSecret, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessServiceTokenSecret{}, err
+	}
+
+	if params.Name == "" {
+		return AccessServiceTokenSecret{}, errors.New("name is required to create a service token")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/service_tokens", params)
+	if err != nil {
+		return AccessServiceTokenSecret{}, err
+	}
+
+	var r AccessServiceTokenSecretResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessServiceTokenSecret{}, fmt.Errorf("failed to unmarshal access service token JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateServiceToken renames a service token or changes its duration.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-update-a-service-token
+func (s *AccessService) UpdateServiceToken(ctx context.Context, rc *ResourceContainer, tokenID string, params AccessServiceTokenParams) (AccessServiceToken, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessServiceToken{}, err
+	}
+
+	if tokenID == "" {
+		return AccessServiceToken{}, fmt.Errorf(errMissingResourceID, "service token")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/access/service_tokens/"+tokenID, params)
+	if err != nil {
+		return AccessServiceToken{}, err
+	}
+
+	var r AccessServiceTokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessServiceToken{}, fmt.Errorf("failed to unmarshal access service token JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// RotateServiceToken generates a new client secret for a service token,
+// invalidating the previous one immediately.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-rotate-a-service-token
+func (s *AccessService) RotateServiceToken(ctx context.Context, rc *ResourceContainer, tokenID string) (AccessServiceTokenSecret, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessServiceTokenSecret{}, err
+	}
+
+	if tokenID == "" {
+		return AccessServiceTokenSecret{}, fmt.Errorf(errMissingResourceID, "service token")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/service_tokens/"+tokenID+"/rotate", nil)
+	if err != nil {
+		return AccessServiceTokenSecret{}, err
+	}
+
+	var r AccessServiceTokenSecretResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessServiceTokenSecret{}, fmt.Errorf("failed to unmarshal access service token JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// RefreshServiceToken extends the expiry of a service token by its duration
+// without changing its credentials.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-refresh-a-service-token
+func (s *AccessService) RefreshServiceToken(ctx context.Context, rc *ResourceContainer, tokenID string) (AccessServiceToken, error) {
+	if err := validateAccessContainer(rc); err != nil {
+		return AccessServiceToken{}, err
+	}
+
+	if tokenID == "" {
+		return AccessServiceToken{}, fmt.Errorf(errMissingResourceID, "service token")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/access/service_tokens/"+tokenID+"/refresh", nil)
+	if err != nil {
+		return AccessServiceToken{}, err
+	}
+
+	var r AccessServiceTokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccessServiceToken{}, fmt.Errorf("failed to unmarshal access service token JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteServiceToken removes a service token.
+//
+// API reference: https://api.cloudflare.com/#access-service-tokens-delete-a-service-token
+func (s *AccessService) DeleteServiceToken(ctx context.Context, rc *ResourceContainer, tokenID string) error {
+	if err := validateAccessContainer(rc); err != nil {
+		return err
+	}
+
+	if tokenID == "" {
+		return fmt.Errorf(errMissingResourceID, "service token")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/access/service_tokens/"+tokenID, nil)
+	return err
+}
@@ -0,0 +1,205 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type AccountMembersService service
+
+// AccountMember is the definition of a member of an account.
+type AccountMember struct {
+	ID     string                   `json:"id"`
+	Code   string                   `json:"code"`
+	User   AccountMemberUserDetails `json:"user"`
+	Status string                   `json:"status"`
+	Roles  []AccountRole            `json:"roles"`
+}
+
+// AccountMemberUserDetails outlines all the personal information about
+// a member.
+type AccountMemberUserDetails struct {
+	ID                             string `json:"id"`
+	FirstName                      string `json:"first_name"`
+	LastName                       string `json:"last_name"`
+	Email                          string `json:"email"`
+	TwoFactorAuthenticationEnabled bool   `json:"two_factor_authentication_enabled"`
+}
+
+// AccountMemberResponse represents the response from the account members
+// endpoint containing a single member.
+type AccountMemberResponse struct {
+	Response
+	Result AccountMember `json:"result"`
+}
+
+// AccountMembersResponse represents the response from the account members
+// endpoint containing multiple members.
+type AccountMembersResponse struct {
+	Response
+	Result     []AccountMember `json:"result"`
+	ResultInfo ResultInfo      `json:"result_info"`
+}
+
+// AccountMemberListParams contains the filters available when listing account
+// members.
+type AccountMemberListParams struct {
+	Status string `url:"status,omitempty"`
+	Order  string `url:"order,omitempty"`
+
+	PaginationParams
+}
+
+// AccountMemberCreateParams contains the details needed to invite a new member
+// to an account. Roles are the role IDs the member should be granted and
+// Status may be set to "accepted" to skip the invitation email for users that
+// are already part of the organisation.
+type AccountMemberCreateParams struct {
+	Email  string   `json:"email"`
+	Roles  []string `json:"roles"`
+	Status string   `json:"status,omitempty"`
+}
+
+// AccountMemberUpdateParams contains the roles to assign to an existing
+// member. The provided roles replace any the member currently holds.
+type AccountMemberUpdateParams struct {
+	Roles []AccountRole `json:"roles"`
+}
+
+// Create invites a new member to the account.
+//
+// API reference: https://api.cloudflare.com/#account-members-add-member
+func (s *AccountMembersService) Create(ctx context.Context, accountID string, params AccountMemberCreateParams) (AccountMember, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AccountMember{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Email == "" {
+		return AccountMember{}, errors.New("email is required to invite an account member")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/members", params)
+	if err != nil {
+		return AccountMember{}, err
+	}
+
+	var r AccountMemberResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccountMember{}, fmt.Errorf("failed to unmarshal account member JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// List returns all members of an account that match the provided
+// `AccountMemberListParams`.
+//
+// API reference: https://api.cloudflare.com/#account-members-list-members
+func (s *AccountMembersService) List(ctx context.Context, accountID string, params AccountMemberListParams) ([]AccountMember, ResultInfo, error) {
+	var members []AccountMember
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []AccountMember, pageInfo ResultInfo) bool {
+		members = append(members, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccountMember{}, ResultInfo{}, err
+	}
+
+	return members, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#account-members-list-members
+func (s *AccountMembersService) ForEachPage(ctx context.Context, accountID string, params AccountMemberListParams, fn func(items []AccountMember, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/members", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccountMembersResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal account member JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single account member.
+//
+// API reference: https://api.cloudflare.com/#account-members-member-details
+func (s *AccountMembersService) Get(ctx context.Context, accountID, memberID string) (AccountMember, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AccountMember{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if memberID == "" {
+		return AccountMember{}, fmt.Errorf(errMissingResourceID, "member")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/members/"+memberID, nil)
+	if err != nil {
+		return AccountMember{}, err
+	}
+
+	var r AccountMemberResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccountMember{}, fmt.Errorf("failed to unmarshal account member JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces the roles assigned to an account member.
+//
+// API reference: https://api.cloudflare.com/#account-members-update-member
+func (s *AccountMembersService) Update(ctx context.Context, accountID, memberID string, params AccountMemberUpdateParams) (AccountMember, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AccountMember{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if memberID == "" {
+		return AccountMember{}, fmt.Errorf(errMissingResourceID, "member")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/members/"+memberID, params)
+	if err != nil {
+		return AccountMember{}, err
+	}
+
+	var r AccountMemberResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccountMember{}, fmt.Errorf("failed to unmarshal account member JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a member from an account.
+//
+// API reference: https://api.cloudflare.com/#account-members-remove-member
+func (s *AccountMembersService) Delete(ctx context.Context, accountID, memberID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if memberID == "" {
+		return fmt.Errorf(errMissingResourceID, "member")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/members/"+memberID, nil)
+	return err
+}
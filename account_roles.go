@@ -0,0 +1,113 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type AccountRolesService service
+
+// AccountRole defines the roles that a member can have attached.
+type AccountRole struct {
+	ID          string                           `json:"id"`
+	Name        string                           `json:"name,omitempty"`
+	Description string                           `json:"description,omitempty"`
+	Permissions map[string]AccountRolePermission `json:"permissions,omitempty"`
+}
+
+// AccountRolePermission is the shared structure for all permissions that can
+// be assigned to a member.
+type AccountRolePermission struct {
+	Read bool `json:"read"`
+	Edit bool `json:"edit"`
+}
+
+// AccountRoleListParams contains the options available when listing account
+// roles.
+type AccountRoleListParams struct {
+	PaginationParams
+}
+
+// AccountRoleResponse represents the response from the account roles endpoint
+// containing a single role.
+type AccountRoleResponse struct {
+	Response
+	Result AccountRole `json:"result"`
+}
+
+// AccountRolesResponse represents the response from the account roles endpoint
+// containing multiple roles.
+type AccountRolesResponse struct {
+	Response
+	Result     []AccountRole `json:"result"`
+	ResultInfo ResultInfo    `json:"result_info"`
+}
+
+// List returns the roles that can be assigned to members of an account. Every
+// page is fetched unless params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#account-roles-list-roles
+func (s *AccountRolesService) List(ctx context.Context, accountID string, params AccountRoleListParams) ([]AccountRole, ResultInfo, error) {
+	var roles []AccountRole
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []AccountRole, pageInfo ResultInfo) bool {
+		roles = append(roles, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AccountRole{}, ResultInfo{}, err
+	}
+
+	return roles, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#account-roles-list-roles
+func (s *AccountRolesService) ForEachPage(ctx context.Context, accountID string, params AccountRoleListParams, fn func(items []AccountRole, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/roles", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccountRolesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal account role JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single account role.
+//
+// API reference: https://api.cloudflare.com/#account-roles-role-details
+func (s *AccountRolesService) Get(ctx context.Context, accountID, roleID string) (AccountRole, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AccountRole{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if roleID == "" {
+		return AccountRole{}, fmt.Errorf(errMissingResourceID, "role")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/roles/"+roleID, nil)
+	if err != nil {
+		return AccountRole{}, err
+	}
+
+	var r AccountRoleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccountRole{}, fmt.Errorf("failed to unmarshal account role JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
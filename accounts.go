@@ -0,0 +1,194 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type AccountsService service
+
+// Account describes a Cloudflare account.
+type Account struct {
+	ID        string           `json:"id,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	Type      string           `json:"type,omitempty"`
+	CreatedOn *time.Time       `json:"created_on,omitempty"`
+	Settings  *AccountSettings `json:"settings,omitempty"`
+}
+
+// AccountSettings outlines the available options for an account.
+type AccountSettings struct {
+	EnforceTwoFactor            bool   `json:"enforce_twofactor"`
+	UseAccountCustomNSByDefault bool   `json:"use_account_custom_ns_by_default"`
+	DefaultNameservers          string `json:"default_nameservers,omitempty"`
+}
+
+// AccountResponse represents the response from the accounts endpoint
+// containing a single account.
+type AccountResponse struct {
+	Response
+	Result Account `json:"result"`
+}
+
+// AccountsResponse represents the response from the accounts endpoint
+// containing multiple accounts.
+type AccountsResponse struct {
+	Response
+	Result     []Account  `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// AccountListParams contains the filters available when listing accounts.
+type AccountListParams struct {
+	Name string `url:"name,omitempty"`
+
+	PaginationParams
+}
+
+// AccountUpdateParams contains the fields that can be changed on an account.
+type AccountUpdateParams struct {
+	Name     string           `json:"name"`
+	Settings *AccountSettings `json:"settings,omitempty"`
+}
+
+// Get fetches a single account.
+//
+// API reference: https://api.cloudflare.com/#accounts-account-details
+func (s *AccountsService) Get(ctx context.Context, accountID string) (Account, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Account{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID, nil)
+	if err != nil {
+		return Account{}, err
+	}
+
+	var r AccountResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Account{}, fmt.Errorf("failed to unmarshal account JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// List returns all accounts the credentials have access to that match the
+// provided `AccountListParams`.
+//
+// API reference: https://api.cloudflare.com/#accounts-list-accounts
+func (s *AccountsService) List(ctx context.Context, params AccountListParams) ([]Account, ResultInfo, error) {
+	var accounts []Account
+	var info ResultInfo
+	err := s.ForEachPage(ctx, params, func(page []Account, pageInfo ResultInfo) bool {
+		accounts = append(accounts, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []Account{}, ResultInfo{}, err
+	}
+
+	return accounts, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#accounts-list-accounts
+func (s *AccountsService) ForEachPage(ctx context.Context, params AccountListParams, fn func(items []Account, info ResultInfo) bool) error {
+	return s.client.listPages(ctx, "/accounts", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AccountsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal account JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// AuditLogs returns the audit log entries of an account that match the
+// provided `AuditLogListParams`. Every page is fetched unless
+// params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#audit-logs-get-account-audit-logs
+func (s *AccountsService) AuditLogs(ctx context.Context, accountID string, params AuditLogListParams) ([]AuditLog, ResultInfo, error) {
+	var logs []AuditLog
+	var info ResultInfo
+	err := s.ForEachAuditLogsPage(ctx, accountID, params, func(page []AuditLog, pageInfo ResultInfo) bool {
+		logs = append(logs, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AuditLog{}, ResultInfo{}, err
+	}
+
+	return logs, info, nil
+}
+
+// ForEachAuditLogsPage is like AuditLogs but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#audit-logs-get-account-audit-logs
+func (s *AccountsService) ForEachAuditLogsPage(ctx context.Context, accountID string, params AuditLogListParams, fn func(items []AuditLog, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/audit_logs", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AuditLogsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal audit log JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Update modifies the name and settings of an existing account.
+//
+// API reference: https://api.cloudflare.com/#accounts-update-account
+func (s *AccountsService) Update(ctx context.Context, accountID string, params AccountUpdateParams) (Account, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Account{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID, params)
+	if err != nil {
+		return Account{}, err
+	}
+
+	var r AccountResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Account{}, fmt.Errorf("failed to unmarshal account JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSettings changes only the settings of an account, leaving its name
+// untouched.
+//
+// API reference: https://api.cloudflare.com/#accounts-update-account
+func (s *AccountsService) UpdateSettings(ctx context.Context, accountID string, settings AccountSettings) (Account, error) {
+	account, err := s.Get(ctx, accountID)
+	if err != nil {
+		return Account{}, err
+	}
+
+	return s.Update(ctx, accountID, AccountUpdateParams{
+		Name:     account.Name,
+		Settings: &settings,
+	})
+}
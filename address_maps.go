@@ -0,0 +1,348 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+type AddressMapsService service
+
+// AddressMapMembershipKind is the kind of resource an address map applies
+// to.
+type AddressMapMembershipKind string
+
+const (
+	AddressMapMembershipZone    AddressMapMembershipKind = "zone"
+	AddressMapMembershipAccount AddressMapMembershipKind = "account"
+)
+
+// AddressMapIP is an address of a BYOIP prefix included in an address map.
+type AddressMapIP struct {
+	IP        string     `json:"ip"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// AddressMapMembership is a zone or account whose proxied hostnames are
+// served from the addresses of an address map.
+type AddressMapMembership struct {
+	Identifier string                   `json:"identifier"`
+	Kind       AddressMapMembershipKind `json:"kind"`
+	CanDelete  bool                     `json:"can_delete,omitempty"`
+	CreatedAt  *time.Time               `json:"created_at,omitempty"`
+}
+
+// AddressMap maps the proxied hostnames of its member zones and accounts to
+// a set of BYOIP addresses. DefaultSNI is the hostname used for TLS
+// handshakes on the addresses of the map that carry no SNI.
+type AddressMap struct {
+	ID           string                 `json:"id"`
+	Description  *string                `json:"description,omitempty"`
+	DefaultSNI   *string                `json:"default_sni,omitempty"`
+	Enabled      *bool                  `json:"enabled,omitempty"`
+	CanDelete    bool                   `json:"can_delete"`
+	CanModifyIPs bool                   `json:"can_modify_ips"`
+	IPs          []AddressMapIP         `json:"ips,omitempty"`
+	Memberships  []AddressMapMembership `json:"memberships,omitempty"`
+	CreatedAt    *time.Time             `json:"created_at,omitempty"`
+	ModifiedAt   *time.Time             `json:"modified_at,omitempty"`
+}
+
+// AddressMapCreateParams contains the details of a new address map.
+type AddressMapCreateParams struct {
+	Description string                 `json:"description,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	IPs         []string               `json:"ips,omitempty"`
+	Memberships []AddressMapMembership `json:"memberships,omitempty"`
+}
+
+// AddressMapUpdateParams contains the fields of an address map to change.
+// Fields left nil are not changed.
+type AddressMapUpdateParams struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	DefaultSNI  *string `json:"default_sni,omitempty"`
+}
+
+// AddressMapResponse represents the response from the address maps endpoint
+// containing a single address map.
+type AddressMapResponse struct {
+	Response
+	Result AddressMap `json:"result"`
+}
+
+// AddressMapsResponse represents the response from the address maps endpoint
+// containing multiple address maps.
+type AddressMapsResponse struct {
+	Response
+	Result []AddressMap `json:"result"`
+}
+
+// List returns the address maps of an account.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-list-address-maps
+func (s *AddressMapsService) List(ctx context.Context, accountID string) ([]AddressMap, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []AddressMap{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/addressing/address_maps", nil)
+	if err != nil {
+		return []AddressMap{}, err
+	}
+
+	var r AddressMapsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []AddressMap{}, fmt.Errorf("failed to unmarshal address map JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-address-map-details
+func (s *AddressMapsService) Get(ctx context.Context, accountID, addressMapID string) (AddressMap, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AddressMap{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return AddressMap{}, fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID, nil)
+	if err != nil {
+		return AddressMap{}, err
+	}
+
+	var r AddressMapResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AddressMap{}, fmt.Errorf("failed to unmarshal address map JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create adds an address map to an account.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-create-address-map
+func (s *AddressMapsService) Create(ctx context.Context, accountID string, params AddressMapCreateParams) (AddressMap, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AddressMap{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	for _, ip := range params.IPs {
+		if net.ParseIP(ip) == nil {
+			return AddressMap{}, fmt.Errorf("invalid ip: %q", ip)
+		}
+	}
+
+	for _, m := range params.Memberships {
+		if err := validateAddressMapMembership(m.Kind, m.Identifier); err != nil {
+			return AddressMap{}, err
+		}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/addressing/address_maps", params)
+	if err != nil {
+		return AddressMap{}, err
+	}
+
+	var r AddressMapResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AddressMap{}, fmt.Errorf("failed to unmarshal address map JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the description, enabled state or default SNI of an
+// address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-update-address-map
+func (s *AddressMapsService) Update(ctx context.Context, accountID, addressMapID string, params AddressMapUpdateParams) (AddressMap, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return AddressMap{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return AddressMap{}, fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID, params)
+	if err != nil {
+		return AddressMap{}, err
+	}
+
+	var r AddressMapResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AddressMap{}, fmt.Errorf("failed to unmarshal address map JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes an address map. Address maps that are enabled or not
+// deletable cannot be removed.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-delete-address-map
+func (s *AddressMapsService) Delete(ctx context.Context, accountID, addressMapID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID, nil)
+	return err
+}
+
+// AddIP adds an address to an address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-add-an-ip-to-an-address-map
+func (s *AddressMapsService) AddIP(ctx context.Context, accountID, addressMapID, ip string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	if net.ParseIP(ip) == nil {
+		return fmt.Errorf("invalid ip: %q", ip)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID+"/ips/"+ip, nil)
+	return err
+}
+
+// AddZone adds a zone to the members of an address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-add-a-zone-membership-to-an-address-map
+func (s *AddressMapsService) AddZone(ctx context.Context, accountID, addressMapID, zoneID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	if err := validateAddressMapMembership(AddressMapMembershipZone, zoneID); err != nil {
+		return err
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID+"/zones/"+zoneID, nil)
+	return err
+}
+
+// AddAccount adds an account to the members of an address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-add-an-account-membership-to-an-address-map
+func (s *AddressMapsService) AddAccount(ctx context.Context, accountID, addressMapID, memberAccountID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	if err := validateAddressMapMembership(AddressMapMembershipAccount, memberAccountID); err != nil {
+		return err
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID+"/accounts/"+memberAccountID, nil)
+	return err
+}
+
+// RemoveIP removes an address from an address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-remove-an-ip-from-an-address-map
+func (s *AddressMapsService) RemoveIP(ctx context.Context, accountID, addressMapID, ip string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	if net.ParseIP(ip) == nil {
+		return fmt.Errorf("invalid ip: %q", ip)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID+"/ips/"+ip, nil)
+	return err
+}
+
+// RemoveZone removes a zone from the members of an address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-remove-a-zone-membership-from-an-address-map
+func (s *AddressMapsService) RemoveZone(ctx context.Context, accountID, addressMapID, zoneID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	if err := validateAddressMapMembership(AddressMapMembershipZone, zoneID); err != nil {
+		return err
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID+"/zones/"+zoneID, nil)
+	return err
+}
+
+// RemoveAccount removes an account from the members of an address map.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-remove-an-account-membership-from-an-address-map
+func (s *AddressMapsService) RemoveAccount(ctx context.Context, accountID, addressMapID, memberAccountID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressMapID == "" {
+		return fmt.Errorf(errMissingResourceID, "address map")
+	}
+
+	if err := validateAddressMapMembership(AddressMapMembershipAccount, memberAccountID); err != nil {
+		return err
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/addressing/address_maps/"+addressMapID+"/accounts/"+memberAccountID, nil)
+	return err
+}
+
+// validateAddressMapMembership checks the kind and identifier of an address
+// map member.
+func validateAddressMapMembership(kind AddressMapMembershipKind, identifier string) error {
+	switch kind {
+	case AddressMapMembershipZone:
+		if !isValidZoneIdentifier(identifier) {
+			return fmt.Errorf(errInvalidZoneIdentifer, identifier)
+		}
+	case AddressMapMembershipAccount:
+		if !isValidAccountIdentifier(identifier) {
+			return fmt.Errorf(errInvalidAccountIdentifier, identifier)
+		}
+	default:
+		return errors.New("membership kind must be zone or account")
+	}
+
+	return nil
+}
@@ -0,0 +1,245 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// defaultAnalyticsLimit is the number of rows requested from a dataset when
+// no limit is given.
+const defaultAnalyticsLimit = 100
+
+// graphQLIdentifier matches the dimension, field and ordering names that are
+// interpolated into analytics queries.
+var graphQLIdentifier = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+type AnalyticsService service
+
+// HTTPRequestsParams selects the rows returned by HTTPRequests. Since is
+// inclusive and Until exclusive.
+//
+// Dimensions are the httpRequestsAdaptiveGroups dimensions rows are grouped
+// by, such as "datetimeHour", "clientCountryName" or "edgeResponseStatus".
+// Filter holds additional filters in the dataset's filter syntax, for example
+// {"clientCountryName": "US"}. OrderBy defaults to the first dimension
+// ascending.
+type HTTPRequestsParams struct {
+	Since      time.Time
+	Until      time.Time
+	Dimensions []string
+	Filter     map[string]interface{}
+	OrderBy    []string
+	Limit      int
+}
+
+// HTTPRequestsGroup is a row of the httpRequestsAdaptiveGroups dataset.
+// Dimensions holds the value of each requested dimension.
+type HTTPRequestsGroup struct {
+	Count      int64                  `json:"count"`
+	Dimensions map[string]interface{} `json:"dimensions"`
+	Sum        struct {
+		EdgeResponseBytes int64 `json:"edgeResponseBytes"`
+		Visits            int64 `json:"visits"`
+	} `json:"sum"`
+	Avg struct {
+		SampleInterval float64 `json:"sampleInterval"`
+	} `json:"avg"`
+}
+
+// FirewallEventsParams selects the events returned by FirewallEvents. Since
+// is inclusive and Until exclusive. Filter holds additional filters in the
+// dataset's filter syntax, for example {"action": "block"}. Events are
+// returned newest first unless OrderBy is set.
+type FirewallEventsParams struct {
+	Since   time.Time
+	Until   time.Time
+	Filter  map[string]interface{}
+	OrderBy []string
+	Limit   int
+}
+
+// FirewallEvent is a row of the firewallEventsAdaptive dataset.
+type FirewallEvent struct {
+	Action                string    `json:"action"`
+	Source                string    `json:"source"`
+	RuleID                string    `json:"ruleId"`
+	RayName               string    `json:"rayName"`
+	Datetime              time.Time `json:"datetime"`
+	ClientIP              string    `json:"clientIP"`
+	ClientASNDescription  string    `json:"clientASNDescription"`
+	ClientCountryName     string    `json:"clientCountryName"`
+	ClientRequestHTTPHost string    `json:"clientRequestHTTPHost"`
+	ClientRequestPath     string    `json:"clientRequestPath"`
+	ClientRequestQuery    string    `json:"clientRequestQuery"`
+	UserAgent             string    `json:"userAgent"`
+}
+
+// firewallEventFields is the selection set matching FirewallEvent.
+const firewallEventFields = `action source ruleId rayName datetime clientIP clientASNDescription clientCountryName clientRequestHTTPHost clientRequestPath clientRequestQuery userAgent`
+
+// HTTPRequests returns HTTP request totals for a zone from the
+// httpRequestsAdaptiveGroups dataset, grouped by the requested dimensions.
+func (s *AnalyticsService) HTTPRequests(ctx context.Context, zoneID string, params HTTPRequestsParams) ([]HTTPRequestsGroup, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []HTTPRequestsGroup{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(params.Dimensions) == 0 {
+		return []HTTPRequestsGroup{}, errors.New("at least one dimension must be provided")
+	}
+
+	orderBy := params.OrderBy
+	if len(orderBy) == 0 {
+		orderBy = []string{params.Dimensions[0] + "_ASC"}
+	}
+
+	fields := "count dimensions { " + strings.Join(params.Dimensions, " ") + " } sum { edgeResponseBytes visits } avg { sampleInterval }"
+
+	var groups []HTTPRequestsGroup
+	err := s.query(ctx, analyticsQuery{
+		scope:    "zones",
+		tag:      zoneID,
+		dataset:  "httpRequestsAdaptiveGroups",
+		since:    params.Since,
+		until:    params.Until,
+		filter:   params.Filter,
+		orderBy:  orderBy,
+		limit:    params.Limit,
+		fields:   fields,
+		validate: params.Dimensions,
+	}, &groups)
+	if err != nil {
+		return []HTTPRequestsGroup{}, err
+	}
+
+	return groups, nil
+}
+
+// FirewallEvents returns the security events of a zone from the
+// firewallEventsAdaptive dataset.
+func (s *AnalyticsService) FirewallEvents(ctx context.Context, zoneID string, params FirewallEventsParams) ([]FirewallEvent, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []FirewallEvent{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	orderBy := params.OrderBy
+	if len(orderBy) == 0 {
+		orderBy = []string{"datetime_DESC"}
+	}
+
+	var events []FirewallEvent
+	err := s.query(ctx, analyticsQuery{
+		scope:   "zones",
+		tag:     zoneID,
+		dataset: "firewallEventsAdaptive",
+		since:   params.Since,
+		until:   params.Until,
+		filter:  params.Filter,
+		orderBy: orderBy,
+		limit:   params.Limit,
+		fields:  firewallEventFields,
+	}, &events)
+	if err != nil {
+		return []FirewallEvent{}, err
+	}
+
+	return events, nil
+}
+
+// analyticsQuery describes a query of a single GraphQL dataset of a zone or
+// account. validate lists caller supplied names interpolated into fields.
+type analyticsQuery struct {
+	scope    string
+	tag      string
+	dataset  string
+	since    time.Time
+	until    time.Time
+	filter   map[string]interface{}
+	orderBy  []string
+	limit    int
+	fields   string
+	validate []string
+}
+
+// query runs q and decodes the dataset's rows into out. The time range is
+// added to the filter as datetime_geq and datetime_lt.
+func (s *AnalyticsService) query(ctx context.Context, q analyticsQuery, out interface{}) error {
+	if q.since.IsZero() || q.until.IsZero() {
+		return errors.New("since and until must be provided")
+	}
+
+	if !q.until.After(q.since) {
+		return errors.New("until must be after since")
+	}
+
+	for _, name := range append(append([]string{}, q.validate...), q.orderBy...) {
+		if !graphQLIdentifier.MatchString(name) {
+			return fmt.Errorf("invalid analytics field name: %q", name)
+		}
+	}
+
+	if q.limit == 0 {
+		q.limit = defaultAnalyticsLimit
+	}
+
+	filter := make(map[string]interface{}, len(q.filter)+2)
+	for k, v := range q.filter {
+		filter[k] = v
+	}
+	filter["datetime_geq"] = q.since.UTC().Format(time.RFC3339)
+	filter["datetime_lt"] = q.until.UTC().Format(time.RFC3339)
+
+	// The scope prefix of the filter type is the singular, capitalised scope:
+	// ZoneHttpRequestsAdaptiveGroupsFilter_InputObject for zones.
+	scopeType := strings.ToUpper(q.scope[:1]) + strings.TrimSuffix(q.scope[1:], "s")
+	tagName := strings.TrimSuffix(q.scope, "s") + "Tag"
+	filterType := scopeType + strings.ToUpper(q.dataset[:1]) + q.dataset[1:] + "Filter_InputObject"
+
+	var orderBy string
+	if len(q.orderBy) > 0 {
+		orderBy = ", orderBy: [" + strings.Join(q.orderBy, ", ") + "]"
+	}
+
+	query := fmt.Sprintf(`query ($tag: string!, $filter: %s!, $limit: uint64!) {
+  viewer {
+    scope: %s(filter: {%s: $tag}) {
+      rows: %s(filter: $filter, limit: $limit%s) {
+        %s
+      }
+    }
+  }
+}`, filterType, q.scope, tagName, q.dataset, orderBy, q.fields)
+
+	var data struct {
+		Viewer struct {
+			Scope []struct {
+				Rows json.RawMessage `json:"rows"`
+			} `json:"scope"`
+		} `json:"viewer"`
+	}
+
+	err := s.client.GraphQL(ctx, query, map[string]interface{}{
+		"tag":    q.tag,
+		"filter": filter,
+		"limit":  q.limit,
+	}, &data)
+	if err != nil {
+		return err
+	}
+
+	if len(data.Viewer.Scope) == 0 || len(data.Viewer.Scope[0].Rows) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(data.Viewer.Scope[0].Rows, out)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal %s JSON data: %w", q.dataset, err)
+	}
+
+	return nil
+}
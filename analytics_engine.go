@@ -0,0 +1,162 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// AnalyticsEngineFormat is the output format of an Analytics Engine SQL
+// query, set by its FORMAT clause.
+type AnalyticsEngineFormat string
+
+const (
+	AnalyticsEngineFormatJSON         AnalyticsEngineFormat = "JSON"
+	AnalyticsEngineFormatJSONEachRow  AnalyticsEngineFormat = "JSONEachRow"
+	AnalyticsEngineFormatTabSeparated AnalyticsEngineFormat = "TabSeparated"
+)
+
+// analyticsEngineFormatClause matches a trailing FORMAT clause of a query.
+var analyticsEngineFormatClause = regexp.MustCompile(`(?i)\bFORMAT\s+([A-Za-z]+)\s*;?\s*$`)
+
+// AnalyticsEngineSQLParams contains the query run by SQL. Format appends a
+// FORMAT clause to the query; it must be left empty if the query already
+// has one. Queries without a FORMAT clause return JSON.
+type AnalyticsEngineSQLParams struct {
+	Query  string
+	Format AnalyticsEngineFormat
+}
+
+// AnalyticsEngineColumn describes a column of a query result.
+type AnalyticsEngineColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// AnalyticsEngineSQLResult describes the result of a query in the JSON
+// format. It is empty for JSONEachRow queries.
+type AnalyticsEngineSQLResult struct {
+	Meta                   []AnalyticsEngineColumn `json:"meta"`
+	Rows                   int                     `json:"rows"`
+	RowsBeforeLimitAtLeast int                     `json:"rows_before_limit_at_least"`
+}
+
+type analyticsEngineSQLResponse struct {
+	AnalyticsEngineSQLResult
+	Data json.RawMessage `json:"data"`
+}
+
+// SQL runs a query against the Workers Analytics Engine datasets of an
+// account and decodes the rows into out, typically a pointer to a slice of
+// structs. out may be nil when the rows aren't needed. Queries must use the
+// JSON or JSONEachRow format; use SQLRaw for other formats. Note that
+// Analytics Engine returns 64 bit integers as JSON strings.
+//
+// API reference: https://api.cloudflare.com/#analytics-engine-sql-query
+func (s *AnalyticsService) SQL(ctx context.Context, accountID string, params AnalyticsEngineSQLParams, out interface{}) (AnalyticsEngineSQLResult, error) {
+	query, format, err := analyticsEngineQuery(params)
+	if err != nil {
+		return AnalyticsEngineSQLResult{}, err
+	}
+
+	if format != AnalyticsEngineFormatJSON && format != AnalyticsEngineFormatJSONEachRow {
+		return AnalyticsEngineSQLResult{}, fmt.Errorf("format %s cannot be decoded, use SQLRaw instead", format)
+	}
+
+	res, err := s.SQLRaw(ctx, accountID, query)
+	if err != nil {
+		return AnalyticsEngineSQLResult{}, err
+	}
+
+	if format == AnalyticsEngineFormatJSONEachRow {
+		if out == nil {
+			return AnalyticsEngineSQLResult{}, nil
+		}
+
+		// Turn the newline delimited rows into an array so they can be
+		// decoded in one go.
+		var rows [][]byte
+		for _, line := range bytes.Split(res, []byte("\n")) {
+			if line = bytes.TrimSpace(line); len(line) > 0 {
+				rows = append(rows, line)
+			}
+		}
+
+		data := append(append([]byte("["), bytes.Join(rows, []byte(","))...), ']')
+		if err := json.Unmarshal(data, out); err != nil {
+			return AnalyticsEngineSQLResult{}, fmt.Errorf("failed to unmarshal analytics engine JSON data: %w", err)
+		}
+
+		return AnalyticsEngineSQLResult{}, nil
+	}
+
+	var r analyticsEngineSQLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AnalyticsEngineSQLResult{}, fmt.Errorf("failed to unmarshal analytics engine JSON data: %w", err)
+	}
+
+	if out != nil && len(r.Data) > 0 {
+		if err := json.Unmarshal(r.Data, out); err != nil {
+			return AnalyticsEngineSQLResult{}, fmt.Errorf("failed to unmarshal analytics engine JSON data: %w", err)
+		}
+	}
+
+	return r.AnalyticsEngineSQLResult, nil
+}
+
+// SQLRaw runs a query against the Workers Analytics Engine datasets of an
+// account and returns the response body as is.
+//
+// API reference: https://api.cloudflare.com/#analytics-engine-sql-query
+func (s *AnalyticsService) SQLRaw(ctx context.Context, accountID, query string) ([]byte, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if strings.TrimSpace(query) == "" {
+		return nil, errors.New("query must be provided")
+	}
+
+	res, err := s.client.CallWithHeaders(ctx, http.MethodPost, "/accounts/"+accountID+"/analytics_engine/sql", []byte(query), http.Header{
+		"Content-Type": []string{"text/plain"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// analyticsEngineQuery returns the query to send for params along with its
+// output format. Format names in the query are matched case insensitively.
+func analyticsEngineQuery(params AnalyticsEngineSQLParams) (string, AnalyticsEngineFormat, error) {
+	query := strings.TrimSpace(params.Query)
+	if query == "" {
+		return "", "", errors.New("query must be provided")
+	}
+
+	if m := analyticsEngineFormatClause.FindStringSubmatch(query); m != nil {
+		if params.Format != "" {
+			return "", "", errors.New("format must not be set when the query has a FORMAT clause")
+		}
+		format := AnalyticsEngineFormat(m[1])
+		for _, f := range []AnalyticsEngineFormat{AnalyticsEngineFormatJSON, AnalyticsEngineFormatJSONEachRow, AnalyticsEngineFormatTabSeparated} {
+			if strings.EqualFold(m[1], string(f)) {
+				format = f
+			}
+		}
+		return query, format, nil
+	}
+
+	if params.Format == "" {
+		return query, AnalyticsEngineFormatJSON, nil
+	}
+
+	return strings.TrimSuffix(query, ";") + " FORMAT " + string(params.Format), params.Format, nil
+}
@@ -0,0 +1,417 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// APIShieldOperation is an endpoint of an API managed by API Shield. Path
+// parameters in Endpoint are written as {var1}, {var2} and so on.
+type APIShieldOperation struct {
+	ID          string                 `json:"operation_id,omitempty"`
+	Method      string                 `json:"method"`
+	Host        string                 `json:"host"`
+	Endpoint    string                 `json:"endpoint"`
+	LastUpdated *time.Time             `json:"last_updated,omitempty"`
+	Features    map[string]interface{} `json:"features,omitempty"`
+}
+
+// APIShieldOperationListParams contains the filters available when listing
+// operations. Features adds the named features, such as "thresholds" or
+// "parameter_schemas", to each operation. Order is "method", "host",
+// "endpoint" or "thresholds.requests" and Direction is "asc" or "desc".
+type APIShieldOperationListParams struct {
+	Host     []string `url:"host,omitempty"`
+	Method   []string `url:"method,omitempty"`
+	Endpoint string   `url:"endpoint,omitempty"`
+	Features []string `url:"feature,omitempty"`
+	Order    string   `url:"order,omitempty"`
+	PaginationParams
+}
+
+// APIShieldDiscoveryState is the review state of an operation found by API
+// discovery.
+type APIShieldDiscoveryState string
+
+const (
+	APIShieldDiscoveryStateReview  APIShieldDiscoveryState = "review"
+	APIShieldDiscoveryStateSaved   APIShieldDiscoveryState = "saved"
+	APIShieldDiscoveryStateIgnored APIShieldDiscoveryState = "ignored"
+)
+
+// APIShieldDiscoveryOperation is an operation found by API discovery. Origin
+// lists how it was found, "ML" or "SessionIdentifier". Saved operations are
+// added to the zone's operations.
+type APIShieldDiscoveryOperation struct {
+	ID          string                  `json:"id"`
+	Method      string                  `json:"method"`
+	Host        string                  `json:"host"`
+	Endpoint    string                  `json:"endpoint"`
+	Origin      []string                `json:"origin"`
+	State       APIShieldDiscoveryState `json:"state"`
+	LastUpdated *time.Time              `json:"last_updated,omitempty"`
+	Features    map[string]interface{}  `json:"features,omitempty"`
+}
+
+// APIShieldDiscoveryListParams contains the filters available when listing
+// discovered operations. Diff limits the results to operations not saved
+// yet.
+type APIShieldDiscoveryListParams struct {
+	Host     []string                `url:"host,omitempty"`
+	Method   []string                `url:"method,omitempty"`
+	Endpoint string                  `url:"endpoint,omitempty"`
+	Origin   string                  `url:"origin,omitempty"`
+	State    APIShieldDiscoveryState `url:"state,omitempty"`
+	Diff     bool                    `url:"diff,omitempty"`
+	Order    string                  `url:"order,omitempty"`
+	PaginationParams
+}
+
+// APIShieldDiscoverySchemas holds the OpenAPI schemas built from discovered
+// operations and when they were last generated.
+type APIShieldDiscoverySchemas struct {
+	Schemas   []json.RawMessage `json:"schemas"`
+	Timestamp *time.Time        `json:"timestamp,omitempty"`
+}
+
+// APIShieldOperationSchemas holds the request parameter schemas learned for
+// an operation. ParameterSchemas is empty until enough traffic has been seen.
+type APIShieldOperationSchemas struct {
+	OperationID      string `json:"operation_id"`
+	ParameterSchemas struct {
+		LastUpdated      *time.Time      `json:"last_updated,omitempty"`
+		ParameterSchemas json.RawMessage `json:"parameter_schemas,omitempty"`
+	} `json:"parameter_schemas"`
+}
+
+// apiShieldDiscoveryStateParams is the request body of the discovered
+// operation update endpoints.
+type apiShieldDiscoveryStateParams struct {
+	State APIShieldDiscoveryState `json:"state"`
+}
+
+// APIShieldOperationResponse represents the response from the operations
+// endpoint containing a single operation.
+type APIShieldOperationResponse struct {
+	Response
+	Result APIShieldOperation `json:"result"`
+}
+
+// APIShieldOperationsResponse represents the response from the operations
+// endpoint containing multiple operations.
+type APIShieldOperationsResponse struct {
+	Response
+	Result     []APIShieldOperation `json:"result"`
+	ResultInfo ResultInfo           `json:"result_info"`
+}
+
+// APIShieldDiscoveryOperationResponse represents the response from the
+// discovered operations endpoint containing a single operation.
+type APIShieldDiscoveryOperationResponse struct {
+	Response
+	Result APIShieldDiscoveryOperation `json:"result"`
+}
+
+// APIShieldDiscoveryOperationsResponse represents the response from the
+// discovered operations endpoint containing multiple operations.
+type APIShieldDiscoveryOperationsResponse struct {
+	Response
+	Result     []APIShieldDiscoveryOperation `json:"result"`
+	ResultInfo ResultInfo                    `json:"result_info"`
+}
+
+// APIShieldDiscoveryStatesResponse represents the response from the bulk
+// discovered operation update endpoint, keyed by operation ID.
+type APIShieldDiscoveryStatesResponse struct {
+	Response
+	Result map[string]struct {
+		State APIShieldDiscoveryState `json:"state"`
+	} `json:"result"`
+}
+
+// APIShieldDiscoverySchemasResponse represents the response from the
+// discovery schemas endpoint.
+type APIShieldDiscoverySchemasResponse struct {
+	Response
+	Result APIShieldDiscoverySchemas `json:"result"`
+}
+
+// APIShieldOperationSchemasResponse represents the response from the
+// operation schemas endpoint.
+type APIShieldOperationSchemasResponse struct {
+	Response
+	Result APIShieldOperationSchemas `json:"result"`
+}
+
+// ListOperations returns the operations of a zone.
+//
+// API reference: https://api.cloudflare.com/#api-shield-endpoint-management-retrieve-information-about-all-operations-on-a-zone
+func (s *APIShieldService) ListOperations(ctx context.Context, zoneID string, params APIShieldOperationListParams) ([]APIShieldOperation, ResultInfo, error) {
+	var operations []APIShieldOperation
+	var info ResultInfo
+	err := s.ForEachOperationsPage(ctx, zoneID, params, func(page []APIShieldOperation, pageInfo ResultInfo) bool {
+		operations = append(operations, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []APIShieldOperation{}, ResultInfo{}, err
+	}
+
+	return operations, info, nil
+}
+
+// ForEachOperationsPage is like ListOperations but passes each page of results
+// to fn as it is fetched instead of collecting them. Paging stops early when
+// fn returns false.
+//
+// API reference: https://api.cloudflare.com/#api-shield-endpoint-management-retrieve-information-about-all-operations-on-a-zone
+func (s *APIShieldService) ForEachOperationsPage(ctx context.Context, zoneID string, params APIShieldOperationListParams, fn func(items []APIShieldOperation, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/api_gateway/operations", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r APIShieldOperationsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal api shield operation JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetOperation fetches a single operation, including the named features.
+//
+// API reference: https://api.cloudflare.com/#api-shield-endpoint-management-retrieve-information-about-an-operation
+func (s *APIShieldService) GetOperation(ctx context.Context, zoneID, operationID string, features ...string) (APIShieldOperation, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldOperation{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if operationID == "" {
+		return APIShieldOperation{}, fmt.Errorf(errMissingResourceID, "operation")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/api_gateway/operations/"+operationID, APIShieldOperationListParams{Features: features}), nil)
+	if err != nil {
+		return APIShieldOperation{}, err
+	}
+
+	var r APIShieldOperationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldOperation{}, fmt.Errorf("failed to unmarshal api shield operation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateOperations adds operations to a zone. Operations that already exist
+// are returned unchanged.
+//
+// API reference: https://api.cloudflare.com/#api-shield-endpoint-management-add-operations-to-a-zone
+func (s *APIShieldService) CreateOperations(ctx context.Context, zoneID string, operations []APIShieldOperation) ([]APIShieldOperation, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []APIShieldOperation{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(operations) == 0 {
+		return []APIShieldOperation{}, errors.New("at least one operation must be provided")
+	}
+
+	for i, op := range operations {
+		if op.Method == "" || op.Host == "" || op.Endpoint == "" {
+			return []APIShieldOperation{}, fmt.Errorf("operation %d: method, host and endpoint must be provided", i)
+		}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/api_gateway/operations", operations)
+	if err != nil {
+		return []APIShieldOperation{}, err
+	}
+
+	var r APIShieldOperationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []APIShieldOperation{}, fmt.Errorf("failed to unmarshal api shield operation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteOperation removes an operation.
+//
+// API reference: https://api.cloudflare.com/#api-shield-endpoint-management-delete-an-operation
+func (s *APIShieldService) DeleteOperation(ctx context.Context, zoneID, operationID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if operationID == "" {
+		return fmt.Errorf(errMissingResourceID, "operation")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/api_gateway/operations/"+operationID, nil)
+	return err
+}
+
+// GetOperationSchemas returns the request parameter schemas learned for an
+// operation.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-retrieve-operation-level-schemas
+func (s *APIShieldService) GetOperationSchemas(ctx context.Context, zoneID, operationID string) (APIShieldOperationSchemas, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldOperationSchemas{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if operationID == "" {
+		return APIShieldOperationSchemas{}, fmt.Errorf(errMissingResourceID, "operation")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/api_gateway/operations/"+operationID+"/schemas", nil)
+	if err != nil {
+		return APIShieldOperationSchemas{}, err
+	}
+
+	var r APIShieldOperationSchemasResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldOperationSchemas{}, fmt.Errorf("failed to unmarshal api shield operation schemas JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListDiscoveredOperations returns the operations found by API discovery.
+//
+// API reference: https://api.cloudflare.com/#api-shield-api-discovery-retrieve-discovered-operations-on-a-zone
+func (s *APIShieldService) ListDiscoveredOperations(ctx context.Context, zoneID string, params APIShieldDiscoveryListParams) ([]APIShieldDiscoveryOperation, ResultInfo, error) {
+	var operations []APIShieldDiscoveryOperation
+	var info ResultInfo
+	err := s.ForEachDiscoveredOperationsPage(ctx, zoneID, params, func(page []APIShieldDiscoveryOperation, pageInfo ResultInfo) bool {
+		operations = append(operations, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []APIShieldDiscoveryOperation{}, ResultInfo{}, err
+	}
+
+	return operations, info, nil
+}
+
+// ForEachDiscoveredOperationsPage is like ListDiscoveredOperations but passes
+// each page of results to fn as it is fetched instead of collecting them.
+// Paging stops early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#api-shield-api-discovery-retrieve-discovered-operations-on-a-zone
+func (s *APIShieldService) ForEachDiscoveredOperationsPage(ctx context.Context, zoneID string, params APIShieldDiscoveryListParams, fn func(items []APIShieldDiscoveryOperation, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/api_gateway/discovery/operations", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r APIShieldDiscoveryOperationsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal api shield discovered operation JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// UpdateDiscoveredOperation changes the review state of a discovered
+// operation. Saving an operation adds it to the operations of the zone.
+//
+// API reference: https://api.cloudflare.com/#api-shield-api-discovery-patch-discovered-operation
+func (s *APIShieldService) UpdateDiscoveredOperation(ctx context.Context, zoneID, operationID string, state APIShieldDiscoveryState) (APIShieldDiscoveryOperation, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldDiscoveryOperation{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if operationID == "" {
+		return APIShieldDiscoveryOperation{}, fmt.Errorf(errMissingResourceID, "operation")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/api_gateway/discovery/operations/"+operationID, apiShieldDiscoveryStateParams{State: state})
+	if err != nil {
+		return APIShieldDiscoveryOperation{}, err
+	}
+
+	var r APIShieldDiscoveryOperationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldDiscoveryOperation{}, fmt.Errorf("failed to unmarshal api shield discovered operation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateDiscoveredOperations changes the review state of several discovered
+// operations at once, keyed by operation ID.
+//
+// API reference: https://api.cloudflare.com/#api-shield-api-discovery-patch-discovered-operations
+func (s *APIShieldService) UpdateDiscoveredOperations(ctx context.Context, zoneID string, states map[string]APIShieldDiscoveryState) (map[string]APIShieldDiscoveryState, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return nil, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(states) == 0 {
+		return nil, errors.New("at least one operation must be provided")
+	}
+
+	body := make(map[string]apiShieldDiscoveryStateParams, len(states))
+	for id, state := range states {
+		body[id] = apiShieldDiscoveryStateParams{State: state}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/api_gateway/discovery/operations", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var r APIShieldDiscoveryStatesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal api shield discovered operation JSON data: %w", err)
+	}
+
+	result := make(map[string]APIShieldDiscoveryState, len(r.Result))
+	for id, op := range r.Result {
+		result[id] = op.State
+	}
+
+	return result, nil
+}
+
+// GetDiscoveredSchemas returns the OpenAPI schemas generated from the
+// operations found by API discovery, which can be exported to API catalogs.
+//
+// API reference: https://api.cloudflare.com/#api-shield-api-discovery-retrieve-discovered-operations-on-a-zone-as-openapi
+func (s *APIShieldService) GetDiscoveredSchemas(ctx context.Context, zoneID string) (APIShieldDiscoverySchemas, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldDiscoverySchemas{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/api_gateway/discovery", nil)
+	if err != nil {
+		return APIShieldDiscoverySchemas{}, err
+	}
+
+	var r APIShieldDiscoverySchemasResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldDiscoverySchemas{}, fmt.Errorf("failed to unmarshal api shield discovery schemas JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
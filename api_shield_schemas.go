@@ -0,0 +1,385 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type APIShieldService service
+
+// APIShieldMitigationAction is the action taken on requests that fail
+// schema validation. "none" disables mitigation.
+type APIShieldMitigationAction string
+
+const (
+	APIShieldMitigationActionNone  APIShieldMitigationAction = "none"
+	APIShieldMitigationActionLog   APIShieldMitigationAction = "log"
+	APIShieldMitigationActionBlock APIShieldMitigationAction = "block"
+)
+
+// APIShieldSchema is an OpenAPI schema requests to a zone are validated
+// against while ValidationEnabled is set. Source is only returned when
+// requested.
+type APIShieldSchema struct {
+	ID                string     `json:"schema_id,omitempty"`
+	Name              string     `json:"name"`
+	Kind              string     `json:"kind"`
+	Source            string     `json:"source,omitempty"`
+	ValidationEnabled bool       `json:"validation_enabled"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+}
+
+// APIShieldSchemaUploadParams contains a schema to upload. Source is the
+// schema document in JSON or YAML. Kind defaults to "openapi_v3".
+type APIShieldSchemaUploadParams struct {
+	Name              string `json:"name"`
+	Kind              string `json:"kind"`
+	Source            string `json:"source"`
+	ValidationEnabled bool   `json:"validation_enabled"`
+}
+
+// APIShieldSchemaListParams contains the options available when listing
+// schemas. Set OmitSource to leave the schema documents out of the results.
+type APIShieldSchemaListParams struct {
+	OmitSource        bool  `url:"omit_source,omitempty"`
+	ValidationEnabled *bool `url:"validation_enabled,omitempty"`
+	PaginationParams
+}
+
+// APIShieldValidationSettings are the zone wide schema validation settings.
+// DefaultMitigationAction applies to operations without their own action.
+// OverrideMitigationAction, when "none", disables mitigation everywhere
+// regardless of other settings; nil clears the override.
+type APIShieldValidationSettings struct {
+	DefaultMitigationAction  APIShieldMitigationAction  `json:"validation_default_mitigation_action"`
+	OverrideMitigationAction *APIShieldMitigationAction `json:"validation_override_mitigation_action"`
+}
+
+// APIShieldOperationValidation is the schema validation setting of a single
+// operation. A nil MitigationAction uses the zone default.
+type APIShieldOperationValidation struct {
+	OperationID      string                     `json:"operation_id,omitempty"`
+	MitigationAction *APIShieldMitigationAction `json:"mitigation_action"`
+}
+
+// apiShieldSchemaUpdateParams is the request body of the schema update
+// endpoint.
+type apiShieldSchemaUpdateParams struct {
+	ValidationEnabled bool `json:"validation_enabled"`
+}
+
+// APIShieldSchemaResponse represents the response from the schemas endpoint
+// containing a single schema.
+type APIShieldSchemaResponse struct {
+	Response
+	Result APIShieldSchema `json:"result"`
+}
+
+// APIShieldSchemasResponse represents the response from the schemas endpoint
+// containing multiple schemas.
+type APIShieldSchemasResponse struct {
+	Response
+	Result     []APIShieldSchema `json:"result"`
+	ResultInfo ResultInfo        `json:"result_info"`
+}
+
+// APIShieldValidationSettingsResponse represents the response from the
+// schema validation settings endpoint.
+type APIShieldValidationSettingsResponse struct {
+	Response
+	Result APIShieldValidationSettings `json:"result"`
+}
+
+// APIShieldOperationValidationResponse represents the response from the
+// operation schema validation settings endpoint.
+type APIShieldOperationValidationResponse struct {
+	Response
+	Result APIShieldOperationValidation `json:"result"`
+}
+
+// APIShieldOperationsValidationResponse represents the response from the
+// bulk operation schema validation settings endpoint, keyed by operation ID.
+type APIShieldOperationsValidationResponse struct {
+	Response
+	Result map[string]APIShieldOperationValidation `json:"result"`
+}
+
+// ListSchemas returns the schemas uploaded to a zone.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-list-all-uploaded-schemas
+func (s *APIShieldService) ListSchemas(ctx context.Context, zoneID string, params APIShieldSchemaListParams) ([]APIShieldSchema, ResultInfo, error) {
+	var schemas []APIShieldSchema
+	var info ResultInfo
+	err := s.ForEachSchemasPage(ctx, zoneID, params, func(page []APIShieldSchema, pageInfo ResultInfo) bool {
+		schemas = append(schemas, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []APIShieldSchema{}, ResultInfo{}, err
+	}
+
+	return schemas, info, nil
+}
+
+// ForEachSchemasPage is like ListSchemas but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-list-all-uploaded-schemas
+func (s *APIShieldService) ForEachSchemasPage(ctx context.Context, zoneID string, params APIShieldSchemaListParams, fn func(items []APIShieldSchema, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/schema_validation/schemas", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r APIShieldSchemasResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal api shield schema JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetSchema fetches a single schema, including its source unless omitSource
+// is set.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-retrieve-information-about-a-specific-schema-on-a-zone
+func (s *APIShieldService) GetSchema(ctx context.Context, zoneID, schemaID string, omitSource bool) (APIShieldSchema, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldSchema{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if schemaID == "" {
+		return APIShieldSchema{}, fmt.Errorf(errMissingResourceID, "schema")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/schema_validation/schemas/"+schemaID, APIShieldSchemaListParams{OmitSource: omitSource}), nil)
+	if err != nil {
+		return APIShieldSchema{}, err
+	}
+
+	var r APIShieldSchemaResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldSchema{}, fmt.Errorf("failed to unmarshal api shield schema JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UploadSchema uploads an OpenAPI schema to a zone.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-upload-a-schema
+func (s *APIShieldService) UploadSchema(ctx context.Context, zoneID string, params APIShieldSchemaUploadParams) (APIShieldSchema, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldSchema{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.Name == "" || params.Source == "" {
+		return APIShieldSchema{}, errors.New("name and source must be provided")
+	}
+
+	if params.Kind == "" {
+		params.Kind = "openapi_v3"
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/schema_validation/schemas", params)
+	if err != nil {
+		return APIShieldSchema{}, err
+	}
+
+	var r APIShieldSchemaResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldSchema{}, fmt.Errorf("failed to unmarshal api shield schema JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// SetSchemaValidation enables or disables validation of requests against a
+// schema.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-enable-validation-for-a-schema
+func (s *APIShieldService) SetSchemaValidation(ctx context.Context, zoneID, schemaID string, enabled bool) (APIShieldSchema, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldSchema{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if schemaID == "" {
+		return APIShieldSchema{}, fmt.Errorf(errMissingResourceID, "schema")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/schema_validation/schemas/"+schemaID, apiShieldSchemaUpdateParams{ValidationEnabled: enabled})
+	if err != nil {
+		return APIShieldSchema{}, err
+	}
+
+	var r APIShieldSchemaResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldSchema{}, fmt.Errorf("failed to unmarshal api shield schema JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteSchema removes a schema.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-delete-a-schema
+func (s *APIShieldService) DeleteSchema(ctx context.Context, zoneID, schemaID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if schemaID == "" {
+		return fmt.Errorf(errMissingResourceID, "schema")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/schema_validation/schemas/"+schemaID, nil)
+	return err
+}
+
+// GetValidationSettings returns the zone wide schema validation settings.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-retrieve-zone-level-schema-validation-settings
+func (s *APIShieldService) GetValidationSettings(ctx context.Context, zoneID string) (APIShieldValidationSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldValidationSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/schema_validation/settings", nil)
+	if err != nil {
+		return APIShieldValidationSettings{}, err
+	}
+
+	var r APIShieldValidationSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldValidationSettings{}, fmt.Errorf("failed to unmarshal api shield validation settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateValidationSettings replaces the zone wide schema validation
+// settings.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-update-zone-level-schema-validation-settings
+func (s *APIShieldService) UpdateValidationSettings(ctx context.Context, zoneID string, settings APIShieldValidationSettings) (APIShieldValidationSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldValidationSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	switch settings.DefaultMitigationAction {
+	case APIShieldMitigationActionNone, APIShieldMitigationActionLog, APIShieldMitigationActionBlock:
+	default:
+		return APIShieldValidationSettings{}, fmt.Errorf("invalid default mitigation action: %q", settings.DefaultMitigationAction)
+	}
+
+	if settings.OverrideMitigationAction != nil && *settings.OverrideMitigationAction != APIShieldMitigationActionNone {
+		return APIShieldValidationSettings{}, errors.New("override mitigation action can only be none")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/schema_validation/settings", settings)
+	if err != nil {
+		return APIShieldValidationSettings{}, err
+	}
+
+	var r APIShieldValidationSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldValidationSettings{}, fmt.Errorf("failed to unmarshal api shield validation settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetOperationValidation returns the schema validation setting of an
+// operation.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-retrieve-operation-level-schema-validation-settings
+func (s *APIShieldService) GetOperationValidation(ctx context.Context, zoneID, operationID string) (APIShieldOperationValidation, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldOperationValidation{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if operationID == "" {
+		return APIShieldOperationValidation{}, fmt.Errorf(errMissingResourceID, "operation")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/schema_validation/settings"+"/operations/"+operationID, nil)
+	if err != nil {
+		return APIShieldOperationValidation{}, err
+	}
+
+	var r APIShieldOperationValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldOperationValidation{}, fmt.Errorf("failed to unmarshal api shield operation validation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateOperationValidation sets the action taken on requests to an
+// operation that fail validation. A nil action uses the zone default.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-update-operation-level-schema-validation-settings
+func (s *APIShieldService) UpdateOperationValidation(ctx context.Context, zoneID, operationID string, action *APIShieldMitigationAction) (APIShieldOperationValidation, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return APIShieldOperationValidation{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if operationID == "" {
+		return APIShieldOperationValidation{}, fmt.Errorf(errMissingResourceID, "operation")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/schema_validation/settings"+"/operations/"+operationID, APIShieldOperationValidation{MitigationAction: action})
+	if err != nil {
+		return APIShieldOperationValidation{}, err
+	}
+
+	var r APIShieldOperationValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return APIShieldOperationValidation{}, fmt.Errorf("failed to unmarshal api shield operation validation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateOperationsValidation sets the schema validation actions of several
+// operations at once, keyed by operation ID.
+//
+// API reference: https://api.cloudflare.com/#api-shield-schema-validation-2-0-update-multiple-operation-level-schema-validation-settings
+func (s *APIShieldService) UpdateOperationsValidation(ctx context.Context, zoneID string, settings map[string]APIShieldOperationValidation) (map[string]APIShieldOperationValidation, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return nil, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(settings) == 0 {
+		return nil, errors.New("at least one operation must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/schema_validation/settings"+"/operations", settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var r APIShieldOperationsValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal api shield operation validation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,149 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrArgoNotEntitled is reported, via errors.Is, when an Argo setting cannot
+// be changed because the zone does not have the required subscription. The
+// underlying *APIRequestError remains available through errors.As.
+var ErrArgoNotEntitled = errors.New("zone does not have the subscription required for this Argo setting")
+
+type ArgoService service
+
+// ArgoSetting is the value of an Argo Smart Routing or Tiered Caching
+// setting.
+type ArgoSetting struct {
+	ID         string            `json:"id"`
+	Value      ZoneSettingToggle `json:"value"`
+	Editable   bool              `json:"editable"`
+	ModifiedOn *time.Time        `json:"modified_on,omitempty"`
+}
+
+// ArgoSettingResponse represents the response from the Argo settings
+// endpoints.
+type ArgoSettingResponse struct {
+	Response
+	Result ArgoSetting `json:"result"`
+}
+
+// argoSettingParams is the request body used to change an Argo setting.
+type argoSettingParams struct {
+	Value ZoneSettingToggle `json:"value"`
+}
+
+// argoEntitlementError wraps API errors caused by a missing subscription so
+// they match ErrArgoNotEntitled.
+type argoEntitlementError struct {
+	err *APIRequestError
+}
+
+func (e *argoEntitlementError) Error() string {
+	return ErrArgoNotEntitled.Error() + ": " + e.err.Error()
+}
+
+func (e *argoEntitlementError) Is(target error) bool {
+	return target == ErrArgoNotEntitled
+}
+
+func (e *argoEntitlementError) Unwrap() error {
+	return e.err
+}
+
+// GetSmartRouting returns whether Argo Smart Routing is enabled for a zone.
+//
+// API reference: https://api.cloudflare.com/#argo-smart-routing-get-argo-smart-routing-setting
+func (s *ArgoService) GetSmartRouting(ctx context.Context, zoneID string) (ArgoSetting, error) {
+	return s.getSetting(ctx, zoneID, "smart_routing")
+}
+
+// UpdateSmartRouting enables or disables Argo Smart Routing for a zone.
+// Enabling it requires an Argo subscription on the zone; otherwise the
+// returned error matches ErrArgoNotEntitled.
+//
+// API reference: https://api.cloudflare.com/#argo-smart-routing-patch-argo-smart-routing-setting
+func (s *ArgoService) UpdateSmartRouting(ctx context.Context, zoneID string, value ZoneSettingToggle) (ArgoSetting, error) {
+	return s.updateSetting(ctx, zoneID, "smart_routing", value)
+}
+
+// GetTieredCaching returns whether Argo Tiered Caching is enabled for a
+// zone.
+//
+// API reference: https://api.cloudflare.com/#tiered-caching-get-tiered-caching-setting
+func (s *ArgoService) GetTieredCaching(ctx context.Context, zoneID string) (ArgoSetting, error) {
+	return s.getSetting(ctx, zoneID, "tiered_caching")
+}
+
+// UpdateTieredCaching enables or disables Argo Tiered Caching for a zone.
+// Errors caused by the zone's plan match ErrArgoNotEntitled.
+//
+// API reference: https://api.cloudflare.com/#tiered-caching-patch-tiered-caching-setting
+func (s *ArgoService) UpdateTieredCaching(ctx context.Context, zoneID string, value ZoneSettingToggle) (ArgoSetting, error) {
+	return s.updateSetting(ctx, zoneID, "tiered_caching", value)
+}
+
+func (s *ArgoService) getSetting(ctx context.Context, zoneID, setting string) (ArgoSetting, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ArgoSetting{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/argo/"+setting, nil)
+	if err != nil {
+		return ArgoSetting{}, argoError(err)
+	}
+
+	var r ArgoSettingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ArgoSetting{}, fmt.Errorf("failed to unmarshal argo JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+func (s *ArgoService) updateSetting(ctx context.Context, zoneID, setting string, value ZoneSettingToggle) (ArgoSetting, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ArgoSetting{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if value != ZoneSettingOn && value != ZoneSettingOff {
+		return ArgoSetting{}, fmt.Errorf("invalid argo setting value: %q", value)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/argo/"+setting, argoSettingParams{Value: value})
+	if err != nil {
+		return ArgoSetting{}, argoError(err)
+	}
+
+	var r ArgoSettingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ArgoSetting{}, fmt.Errorf("failed to unmarshal argo JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// argoError marks API errors that were caused by a missing subscription
+// rather than bad credentials or input.
+func argoError(err error) error {
+	var apiErr *APIRequestError
+	if !errors.As(err, &apiErr) || !apiErr.ClientError() {
+		return err
+	}
+
+	for _, msg := range apiErr.ErrorMessages() {
+		msg = strings.ToLower(msg)
+		if strings.Contains(msg, "entitle") || strings.Contains(msg, "subscription") || strings.Contains(msg, "not enabled") {
+			return &argoEntitlementError{err: apiErr}
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,68 @@
+package cloudflare
+
+import "time"
+
+// AuditLogAction is a member of AuditLog, the action that was taken.
+type AuditLogAction struct {
+	Result bool   `json:"result"`
+	Type   string `json:"type"`
+}
+
+// AuditLogActor is a member of AuditLog, who performed the action.
+type AuditLogActor struct {
+	Email string `json:"email"`
+	ID    string `json:"id"`
+	IP    string `json:"ip"`
+	Type  string `json:"type"`
+}
+
+// AuditLogOwner is a member of AuditLog, who owns this audit log.
+type AuditLogOwner struct {
+	ID string `json:"id"`
+}
+
+// AuditLogResource is a member of AuditLog, what was the action performed on.
+type AuditLogResource struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// AuditLog is a single audit log entry.
+type AuditLog struct {
+	Action       AuditLogAction         `json:"action"`
+	Actor        AuditLogActor          `json:"actor"`
+	ID           string                 `json:"id"`
+	Interface    string                 `json:"interface"`
+	Metadata     map[string]interface{} `json:"metadata"`
+	NewValue     string                 `json:"newValue"`
+	NewValueJSON map[string]interface{} `json:"newValueJson"`
+	OldValue     string                 `json:"oldValue"`
+	OldValueJSON map[string]interface{} `json:"oldValueJson"`
+	Owner        AuditLogOwner          `json:"owner"`
+	Resource     AuditLogResource       `json:"resource"`
+	When         time.Time              `json:"when"`
+}
+
+// AuditLogsResponse is the response returned from the audit logs endpoints.
+type AuditLogsResponse struct {
+	Response
+	Result     []AuditLog `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// AuditLogListParams contains the filters available when listing audit logs.
+// Since and Before bound the time range, Direction orders the entries by
+// time ("asc" or "desc") and HideUserLogs excludes entries for actions
+// performed on the user rather than the account.
+type AuditLogListParams struct {
+	ID           string     `url:"id,omitempty"`
+	ActorIP      string     `url:"actor.ip,omitempty"`
+	ActorEmail   string     `url:"actor.email,omitempty"`
+	ActionType   string     `url:"action.type,omitempty"`
+	ZoneName     string     `url:"zone.name,omitempty"`
+	Since        *time.Time `url:"since,omitempty"`
+	Before       *time.Time `url:"before,omitempty"`
+	HideUserLogs bool       `url:"hide_user_logs,omitempty"`
+
+	PaginationParams
+}
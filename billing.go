@@ -0,0 +1,231 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BillingProfile is the billing contact and payment details of an account.
+type BillingProfile struct {
+	ID              string     `json:"id"`
+	FirstName       string     `json:"first_name"`
+	LastName        string     `json:"last_name"`
+	Company         string     `json:"company"`
+	Address         string     `json:"address"`
+	Address2        string     `json:"address2"`
+	City            string     `json:"city"`
+	State           string     `json:"state"`
+	Zipcode         string     `json:"zipcode"`
+	Country         string     `json:"country"`
+	Telephone       string     `json:"telephone"`
+	VAT             string     `json:"vat"`
+	PaymentGateway  string     `json:"payment_gateway"`
+	PaymentEmail    string     `json:"payment_email"`
+	CardNumber      string     `json:"card_number"`
+	CardExpiryYear  int        `json:"card_expiry_year"`
+	CardExpiryMonth int        `json:"card_expiry_month"`
+	CreatedOn       *time.Time `json:"created_on,omitempty"`
+	EditedOn        *time.Time `json:"edited_on,omitempty"`
+}
+
+// BillingHistoryItem is a charge or refund of the user's billing history.
+type BillingHistoryItem struct {
+	ID          string     `json:"id"`
+	Type        string     `json:"type"`
+	Action      string     `json:"action"`
+	Description string     `json:"description"`
+	Amount      float64    `json:"amount"`
+	Currency    string     `json:"currency"`
+	OccurredAt  *time.Time `json:"occurred_at,omitempty"`
+	Zone        struct {
+		Name string `json:"name"`
+	} `json:"zone"`
+}
+
+// BillingHistoryListParams contains the filters available when listing the
+// billing history. The API spells the OccurredAt filter occured_at.
+type BillingHistoryListParams struct {
+	Type       string     `url:"type,omitempty"`
+	Action     string     `url:"action,omitempty"`
+	OccurredAt *time.Time `url:"occured_at,omitempty"`
+	Order      string     `url:"order,omitempty"`
+
+	PaginationParams
+}
+
+// SubscriptionsResponse represents the response from the subscription
+// endpoints containing multiple subscriptions.
+type SubscriptionsResponse struct {
+	Response
+	Result []Subscription `json:"result"`
+}
+
+// BillingProfileResponse represents the response from the billing profile
+// endpoint.
+type BillingProfileResponse struct {
+	Response
+	Result BillingProfile `json:"result"`
+}
+
+// BillingHistoryResponse represents the response from the billing history
+// endpoint.
+type BillingHistoryResponse struct {
+	Response
+	Result     []BillingHistoryItem `json:"result"`
+	ResultInfo ResultInfo           `json:"result_info"`
+}
+
+// Subscriptions returns the subscriptions of an account.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-list-subscriptions
+func (s *AccountsService) Subscriptions(ctx context.Context, accountID string) ([]Subscription, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []Subscription{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/subscriptions", nil)
+	if err != nil {
+		return []Subscription{}, err
+	}
+
+	var r SubscriptionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Subscription{}, fmt.Errorf("failed to unmarshal account subscription JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateSubscription subscribes an account to a plan.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-create-subscription
+func (s *AccountsService) CreateSubscription(ctx context.Context, accountID string, params SubscriptionParams) (Subscription, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Subscription{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.RatePlan == nil || params.RatePlan.ID == "" {
+		return Subscription{}, errors.New("rate plan ID must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/subscriptions", params)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("failed to unmarshal account subscription JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSubscription changes the plan, frequency or components of a
+// subscription of an account.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-update-subscription
+func (s *AccountsService) UpdateSubscription(ctx context.Context, accountID, subscriptionID string, params SubscriptionParams) (Subscription, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Subscription{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if subscriptionID == "" {
+		return Subscription{}, fmt.Errorf(errMissingResourceID, "account subscription")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/subscriptions/"+subscriptionID, params)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("failed to unmarshal account subscription JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteSubscription cancels a subscription of an account.
+//
+// API reference: https://api.cloudflare.com/#account-subscriptions-delete-subscription
+func (s *AccountsService) DeleteSubscription(ctx context.Context, accountID, subscriptionID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if subscriptionID == "" {
+		return fmt.Errorf(errMissingResourceID, "account subscription")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/subscriptions/"+subscriptionID, nil)
+	return err
+}
+
+// BillingProfile returns the billing profile of an account.
+//
+// API reference: https://api.cloudflare.com/#account-billing-profile-billing-profile-details
+func (s *AccountsService) BillingProfile(ctx context.Context, accountID string) (BillingProfile, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return BillingProfile{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/billing/profile", nil)
+	if err != nil {
+		return BillingProfile{}, err
+	}
+
+	var r BillingProfileResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return BillingProfile{}, fmt.Errorf("failed to unmarshal billing profile JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// BillingHistory returns the charges and refunds billed to the user that
+// match the provided `BillingHistoryListParams`. Every page is fetched unless
+// params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#user-billing-history-billing-history-details
+func (s *UserService) BillingHistory(ctx context.Context, params BillingHistoryListParams) ([]BillingHistoryItem, ResultInfo, error) {
+	var history []BillingHistoryItem
+	var info ResultInfo
+	err := s.ForEachBillingHistoryPage(ctx, params, func(page []BillingHistoryItem, pageInfo ResultInfo) bool {
+		history = append(history, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []BillingHistoryItem{}, ResultInfo{}, err
+	}
+
+	return history, info, nil
+}
+
+// ForEachBillingHistoryPage is like BillingHistory but passes each page of
+// results to fn as it is fetched instead of collecting them. Paging stops
+// early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#user-billing-history-billing-history-details
+func (s *UserService) ForEachBillingHistoryPage(ctx context.Context, params BillingHistoryListParams, fn func(items []BillingHistoryItem, info ResultInfo) bool) error {
+	return s.client.listPages(ctx, "/user/billing/history", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r BillingHistoryResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal billing history JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
@@ -0,0 +1,168 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type BotManagementService service
+
+// BotManagementAction is the action taken on a category of bot traffic by
+// Super Bot Fight Mode.
+type BotManagementAction string
+
+const (
+	BotManagementActionAllow            BotManagementAction = "allow"
+	BotManagementActionBlock            BotManagementAction = "block"
+	BotManagementActionManagedChallenge BotManagementAction = "managed_challenge"
+)
+
+// BotManagement is the bot protection configuration of a zone. The fields
+// that apply depend on the zone's plan:
+//
+//   - Free zones use FightMode (Bot Fight Mode).
+//   - Pro and Business zones use the SBFM fields (Super Bot Fight Mode) and
+//     OptimizeWordpress. SBFMLikelyAutomated requires Business.
+//   - Enterprise zones with Bot Management use SuppressSessionScore and
+//     AutoUpdateModel.
+//
+// EnableJS and AIBotsProtection are available on every plan. Unset fields
+// are left unchanged by Update.
+type BotManagement struct {
+	EnableJS                     *bool               `json:"enable_js,omitempty"`
+	FightMode                    *bool               `json:"fight_mode,omitempty"`
+	AIBotsProtection             string              `json:"ai_bots_protection,omitempty"`
+	SBFMDefinitelyAutomated      BotManagementAction `json:"sbfm_definitely_automated,omitempty"`
+	SBFMLikelyAutomated          BotManagementAction `json:"sbfm_likely_automated,omitempty"`
+	SBFMVerifiedBots             BotManagementAction `json:"sbfm_verified_bots,omitempty"`
+	SBFMStaticResourceProtection *bool               `json:"sbfm_static_resource_protection,omitempty"`
+	OptimizeWordpress            *bool               `json:"optimize_wordpress,omitempty"`
+	SuppressSessionScore         *bool               `json:"suppress_session_score,omitempty"`
+	AutoUpdateModel              *bool               `json:"auto_update_model,omitempty"`
+	UsingLatestModel             bool                `json:"using_latest_model,omitempty"`
+}
+
+// BotManagementResponse represents the response from the bot management
+// endpoint.
+type BotManagementResponse struct {
+	Response
+	Result BotManagement `json:"result"`
+}
+
+// Get returns the bot protection configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#bot-settings-get-zone-bot-management-config
+func (s *BotManagementService) Get(ctx context.Context, zoneID string) (BotManagement, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return BotManagement{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/bot_management", nil)
+	if err != nil {
+		return BotManagement{}, err
+	}
+
+	var r BotManagementResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return BotManagement{}, fmt.Errorf("failed to unmarshal bot management JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the bot protection configuration of a zone. The zone's plan
+// is looked up first and fields that do not apply to it are rejected before
+// any change is made.
+//
+// API reference: https://api.cloudflare.com/#bot-settings-update-zone-bot-management-config
+func (s *BotManagementService) Update(ctx context.Context, zoneID string, params BotManagement) (BotManagement, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return BotManagement{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	zone, err := s.client.Zones.Get(ctx, zoneID)
+	if err != nil {
+		return BotManagement{}, err
+	}
+
+	if err := validateBotManagement(zone.Plan.LegacyID, params); err != nil {
+		return BotManagement{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/bot_management", params)
+	if err != nil {
+		return BotManagement{}, err
+	}
+
+	var r BotManagementResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return BotManagement{}, fmt.Errorf("failed to unmarshal bot management JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// validateBotManagement checks that every field set in params is available
+// on plan, a zone plan legacy ID. Unknown plans are not validated.
+func validateBotManagement(plan string, params BotManagement) error {
+	for field, action := range map[string]BotManagementAction{
+		"sbfm_definitely_automated": params.SBFMDefinitelyAutomated,
+		"sbfm_likely_automated":     params.SBFMLikelyAutomated,
+		"sbfm_verified_bots":        params.SBFMVerifiedBots,
+	} {
+		switch action {
+		case "", BotManagementActionAllow, BotManagementActionBlock, BotManagementActionManagedChallenge:
+		default:
+			return fmt.Errorf("invalid %s action: %q", field, action)
+		}
+	}
+
+	if params.AIBotsProtection != "" && params.AIBotsProtection != "block" && params.AIBotsProtection != "disabled" {
+		return fmt.Errorf("invalid ai_bots_protection value: %q", params.AIBotsProtection)
+	}
+
+	sbfm := params.SBFMDefinitelyAutomated != "" || params.SBFMVerifiedBots != "" ||
+		params.SBFMStaticResourceProtection != nil || params.OptimizeWordpress != nil
+	enterprise := params.SuppressSessionScore != nil || params.AutoUpdateModel != nil
+
+	var unavailable string
+	switch plan {
+	case "free":
+		switch {
+		case sbfm || params.SBFMLikelyAutomated != "":
+			unavailable = "super bot fight mode settings are"
+		case enterprise:
+			unavailable = "bot management settings are"
+		}
+	case "pro":
+		switch {
+		case params.FightMode != nil:
+			unavailable = "fight_mode is"
+		case params.SBFMLikelyAutomated != "":
+			unavailable = "sbfm_likely_automated is"
+		case enterprise:
+			unavailable = "bot management settings are"
+		}
+	case "business":
+		switch {
+		case params.FightMode != nil:
+			unavailable = "fight_mode is"
+		case enterprise:
+			unavailable = "bot management settings are"
+		}
+	case "enterprise":
+		if params.FightMode != nil {
+			unavailable = "fight_mode is"
+		}
+	}
+
+	if unavailable != "" {
+		return fmt.Errorf("%s not available on the %s plan", unavailable, plan)
+	}
+
+	return nil
+}
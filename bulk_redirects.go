@@ -0,0 +1,166 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// defaultBulkRedirectsChunkSize is the number of redirects sent in each bulk
+// item operation when BulkRedirectsParams.ChunkSize is not set.
+const defaultBulkRedirectsChunkSize = 1000
+
+// bulkRedirectsKey is the request field matched against the source URLs of a
+// redirect list.
+const bulkRedirectsKey = "http.request.full_uri"
+
+// BulkRedirectsParams contains the redirects applied by SyncBulkRedirects.
+//
+// ListName names the redirect list holding the redirects; it is created if
+// it does not exist. Redirects replace the current items of the list in
+// chunks of ChunkSize items, each waited on before the next is sent every
+// PollInterval. RuleDescription describes the rule of the account's redirect
+// ruleset that enables the list.
+type BulkRedirectsParams struct {
+	ListName        string
+	ListDescription string
+	Redirects       []ListItemRedirect
+	RuleDescription string
+	ChunkSize       int
+	PollInterval    time.Duration
+}
+
+// SyncBulkRedirects makes the redirects of a Bulk Redirects list match
+// params.Redirects and ensures the account's http_request_redirect entry
+// point ruleset has an enabled rule redirecting requests found in the list.
+// Other rules of the ruleset are left unchanged.
+//
+// The list is updated in several operations when there are more than
+// ChunkSize redirects, so requests may briefly see a partial list.
+func (s *ListsService) SyncBulkRedirects(ctx context.Context, accountID string, params BulkRedirectsParams) (List, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return List{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.ListName == "" {
+		return List{}, errors.New("list name must be provided")
+	}
+
+	for i, redirect := range params.Redirects {
+		if redirect.SourceURL == "" || redirect.TargetURL == "" {
+			return List{}, fmt.Errorf("redirect %d: source and target URLs must be provided", i)
+		}
+	}
+
+	chunkSize := params.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultBulkRedirectsChunkSize
+	}
+
+	list, err := s.redirectList(ctx, accountID, params.ListName, params.ListDescription)
+	if err != nil {
+		return List{}, err
+	}
+
+	items := make([]ListItem, 0, len(params.Redirects))
+	for i := range params.Redirects {
+		items = append(items, ListItem{Redirect: &params.Redirects[i]})
+	}
+
+	// The first chunk replaces the list and the rest are appended to it.
+	for start := 0; start == 0 || start < len(items); start += chunkSize {
+		end := start + chunkSize
+		if end > len(items) {
+			end = len(items)
+		}
+
+		var operationID string
+		if start == 0 {
+			operationID, err = s.ReplaceItems(ctx, accountID, list.ID, items[start:end])
+		} else {
+			operationID, err = s.CreateItems(ctx, accountID, list.ID, items[start:end])
+		}
+		if err != nil {
+			return List{}, err
+		}
+
+		if _, err := s.WaitForBulkOperation(ctx, accountID, operationID, params.PollInterval); err != nil {
+			return List{}, err
+		}
+	}
+
+	if err := s.bulkRedirectsRule(ctx, accountID, list.Name, params.RuleDescription); err != nil {
+		return List{}, err
+	}
+
+	return s.Get(ctx, accountID, list.ID)
+}
+
+// redirectList returns the redirect list named name, creating it if needed.
+func (s *ListsService) redirectList(ctx context.Context, accountID, name, description string) (List, error) {
+	lists, err := s.List(ctx, accountID)
+	if err != nil {
+		return List{}, err
+	}
+
+	for _, list := range lists {
+		if list.Name != name {
+			continue
+		}
+
+		if list.Kind != ListKindRedirect {
+			return List{}, fmt.Errorf("list %s is a %s list, not a redirect list", name, list.Kind)
+		}
+		return list, nil
+	}
+
+	return s.Create(ctx, accountID, List{Name: name, Description: description, Kind: ListKindRedirect})
+}
+
+// bulkRedirectsRule adds a rule enabling the redirect list named listName to
+// the account's redirect entry point ruleset, or enables and updates the
+// existing one.
+func (s *ListsService) bulkRedirectsRule(ctx context.Context, accountID, listName, description string) error {
+	rc := AccountIdentifier(accountID)
+
+	ruleset, err := s.client.Rulesets.GetEntrypoint(ctx, rc, RulesetPhaseHTTPRequestRedirect)
+	if err != nil {
+		// The entry point ruleset does not exist until it is first updated.
+		var apiErr *APIRequestError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			return err
+		}
+	}
+
+	rule := RulesetRule{
+		Action:      RulesetRuleActionRedirect,
+		Expression:  bulkRedirectsKey + " in $" + listName,
+		Description: description,
+		Enabled:     Bool(true),
+		ActionParameters: &RulesetRuleActionParameters{
+			FromList: &RulesetRuleActionParametersFromList{Name: listName, Key: bulkRedirectsKey},
+		},
+	}
+
+	rules := make([]RulesetRule, 0, len(ruleset.Rules)+1)
+	found := false
+	for _, r := range ruleset.Rules {
+		if r.ActionParameters != nil && r.ActionParameters.FromList != nil && r.ActionParameters.FromList.Name == listName {
+			rule.ID = r.ID
+			if rule.Description == "" {
+				rule.Description = r.Description
+			}
+			r = rule
+			found = true
+		}
+		rules = append(rules, r)
+	}
+	if !found {
+		rules = append(rules, rule)
+	}
+
+	_, err = s.client.Rulesets.UpdateEntrypoint(ctx, rc, RulesetPhaseHTTPRequestRedirect, RulesetParams{Rules: rules})
+	return err
+}
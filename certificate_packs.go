@@ -0,0 +1,285 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type CertificatePacksService service
+
+// CertificatePackCertificateAuthority is the CA used to issue an advanced
+// certificate pack.
+type CertificatePackCertificateAuthority string
+
+// CertificatePackValidationMethod is the method used to validate domain
+// control for an advanced certificate pack.
+type CertificatePackValidationMethod string
+
+const (
+	CertificatePackCertificateAuthorityDigiCert    CertificatePackCertificateAuthority = "digicert"
+	CertificatePackCertificateAuthorityLetsEncrypt CertificatePackCertificateAuthority = "lets_encrypt"
+	CertificatePackCertificateAuthorityGoogle      CertificatePackCertificateAuthority = "google"
+	CertificatePackCertificateAuthoritySSLCom      CertificatePackCertificateAuthority = "ssl_com"
+
+	CertificatePackValidationMethodTXT   CertificatePackValidationMethod = "txt"
+	CertificatePackValidationMethodHTTP  CertificatePackValidationMethod = "http"
+	CertificatePackValidationMethodEmail CertificatePackValidationMethod = "email"
+)
+
+// CertificatePackGeoRestrictions is for the structure of the geographic
+// restrictions for a TLS certificate.
+type CertificatePackGeoRestrictions struct {
+	Label string `json:"label"`
+}
+
+// CertificatePackCertificate is the base structure of a TLS certificate that
+// is contained within a certificate pack.
+type CertificatePackCertificate struct {
+	ID              string                         `json:"id"`
+	Hosts           []string                       `json:"hosts"`
+	Issuer          string                         `json:"issuer"`
+	Signature       string                         `json:"signature"`
+	Status          string                         `json:"status"`
+	BundleMethod    string                         `json:"bundle_method"`
+	GeoRestrictions CertificatePackGeoRestrictions `json:"geo_restrictions"`
+	ZoneID          string                         `json:"zone_id"`
+	UploadedOn      time.Time                      `json:"uploaded_on"`
+	ModifiedOn      time.Time                      `json:"modified_on"`
+	ExpiresOn       time.Time                      `json:"expires_on"`
+	Priority        int                            `json:"priority"`
+}
+
+// CertificatePack is the overarching structure of a certificate pack response.
+type CertificatePack struct {
+	ID                   string                              `json:"id"`
+	Type                 string                              `json:"type"`
+	Hosts                []string                            `json:"hosts"`
+	Certificates         []CertificatePackCertificate        `json:"certificates"`
+	PrimaryCertificate   string                              `json:"primary_certificate"`
+	Status               string                              `json:"status"`
+	ValidationRecords    []SSLValidationRecord               `json:"validation_records,omitempty"`
+	ValidationErrors     []SSLValidationError                `json:"validation_errors,omitempty"`
+	ValidationMethod     CertificatePackValidationMethod     `json:"validation_method"`
+	ValidityDays         int                                 `json:"validity_days"`
+	CertificateAuthority CertificatePackCertificateAuthority `json:"certificate_authority"`
+	CloudflareBranding   bool                                `json:"cloudflare_branding"`
+}
+
+// CertificatePackResponse represents the response from the certificate packs
+// endpoint containing a single certificate pack.
+type CertificatePackResponse struct {
+	Response
+	Result CertificatePack `json:"result"`
+}
+
+// CertificatePacksResponse represents the response from the certificate packs
+// endpoint containing multiple certificate packs.
+type CertificatePacksResponse struct {
+	Response
+	Result     []CertificatePack `json:"result"`
+	ResultInfo ResultInfo        `json:"result_info"`
+}
+
+// CertificatePackListParams contains the filters available when listing
+// certificate packs. Status may be set to "all" to include packs that are not
+// yet active.
+type CertificatePackListParams struct {
+	Status string `url:"status,omitempty"`
+
+	PaginationParams
+}
+
+// CertificatePackOrderParams contains the details needed to order a new
+// advanced certificate pack.
+type CertificatePackOrderParams struct {
+	Type                 string                              `json:"type"`
+	Hosts                []string                            `json:"hosts"`
+	ValidationMethod     CertificatePackValidationMethod     `json:"validation_method"`
+	ValidityDays         int                                 `json:"validity_days"`
+	CertificateAuthority CertificatePackCertificateAuthority `json:"certificate_authority"`
+	CloudflareBranding   bool                                `json:"cloudflare_branding"`
+}
+
+// CertificatePackQuota is the number of packs that can be ordered for a zone
+// and how many have been used.
+type CertificatePackQuota struct {
+	Allocated int `json:"allocated"`
+	Used      int `json:"used"`
+}
+
+// CertificatePackQuotaResponse represents the response from the certificate
+// pack quota endpoint.
+type CertificatePackQuotaResponse struct {
+	Response
+	Result struct {
+		Advanced CertificatePackQuota `json:"advanced"`
+	} `json:"result"`
+}
+
+// List returns all certificate packs for a zone that match the provided
+// `CertificatePackListParams`.
+//
+// API reference: https://api.cloudflare.com/#certificate-packs-list-certificate-packs
+func (s *CertificatePacksService) List(ctx context.Context, zoneID string, params CertificatePackListParams) ([]CertificatePack, ResultInfo, error) {
+	var packs []CertificatePack
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []CertificatePack, pageInfo ResultInfo) bool {
+		packs = append(packs, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []CertificatePack{}, ResultInfo{}, err
+	}
+
+	return packs, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#certificate-packs-list-certificate-packs
+func (s *CertificatePacksService) ForEachPage(ctx context.Context, zoneID string, params CertificatePackListParams, fn func(items []CertificatePack, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/ssl/certificate_packs", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r CertificatePacksResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal certificate pack JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single certificate pack.
+//
+// API reference: https://api.cloudflare.com/#certificate-packs-get-certificate-pack
+func (s *CertificatePacksService) Get(ctx context.Context, zoneID, certificatePackID string) (CertificatePack, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CertificatePack{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if certificatePackID == "" {
+		return CertificatePack{}, fmt.Errorf(errMissingResourceID, "certificate pack")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/ssl/certificate_packs/"+certificatePackID, nil)
+	if err != nil {
+		return CertificatePack{}, err
+	}
+
+	var r CertificatePackResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CertificatePack{}, fmt.Errorf("failed to unmarshal certificate pack JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Order requests a new advanced certificate pack. Type defaults to "advanced"
+// when left empty.
+//
+// API reference: https://api.cloudflare.com/#certificate-packs-order-advanced-certificate-manager-certificate-pack
+func (s *CertificatePacksService) Order(ctx context.Context, zoneID string, params CertificatePackOrderParams) (CertificatePack, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CertificatePack{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(params.Hosts) == 0 {
+		return CertificatePack{}, errors.New("at least one host is required to order a certificate pack")
+	}
+
+	if params.Type == "" {
+		params.Type = "advanced"
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/ssl/certificate_packs/order", params)
+	if err != nil {
+		return CertificatePack{}, err
+	}
+
+	var r CertificatePackResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CertificatePack{}, fmt.Errorf("failed to unmarshal certificate pack JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// RestartValidation restarts domain control validation of an advanced
+// certificate pack that is pending validation.
+//
+// API reference: https://api.cloudflare.com/#certificate-packs-restart-validation-for-advanced-certificate-manager-certificate-pack
+func (s *CertificatePacksService) RestartValidation(ctx context.Context, zoneID, certificatePackID string) (CertificatePack, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CertificatePack{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if certificatePackID == "" {
+		return CertificatePack{}, fmt.Errorf(errMissingResourceID, "certificate pack")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/ssl/certificate_packs/"+certificatePackID, nil)
+	if err != nil {
+		return CertificatePack{}, err
+	}
+
+	var r CertificatePackResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CertificatePack{}, fmt.Errorf("failed to unmarshal certificate pack JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes an advanced certificate pack from a zone.
+//
+// API reference: https://api.cloudflare.com/#certificate-packs-delete-advanced-certificate-manager-certificate-pack
+func (s *CertificatePacksService) Delete(ctx context.Context, zoneID, certificatePackID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if certificatePackID == "" {
+		return fmt.Errorf(errMissingResourceID, "certificate pack")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/ssl/certificate_packs/"+certificatePackID, nil)
+	return err
+}
+
+// Quota returns the number of advanced certificate packs allocated to and used
+// by a zone.
+//
+// API reference: https://api.cloudflare.com/#certificate-packs-get-certificate-pack-quotas
+func (s *CertificatePacksService) Quota(ctx context.Context, zoneID string) (CertificatePackQuota, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CertificatePackQuota{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/ssl/certificate_packs/quota", nil)
+	if err != nil {
+		return CertificatePackQuota{}, err
+	}
+
+	var r CertificatePackQuotaResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CertificatePackQuota{}, fmt.Errorf("failed to unmarshal certificate pack quota JSON data: %w", err)
+	}
+
+	return r.Result.Advanced, nil
+}
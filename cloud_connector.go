@@ -0,0 +1,181 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type CloudConnectorService service
+
+// CloudConnectorProvider is the cloud storage provider a Cloud Connector
+// rule sends requests to.
+type CloudConnectorProvider string
+
+const (
+	CloudConnectorProviderAWSS3        CloudConnectorProvider = "aws_s3"
+	CloudConnectorProviderR2           CloudConnectorProvider = "cloudflare_r2"
+	CloudConnectorProviderGCPStorage   CloudConnectorProvider = "gcp_storage"
+	CloudConnectorProviderAzureStorage CloudConnectorProvider = "azure_storage"
+)
+
+// CloudConnectorRule routes requests matching Expression to a bucket of a
+// cloud storage provider. Rules are evaluated in order and the first match
+// applies.
+type CloudConnectorRule struct {
+	ID          string                       `json:"id,omitempty"`
+	Enabled     bool                         `json:"enabled"`
+	Expression  string                       `json:"expression"`
+	Description string                       `json:"description,omitempty"`
+	Provider    CloudConnectorProvider       `json:"provider"`
+	Parameters  CloudConnectorRuleParameters `json:"parameters"`
+}
+
+// CloudConnectorRuleParameters holds the provider specific settings of a
+// rule. Host is the hostname of the bucket; the helpers CloudConnectorS3,
+// CloudConnectorGCS, CloudConnectorAzure and CloudConnectorR2 build it.
+type CloudConnectorRuleParameters struct {
+	Host string `json:"host"`
+}
+
+// CloudConnectorRulesResponse represents the response from the Cloud
+// Connector rules endpoint.
+type CloudConnectorRulesResponse struct {
+	Response
+	Result []CloudConnectorRule `json:"result"`
+}
+
+// CloudConnectorS3 returns a rule sending requests matching expression to an
+// Amazon S3 bucket in region.
+func CloudConnectorS3(expression, bucket, region string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Enabled:    true,
+		Expression: expression,
+		Provider:   CloudConnectorProviderAWSS3,
+		Parameters: CloudConnectorRuleParameters{Host: bucket + ".s3." + region + ".amazonaws.com"},
+	}
+}
+
+// CloudConnectorGCS returns a rule sending requests matching expression to a
+// Google Cloud Storage bucket.
+func CloudConnectorGCS(expression, bucket string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Enabled:    true,
+		Expression: expression,
+		Provider:   CloudConnectorProviderGCPStorage,
+		Parameters: CloudConnectorRuleParameters{Host: bucket + ".storage.googleapis.com"},
+	}
+}
+
+// CloudConnectorAzure returns a rule sending requests matching expression to
+// the blob storage of an Azure storage account.
+func CloudConnectorAzure(expression, storageAccount string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Enabled:    true,
+		Expression: expression,
+		Provider:   CloudConnectorProviderAzureStorage,
+		Parameters: CloudConnectorRuleParameters{Host: storageAccount + ".blob.core.windows.net"},
+	}
+}
+
+// CloudConnectorR2 returns a rule sending requests matching expression to
+// the public hostname of an R2 bucket, such as a custom domain or an r2.dev
+// subdomain.
+func CloudConnectorR2(expression, host string) CloudConnectorRule {
+	return CloudConnectorRule{
+		Enabled:    true,
+		Expression: expression,
+		Provider:   CloudConnectorProviderR2,
+		Parameters: CloudConnectorRuleParameters{Host: host},
+	}
+}
+
+// ListRules returns the Cloud Connector rules of a zone in evaluation order.
+//
+// API reference: https://api.cloudflare.com/#zone-cloud-connector-rules
+func (s *CloudConnectorService) ListRules(ctx context.Context, zoneID string) ([]CloudConnectorRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []CloudConnectorRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/cloud_connector/rules", nil)
+	if err != nil {
+		return []CloudConnectorRule{}, err
+	}
+
+	var r CloudConnectorRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CloudConnectorRule{}, fmt.Errorf("failed to unmarshal cloud connector rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateRules replaces the Cloud Connector rules of a zone. Each rule is
+// checked against the host format of its provider before the request is made.
+//
+// API reference: https://api.cloudflare.com/#zone-cloud-connector-put-rules
+func (s *CloudConnectorService) UpdateRules(ctx context.Context, zoneID string, rules []CloudConnectorRule) ([]CloudConnectorRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []CloudConnectorRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	for i, rule := range rules {
+		if err := validateCloudConnectorRule(rule); err != nil {
+			return []CloudConnectorRule{}, fmt.Errorf("cloud connector rule %d: %w", i, err)
+		}
+	}
+
+	if rules == nil {
+		rules = []CloudConnectorRule{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/cloud_connector/rules", rules)
+	if err != nil {
+		return []CloudConnectorRule{}, err
+	}
+
+	var r CloudConnectorRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CloudConnectorRule{}, fmt.Errorf("failed to unmarshal cloud connector rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// validateCloudConnectorRule checks that a rule has an expression and that
+// its host matches the format used by its provider.
+func validateCloudConnectorRule(rule CloudConnectorRule) error {
+	if rule.Expression == "" {
+		return errors.New("expression must be provided")
+	}
+
+	host := rule.Parameters.Host
+	if host == "" || strings.ContainsAny(host, ":/ ") {
+		return fmt.Errorf("invalid host: %q", host)
+	}
+
+	var suffix string
+	switch rule.Provider {
+	case CloudConnectorProviderAWSS3:
+		suffix = ".amazonaws.com"
+	case CloudConnectorProviderGCPStorage:
+		suffix = ".googleapis.com"
+	case CloudConnectorProviderAzureStorage:
+		suffix = ".blob.core.windows.net"
+	case CloudConnectorProviderR2:
+	default:
+		return fmt.Errorf("invalid provider: %q", rule.Provider)
+	}
+
+	if suffix != "" && !strings.HasSuffix(host, suffix) {
+		return fmt.Errorf("host %s is not a %s host", host, rule.Provider)
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/go-querystring/query"
 	"github.com/pkg/errors"
 	"golang.org/x/time/rate"
 )
@@ -30,10 +31,13 @@ const (
 
 	AccountRouteType RouteType = "accounts"
 	ZoneRouteType    RouteType = "zones"
+	UserRouteType    RouteType = "user"
 
 	testAccountID    = "01a7362d577a6c3019a474fd6f485823"
 	testZoneID       = "d56084adb405e0b7e32c52321bf07be6"
 	testCertPackUUID = "a77f8bd7-3b47-46b4-a6f1-75cf98109948"
+
+	defaultPerPage = 50
 )
 
 var (
@@ -53,9 +57,11 @@ type ClientParams struct {
 	UserAgent      string
 	Headers        http.Header
 	HTTPClient     *http.Client
+	Transport      http.RoundTripper
 	RateLimiter    *rate.Limiter
 	RetryPolicy    RetryPolicy
 	Logger         Logger
+	Middleware     []RequestMiddleware
 }
 
 // A Client manages communication with the Cloudflare API.
@@ -66,7 +72,73 @@ type Client struct {
 
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
-	Zones *ZonesService
+	Zones          *ZonesService
+	Accounts       *AccountsService
+	AccountMembers *AccountMembersService
+	AccountRoles   *AccountRolesService
+	User           *UserService
+
+	OriginCACertificates  *OriginCACertificatesService
+	CustomHostnames       *CustomHostnamesService
+	CustomCertificates    *CustomCertificatesService
+	CertificatePacks      *CertificatePacksService
+	ZoneSettings          *ZoneSettingsService
+	Rulesets              *RulesetsService
+	Filters               *FiltersService
+	FirewallRules         *FirewallRulesService
+	RateLimits            *RateLimitsService
+	AccessRules           *AccessRulesService
+	ZoneLockdowns         *ZoneLockdownsService
+	UserAgentRules        *UserAgentRulesService
+	WorkerRoutes          *WorkerRoutesService
+	WorkersKV             *WorkersKVService
+	Workers               *WorkersService
+	DurableObjects        *DurableObjectsService
+	Queues                *QueuesService
+	D1                    *D1Service
+	R2                    *R2Service
+	Pages                 *PagesService
+	LoadBalancers         *LoadBalancersService
+	HealthChecks          *HealthChecksService
+	Spectrum              *SpectrumService
+	Argo                  *ArgoService
+	Tunnels               *TunnelsService
+	Access                *AccessService
+	Gateway               *GatewayService
+	Devices               *DevicesService
+	DLP                   *DLPService
+	DEX                   *DEXService
+	Logpush               *LogpushService
+	Logs                  *LogsService
+	Notifications         *NotificationsService
+	Registrar             *RegistrarService
+	Intel                 *IntelService
+	URLScanner            *URLScannerService
+	Stream                *StreamService
+	Images                *ImagesService
+	WaitingRooms          *WaitingRoomsService
+	BotManagement         *BotManagementService
+	PageShield            *PageShieldService
+	EmailRouting          *EmailRoutingService
+	MagicTransit          *MagicTransitService
+	Analytics             *AnalyticsService
+	Turnstile             *TurnstileService
+	Web3                  *Web3Service
+	Lists                 *ListsService
+	ManagedHeaders        *ManagedHeadersService
+	Snippets              *SnippetsService
+	CloudConnector        *CloudConnectorService
+	APIShield             *APIShieldService
+	MutualTLSCertificates *MutualTLSCertificatesService
+	KeylessSSL            *KeylessSSLService
+	SSL                   *SSLService
+	DNSSEC                *DNSSECService
+	SecondaryDNS          *SecondaryDNSService
+	DNSFirewall           *DNSFirewallService
+	CustomNameservers     *CustomNameserversService
+	AddressMaps           *AddressMapsService
+	IPPrefixes            *IPPrefixesService
+	RegionalServices      *RegionalServicesService
 }
 
 // Client returns the http.Client used by this Cloudflare client.
@@ -108,7 +180,10 @@ type ResultInfoCursors struct {
 	After  string `json:"after"`
 }
 
-// ResultInfo contains metadata about the Response.
+// ResultInfo contains metadata about the Response. List methods return the
+// ResultInfo of the last page they fetched, so a caller fetching one page at
+// a time can request the next by incrementing Page or passing Cursor back in
+// PaginationParams or CursorPaginationParams.
 type ResultInfo struct {
 	Page       int               `json:"page"`
 	PerPage    int               `json:"per_page"`
@@ -119,6 +194,26 @@ type ResultInfo struct {
 	Cursors    ResultInfoCursors `json:"cursors"`
 }
 
+// PaginationParams configures which page of results a list request returns.
+// When Page and Cursor are left unset, list methods fetch every page
+// automatically. Cursor is used by endpoints paginated with cursors instead
+// of page numbers. Direction orders the results and is "asc" or "desc".
+type PaginationParams struct {
+	Page      int    `url:"page,omitempty"`
+	PerPage   int    `url:"per_page,omitempty"`
+	Cursor    string `url:"cursor,omitempty"`
+	Direction string `url:"direction,omitempty"`
+}
+
+// CursorPaginationParams configures which page of results a list request
+// returns for endpoints paginated only with cursors, which support neither
+// page numbers nor ordering. When Cursor is left unset, list methods fetch
+// every page automatically. The name of the query parameter the cursor is
+// sent as depends on the endpoint.
+type CursorPaginationParams struct {
+	Cursor string `url:"-"`
+}
+
 // Call is the entrypoint to making API calls with the correct request setup.
 func (c *Client) Call(ctx context.Context, method, path string, payload interface{}) ([]byte, error) {
 	return c.makeRequest(ctx, method, path, payload, nil)
@@ -149,6 +244,16 @@ func New(config *ClientParams) (*Client, error) {
 
 	if config.HTTPClient == nil {
 		c.ClientParams.HTTPClient = http.DefaultClient
+	} else {
+		c.ClientParams.HTTPClient = config.HTTPClient
+	}
+
+	// A transport is set on a copy so the caller's HTTP client, which may be
+	// http.DefaultClient, is left untouched.
+	if config.Transport != nil {
+		httpClient := *c.ClientParams.HTTPClient
+		httpClient.Transport = config.Transport
+		c.ClientParams.HTTPClient = &httpClient
 	}
 
 	if config.RateLimiter == nil {
@@ -187,7 +292,74 @@ func New(config *ClientParams) (*Client, error) {
 		c.ClientParams.UserServiceKey = config.UserServiceKey
 	}
 
+	c.ClientParams.Middleware = append([]RequestMiddleware(nil), config.Middleware...)
+
 	c.Zones = (*ZonesService)(&c.common)
+	c.Accounts = (*AccountsService)(&c.common)
+	c.AccountMembers = (*AccountMembersService)(&c.common)
+	c.AccountRoles = (*AccountRolesService)(&c.common)
+	c.User = (*UserService)(&c.common)
+	c.OriginCACertificates = (*OriginCACertificatesService)(&c.common)
+	c.CustomHostnames = (*CustomHostnamesService)(&c.common)
+	c.CustomCertificates = (*CustomCertificatesService)(&c.common)
+	c.CertificatePacks = (*CertificatePacksService)(&c.common)
+	c.ZoneSettings = (*ZoneSettingsService)(&c.common)
+	c.Rulesets = (*RulesetsService)(&c.common)
+	c.Filters = (*FiltersService)(&c.common)
+	c.FirewallRules = (*FirewallRulesService)(&c.common)
+	c.RateLimits = (*RateLimitsService)(&c.common)
+	c.AccessRules = (*AccessRulesService)(&c.common)
+	c.ZoneLockdowns = (*ZoneLockdownsService)(&c.common)
+	c.UserAgentRules = (*UserAgentRulesService)(&c.common)
+	c.WorkerRoutes = (*WorkerRoutesService)(&c.common)
+	c.WorkersKV = (*WorkersKVService)(&c.common)
+	c.Workers = (*WorkersService)(&c.common)
+	c.DurableObjects = (*DurableObjectsService)(&c.common)
+	c.Queues = (*QueuesService)(&c.common)
+	c.D1 = (*D1Service)(&c.common)
+	c.R2 = (*R2Service)(&c.common)
+	c.Pages = (*PagesService)(&c.common)
+	c.LoadBalancers = (*LoadBalancersService)(&c.common)
+	c.HealthChecks = (*HealthChecksService)(&c.common)
+	c.Spectrum = (*SpectrumService)(&c.common)
+	c.Argo = (*ArgoService)(&c.common)
+	c.Tunnels = (*TunnelsService)(&c.common)
+	c.Access = (*AccessService)(&c.common)
+	c.Gateway = (*GatewayService)(&c.common)
+	c.Devices = (*DevicesService)(&c.common)
+	c.DLP = (*DLPService)(&c.common)
+	c.DEX = (*DEXService)(&c.common)
+	c.Logpush = (*LogpushService)(&c.common)
+	c.Logs = (*LogsService)(&c.common)
+	c.Notifications = (*NotificationsService)(&c.common)
+	c.Registrar = (*RegistrarService)(&c.common)
+	c.Intel = (*IntelService)(&c.common)
+	c.URLScanner = (*URLScannerService)(&c.common)
+	c.Stream = (*StreamService)(&c.common)
+	c.Images = (*ImagesService)(&c.common)
+	c.WaitingRooms = (*WaitingRoomsService)(&c.common)
+	c.BotManagement = (*BotManagementService)(&c.common)
+	c.PageShield = (*PageShieldService)(&c.common)
+	c.EmailRouting = (*EmailRoutingService)(&c.common)
+	c.MagicTransit = (*MagicTransitService)(&c.common)
+	c.Analytics = (*AnalyticsService)(&c.common)
+	c.Turnstile = (*TurnstileService)(&c.common)
+	c.Web3 = (*Web3Service)(&c.common)
+	c.Lists = (*ListsService)(&c.common)
+	c.ManagedHeaders = (*ManagedHeadersService)(&c.common)
+	c.Snippets = (*SnippetsService)(&c.common)
+	c.CloudConnector = (*CloudConnectorService)(&c.common)
+	c.APIShield = (*APIShieldService)(&c.common)
+	c.MutualTLSCertificates = (*MutualTLSCertificatesService)(&c.common)
+	c.KeylessSSL = (*KeylessSSLService)(&c.common)
+	c.SSL = (*SSLService)(&c.common)
+	c.DNSSEC = (*DNSSECService)(&c.common)
+	c.SecondaryDNS = (*SecondaryDNSService)(&c.common)
+	c.DNSFirewall = (*DNSFirewallService)(&c.common)
+	c.CustomNameservers = (*CustomNameserversService)(&c.common)
+	c.AddressMaps = (*AddressMapsService)(&c.common)
+	c.IPPrefixes = (*IPPrefixesService)(&c.common)
+	c.RegionalServices = (*RegionalServicesService)(&c.common)
 
 	return c, nil
 }
@@ -272,35 +444,203 @@ func (c *Client) makeRequest(ctx context.Context, method, uri string, params int
 	}
 
 	if resp.StatusCode >= http.StatusBadRequest {
-		if strings.HasSuffix(resp.Request.URL.Path, "/filters/validate-expr") {
-			return nil, errors.Errorf("%s", respBody)
+		return nil, responseError(resp, respBody)
+	}
+
+	return respBody, nil
+}
+
+// responseError converts an unsuccessful response into the error returned to
+// callers.
+func responseError(resp *http.Response, respBody []byte) error {
+	if strings.HasSuffix(resp.Request.URL.Path, "/filters/validate-expr") {
+		return errors.Errorf("%s", respBody)
+	}
+
+	if resp.StatusCode > http.StatusInternalServerError {
+		return errors.Errorf("HTTP status %d: service failure", resp.StatusCode)
+	}
+
+	errBody := &Response{}
+	err := json.Unmarshal(respBody, &errBody)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalErrorBody)
+	}
+
+	return &APIRequestError{
+		StatusCode: resp.StatusCode,
+		Errors:     errBody.Errors,
+		RayID:      resp.Header.Get("cf-ray"),
+	}
+}
+
+// streamingKey is the context key marking requests made by streamRequest.
+type streamingKey struct{}
+
+// isStreaming returns whether ctx belongs to a request made by streamRequest,
+// whose response must not be buffered.
+func isStreaming(ctx context.Context) bool {
+	streaming, _ := ctx.Value(streamingKey{}).(bool)
+	return streaming
+}
+
+// streamRequest makes a request and returns the response body without reading
+// it, for endpoints whose responses are too large to buffer. Unlike Call the
+// request is not retried. The caller must close the returned body.
+func (c *Client) streamRequest(ctx context.Context, method, uri string, headers http.Header) (io.ReadCloser, error) {
+	ctx = context.WithValue(ctx, streamingKey{}, true)
+
+	if err := c.RateLimiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("error caused by request rate limiting: %w", err)
+	}
+
+	resp, err := c.request(ctx, method, uri, nil, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read response body")
 		}
+		return nil, responseError(resp, respBody)
+	}
+
+	return resp.Body, nil
+}
+
+// uploadRequest makes a single request for upload protocols that report
+// their progress in response headers. uri may be an absolute URL. The
+// response body is read and closed before returning. Error statuses are
+// returned as errors alongside the response so callers can inspect the status
+// code; like streamRequest the request is not retried.
+func (c *Client) uploadRequest(ctx context.Context, method, uri string, body io.Reader, headers http.Header) (*http.Response, error) {
+	if err := c.RateLimiter.Wait(ctx); err != nil {
+		return nil, fmt.Errorf("error caused by request rate limiting: %w", err)
+	}
+
+	resp, err := c.request(ctx, method, uri, body, headers)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp, errors.Wrap(err, "could not read response body")
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp, responseError(resp, respBody)
+	}
+
+	return resp, nil
+}
+
+// errStopPagination is returned by a listPages callback to stop fetching
+// further pages without failing the list.
+var errStopPagination = errors.New("pagination stopped")
+
+// listPages fetches consecutive pages of uri, encoding params as the query
+// string, and hands each raw response to fn which returns the page's
+// ResultInfo. pagination must point at the PaginationParams embedded in params
+// so the page number can be advanced between requests. If the caller asked for
+// a specific page, only that page is fetched. fn may return errStopPagination
+// to stop early.
+func (c *Client) listPages(ctx context.Context, uri string, params interface{}, pagination *PaginationParams, fn func(res []byte) (ResultInfo, error)) error {
+	autoPaginate := pagination.Page < 1 && pagination.Cursor == ""
+	if autoPaginate {
+		pagination.Page = 1
+	}
 
-		if resp.StatusCode > http.StatusInternalServerError {
-			return nil, errors.Errorf("HTTP status %d: service failure", resp.StatusCode)
+	if pagination.PerPage < 1 {
+		pagination.PerPage = defaultPerPage
+	}
+
+	for {
+		res, err := c.Call(ctx, http.MethodGet, buildURI(uri, params), nil)
+		if err != nil {
+			return err
 		}
 
-		errBody := &Response{}
-		err = json.Unmarshal(respBody, &errBody)
+		info, err := fn(res)
+		if err == errStopPagination {
+			return nil
+		}
 		if err != nil {
-			return nil, errors.Wrap(err, errUnmarshalErrorBody)
+			return err
 		}
 
-		return nil, &APIRequestError{
-			StatusCode: resp.StatusCode,
-			Errors:     errBody.Errors,
-			RayID:      resp.Header.Get("cf-ray"),
+		if !autoPaginate || info.Page >= info.TotalPages {
+			return nil
 		}
+
+		pagination.Page++
 	}
+}
 
-	return respBody, nil
+// listCursorPages is like listPages for endpoints paginated with cursors.
+// The cursor is sent as the cursorParam query parameter, replacing any value
+// params has for it, and fn returns the ResultInfo whose Cursor identifies the
+// next page. If the caller asked for a specific cursor, only that page is
+// fetched.
+func (c *Client) listCursorPages(ctx context.Context, uri, cursorParam string, params interface{}, pagination *CursorPaginationParams, fn func(res []byte) (ResultInfo, error)) error {
+	autoPaginate := pagination.Cursor == ""
+
+	for {
+		v, _ := query.Values(params)
+		if pagination.Cursor != "" {
+			v.Set(cursorParam, pagination.Cursor)
+		}
+
+		target := uri
+		if q := v.Encode(); q != "" {
+			target += "?" + q
+		}
+
+		res, err := c.Call(ctx, http.MethodGet, target, nil)
+		if err != nil {
+			return err
+		}
+
+		info, err := fn(res)
+		if err == errStopPagination {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !autoPaginate || info.Cursor == "" {
+			return nil
+		}
+
+		pagination.Cursor = info.Cursor
+	}
 }
 
 // request makes a HTTP request to the given API endpoint, returning the raw
 // *http.Response, or an error if one occurred. The caller is responsible for
 // closing the response body.
 func (api *Client) request(ctx context.Context, method, uri string, reqBody io.Reader, headers http.Header) (*http.Response, error) {
-	req, err := http.NewRequestWithContext(ctx, method, api.BaseURL.String()+uri, reqBody)
+	// Absolute URLs are used as is, for endpoints such as resumable uploads
+	// that hand out URLs on other hosts. As they come from responses they must
+	// use https unless they point at the API itself.
+	target := api.BaseURL.String() + uri
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.Wrap(err, "HTTP request creation failed")
+	}
+	if u.IsAbs() {
+		if u.Scheme != "https" && !isSameOrigin(u, api.BaseURL) {
+			return nil, errors.Errorf("refusing to send request to insecure URL %s", u.Redacted())
+		}
+		target = uri
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, reqBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "HTTP request creation failed")
 	}
@@ -310,21 +650,14 @@ func (api *Client) request(ctx context.Context, method, uri string, reqBody io.R
 	copyHeader(combinedHeaders, headers)
 	req.Header = combinedHeaders
 
-	if api.Key == "" && api.Email == "" && api.Token == "" && api.UserServiceKey == "" {
+	if api.Key == "" && api.Email == "" && api.Token == "" && api.UserServiceKey == "" && !isPublicRoute(uri) {
 		return nil, errors.New("no user credentials provided")
 	}
 
-	if api.Key != "" {
-		req.Header.Set("X-Auth-Key", api.Key)
-		req.Header.Set("X-Auth-Email", api.Email)
-	}
-
-	if api.UserServiceKey != "" {
-		req.Header.Set("X-Auth-User-Service-Key", api.UserServiceKey)
-	}
-
-	if api.Token != "" {
-		req.Header.Set("Authorization", "Bearer "+api.Token)
+	// Credentials are only sent to the API and the upload hosts it hands out,
+	// never to any other host an absolute URL may point at.
+	if isSameOrigin(req.URL, api.BaseURL) || isUploadHost(req.URL) {
+		api.setCredentials(req, uri)
 	}
 
 	if api.UserAgent != "" {
@@ -335,7 +668,7 @@ func (api *Client) request(ctx context.Context, method, uri string, reqBody io.R
 		req.Header.Set("Content-Type", "application/json")
 	}
 
-	resp, err := api.HTTPClient.Do(req)
+	resp, err := api.do(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "HTTP request failed")
 	}
@@ -343,6 +676,42 @@ func (api *Client) request(ctx context.Context, method, uri string, reqBody io.R
 	return resp, nil
 }
 
+// setCredentials sets the authentication headers of req. The Origin CA key is
+// only accepted by the Origin CA endpoints so it is preferred for those and
+// otherwise only sent when nothing else is set.
+func (api *Client) setCredentials(req *http.Request, uri string) {
+	if api.UserServiceKey != "" && (isOriginCARoute(uri) || (api.Key == "" && api.Token == "")) {
+		req.Header.Set("X-Auth-User-Service-Key", api.UserServiceKey)
+		return
+	}
+
+	if api.Key != "" {
+		req.Header.Set("X-Auth-Key", api.Key)
+		req.Header.Set("X-Auth-Email", api.Email)
+	}
+
+	if api.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+api.Token)
+	}
+}
+
+// isSameOrigin returns whether u has the scheme and host of base.
+func isSameOrigin(u, base *url.URL) bool {
+	return strings.EqualFold(u.Scheme, base.Scheme) && strings.EqualFold(u.Host, base.Host)
+}
+
+// uploadHosts are the hosts, other than the API, that upload endpoints hand
+// out URLs on and that accept the client's credentials.
+var uploadHosts = map[string]bool{
+	"upload.videodelivery.net":    true,
+	"upload.cloudflarestream.com": true,
+}
+
+// isUploadHost returns whether u is an https URL on one of the upload hosts.
+func isUploadHost(u *url.URL) bool {
+	return u.Scheme == "https" && uploadHosts[strings.ToLower(u.Host)]
+}
+
 // copyHeader copies all headers for `source` and sets them on `target`.
 // based on https://godoc.org/github.com/golang/gddo/httputil/header#Copy
 func copyHeader(target, source http.Header) {
@@ -351,6 +720,20 @@ func copyHeader(target, source http.Header) {
 	}
 }
 
+// isOriginCARoute returns whether uri targets the Origin CA certificate
+// endpoints which authenticate using the Origin CA key.
+func isOriginCARoute(uri string) bool {
+	return uri == originCACertificatesPath ||
+		strings.HasPrefix(uri, originCACertificatesPath+"/") ||
+		strings.HasPrefix(uri, originCACertificatesPath+"?")
+}
+
+// isPublicRoute returns whether uri targets an endpoint that does not require
+// authentication.
+func isPublicRoute(uri string) bool {
+	return uri == ipRangesPath || strings.HasPrefix(uri, ipRangesPath+"?")
+}
+
 func isHTTPWriteMethod(method string) bool {
 	return method != http.MethodGet && method != http.MethodHead
 }
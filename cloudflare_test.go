@@ -0,0 +1,175 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// setup starts a test server and returns a client sending its requests there,
+// along with the mux serving them. Requests aren't rate limited and are
+// retried once after a millisecond.
+func setup(t *testing.T) (*Client, *http.ServeMux) {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := New(&ClientParams{Token: "deadbeef"})
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+
+	client.BaseURL, err = url.Parse(server.URL + "/client/v4")
+	if err != nil {
+		t.Fatalf("failed to parse server URL: %s", err)
+	}
+	client.RateLimiter = rate.NewLimiter(rate.Inf, 0)
+	client.RetryPolicy = RetryPolicy{MaxRetries: 1, MinRetryDelay: time.Millisecond, MaxRetryDelay: time.Millisecond}
+
+	return client, mux
+}
+
+// zonePagesHandler serves three pages of one zone each, recording the query
+// string of every request.
+func zonePagesHandler(queries *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*queries = append(*queries, r.URL.RawQuery)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		fmt.Fprintf(w, `{"success": true, "result": [{"id": "zone-%d"}], "result_info": {"page": %d, "per_page": 1, "total_pages": 3, "count": 1, "total_count": 3}}`, page, page)
+	}
+}
+
+func zoneIDs(zones []Zone) []string {
+	ids := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		ids = append(ids, zone.ID)
+	}
+	return ids
+}
+
+func TestListPages_AutoPaginates(t *testing.T) {
+	client, mux := setup(t)
+
+	var queries []string
+	mux.HandleFunc("/client/v4/zones", zonePagesHandler(&queries))
+
+	zones, info, err := client.Zones.List(context.Background(), ZoneParams{Name: "example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{"zone-1", "zone-2", "zone-3"}; !reflect.DeepEqual(zoneIDs(zones), want) {
+		t.Errorf("got zones %v, want %v", zoneIDs(zones), want)
+	}
+	if info.Page != 3 {
+		t.Errorf("got page %d in result info, want the last page", info.Page)
+	}
+
+	want := []string{
+		"name=example.com&page=1&per_page=50",
+		"name=example.com&page=2&per_page=50",
+		"name=example.com&page=3&per_page=50",
+	}
+	if !reflect.DeepEqual(queries, want) {
+		t.Errorf("got queries %v, want %v", queries, want)
+	}
+}
+
+func TestListPages_ExplicitPage(t *testing.T) {
+	client, mux := setup(t)
+
+	var queries []string
+	mux.HandleFunc("/client/v4/zones", zonePagesHandler(&queries))
+
+	zones, info, err := client.Zones.List(context.Background(), ZoneParams{PaginationParams: PaginationParams{Page: 2, PerPage: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{"zone-2"}; !reflect.DeepEqual(zoneIDs(zones), want) {
+		t.Errorf("got zones %v, want %v", zoneIDs(zones), want)
+	}
+	if info.Page != 2 || info.TotalPages != 3 {
+		t.Errorf("got result info %+v, want page 2 of 3", info)
+	}
+	if want := []string{"page=2&per_page=1"}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("got queries %v, want %v", queries, want)
+	}
+}
+
+func TestListPages_StopsEarly(t *testing.T) {
+	client, mux := setup(t)
+
+	var queries []string
+	mux.HandleFunc("/client/v4/zones", zonePagesHandler(&queries))
+
+	var pages []string
+	err := client.Zones.ForEachPage(context.Background(), ZoneParams{}, func(zones []Zone, info ResultInfo) bool {
+		pages = append(pages, zoneIDs(zones)...)
+		return info.Page < 2
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := []string{"zone-1", "zone-2"}; !reflect.DeepEqual(pages, want) {
+		t.Errorf("got zones %v, want %v", pages, want)
+	}
+	if len(queries) != 2 {
+		t.Errorf("got %d requests, want 2", len(queries))
+	}
+}
+
+func TestListCursorPages(t *testing.T) {
+	client, mux := setup(t)
+
+	cursors := map[string]string{"": "c1", "c1": "c2", "c2": ""}
+	var queries []string
+	mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/storage/kv/namespaces/ns/keys", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		cursor := r.URL.Query().Get("cursor")
+		fmt.Fprintf(w, `{"success": true, "result": [{"name": "key-%s"}], "result_info": {"count": 1, "cursor": %q}}`, cursor, cursors[cursor])
+	})
+
+	keys, info, err := client.WorkersKV.ListKeys(context.Background(), testAccountID, "ns", WorkersKVListKeysParams{Prefix: "key"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var names []string
+	for _, key := range keys {
+		names = append(names, key.Name)
+	}
+	if want := []string{"key-", "key-c1", "key-c2"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("got keys %v, want %v", names, want)
+	}
+	if info.Cursor != "" {
+		t.Errorf("got cursor %q in result info, want none after the last page", info.Cursor)
+	}
+	if want := []string{"prefix=key", "cursor=c1&prefix=key", "cursor=c2&prefix=key"}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("got queries %v, want %v", queries, want)
+	}
+
+	// A caller provided cursor fetches that page only.
+	queries = nil
+	keys, info, err = client.WorkersKV.ListKeys(context.Background(), testAccountID, "ns", WorkersKVListKeysParams{CursorPaginationParams: CursorPaginationParams{Cursor: "c1"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(keys) != 1 || keys[0].Name != "key-c1" || info.Cursor != "c2" {
+		t.Errorf("got keys %v and cursor %q, want key-c1 and c2", keys, info.Cursor)
+	}
+	if want := []string{"cursor=c1"}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("got queries %v, want %v", queries, want)
+	}
+}
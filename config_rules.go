@@ -0,0 +1,91 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ConfigRule returns a rule of the http_config_settings phase overriding
+// zone settings for requests matching expression. Only the set_config fields
+// of settings may be set, for example:
+//
+//	ConfigRule(`starts_with(http.request.uri.path, "/api/")`, "No Rocket Loader on the API",
+//		RulesetRuleActionParameters{RocketLoader: Bool(false)})
+func ConfigRule(expression, description string, settings RulesetRuleActionParameters) (RulesetRule, error) {
+	if expression == "" {
+		return RulesetRule{}, errors.New("expression must be provided")
+	}
+
+	rule := RulesetRule{
+		Action:           RulesetRuleActionSetConfig,
+		Expression:       expression,
+		Description:      description,
+		Enabled:          Bool(true),
+		ActionParameters: &settings,
+	}
+
+	if err := ValidateConfigRule(rule); err != nil {
+		return RulesetRule{}, err
+	}
+
+	return rule, nil
+}
+
+// ValidateConfigRule checks a rule of the http_config_settings phase before
+// it is sent to the API. The rule must use the set_config action, override
+// at least one setting with a valid value and must not carry the parameters
+// of other actions.
+func ValidateConfigRule(rule RulesetRule) error {
+	if rule.Action != RulesetRuleActionSetConfig {
+		return fmt.Errorf("configuration rules must use the %s action, got %q", RulesetRuleActionSetConfig, rule.Action)
+	}
+
+	p := rule.ActionParameters
+	if p == nil {
+		return errors.New("at least one setting must be provided")
+	}
+
+	others := *p
+	others.AutomaticHTTPSRewrites, others.AutoMinify, others.BrowserIntegrityCheck = nil, nil, nil
+	others.DisableApps, others.DisableZaraz, others.EmailObfuscation, others.Fonts = nil, nil, nil, nil
+	others.HotlinkProtection, others.Mirage, others.OpportunisticEncryption = nil, nil, nil
+	others.RocketLoader, others.ServerSideExcludes, others.SXG = nil, nil, nil
+	others.Polish, others.SecurityLevel, others.SSL = "", "", ""
+	if !reflect.DeepEqual(others, RulesetRuleActionParameters{}) {
+		return errors.New("set_config parameters cannot be combined with the parameters of other actions")
+	}
+
+	settings := []*bool{
+		p.AutomaticHTTPSRewrites, p.BrowserIntegrityCheck, p.DisableApps, p.DisableZaraz,
+		p.EmailObfuscation, p.Fonts, p.HotlinkProtection, p.Mirage, p.OpportunisticEncryption,
+		p.RocketLoader, p.ServerSideExcludes, p.SXG,
+	}
+	set := p.AutoMinify != nil || p.Polish != "" || p.SecurityLevel != "" || p.SSL != ""
+	for _, s := range settings {
+		set = set || s != nil
+	}
+	if !set {
+		return errors.New("at least one setting must be provided")
+	}
+
+	switch p.Polish {
+	case "", "off", "lossless", "lossy":
+	default:
+		return fmt.Errorf("invalid polish value: %q", p.Polish)
+	}
+
+	switch p.SecurityLevel {
+	case "", "off", "essentially_off", "low", "medium", "high", "under_attack":
+	default:
+		return fmt.Errorf("invalid security_level value: %q", p.SecurityLevel)
+	}
+
+	switch p.SSL {
+	case "", "off", "flexible", "full", "strict", "origin_pull":
+	default:
+		return fmt.Errorf("invalid ssl value: %q", p.SSL)
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type CustomCertificatesService service
+
+// CustomCertificateBundleMethod is the method used to build the certificate
+// chain served alongside a custom certificate.
+type CustomCertificateBundleMethod string
+
+const (
+	CustomCertificateBundleMethodUbiquitous CustomCertificateBundleMethod = "ubiquitous"
+	CustomCertificateBundleMethodOptimal    CustomCertificateBundleMethod = "optimal"
+	CustomCertificateBundleMethodForce      CustomCertificateBundleMethod = "force"
+)
+
+// CustomCertificateGeoRestrictions restricts where the private key of a
+// custom certificate is stored and used. Valid labels are "us", "eu" and
+// "highest_security".
+type CustomCertificateGeoRestrictions struct {
+	Label string `json:"label"`
+}
+
+// CustomCertificateKeylessServer represents the keyless server a custom
+// certificate is bound to.
+type CustomCertificateKeylessServer struct {
+	ID     string `json:"id"`
+	Host   string `json:"host"`
+	Port   int    `json:"port"`
+	Status string `json:"status"`
+}
+
+// CustomCertificate represents a custom SSL certificate uploaded to a zone.
+type CustomCertificate struct {
+	ID              string                            `json:"id"`
+	Hosts           []string                          `json:"hosts"`
+	Issuer          string                            `json:"issuer"`
+	Signature       string                            `json:"signature"`
+	Status          string                            `json:"status"`
+	BundleMethod    CustomCertificateBundleMethod     `json:"bundle_method"`
+	GeoRestrictions *CustomCertificateGeoRestrictions `json:"geo_restrictions,omitempty"`
+	Policy          string                            `json:"policy,omitempty"`
+	ZoneID          string                            `json:"zone_id"`
+	UploadedOn      time.Time                         `json:"uploaded_on"`
+	ModifiedOn      time.Time                         `json:"modified_on"`
+	ExpiresOn       time.Time                         `json:"expires_on"`
+	Priority        int                               `json:"priority"`
+	KeylessServer   *CustomCertificateKeylessServer   `json:"keyless_server,omitempty"`
+}
+
+// CustomCertificateResponse represents the response from the custom
+// certificates endpoint containing a single certificate.
+type CustomCertificateResponse struct {
+	Response
+	Result CustomCertificate `json:"result"`
+}
+
+// CustomCertificatesResponse represents the response from the custom
+// certificates endpoint containing multiple certificates.
+type CustomCertificatesResponse struct {
+	Response
+	Result     []CustomCertificate `json:"result"`
+	ResultInfo ResultInfo          `json:"result_info"`
+}
+
+// CustomCertificateListParams contains the filters available when listing
+// custom certificates.
+type CustomCertificateListParams struct {
+	Match  string `url:"match,omitempty"`
+	Status string `url:"status,omitempty"`
+
+	PaginationParams
+}
+
+// CustomCertificateParams contains the fields used to upload or update a
+// custom certificate. Type ("legacy_custom" or "sni_custom") can only be set on
+// upload. Policy is a comma separated list of country codes, prefixed with "!"
+// to exclude them, used by legacy certificates to restrict key usage.
+type CustomCertificateParams struct {
+	Certificate     string                            `json:"certificate,omitempty"`
+	PrivateKey      string                            `json:"private_key,omitempty"`
+	BundleMethod    CustomCertificateBundleMethod     `json:"bundle_method,omitempty"`
+	GeoRestrictions *CustomCertificateGeoRestrictions `json:"geo_restrictions,omitempty"`
+	Policy          string                            `json:"policy,omitempty"`
+	Type            string                            `json:"type,omitempty"`
+}
+
+// CustomCertificatePriority represents a certificate's ID and priority. It is
+// a subset of CustomCertificate used for re-prioritising certificates.
+type CustomCertificatePriority struct {
+	ID       string `json:"id"`
+	Priority int    `json:"priority"`
+}
+
+// customCertificatePrioritizeRequest is the payload of the prioritize endpoint.
+type customCertificatePrioritizeRequest struct {
+	Certificates []CustomCertificatePriority `json:"certificates"`
+}
+
+// Create uploads a new custom certificate to a zone.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-create-ssl-configuration
+func (s *CustomCertificatesService) Create(ctx context.Context, zoneID string, params CustomCertificateParams) (CustomCertificate, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomCertificate{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.Certificate == "" || params.PrivateKey == "" {
+		return CustomCertificate{}, errors.New("certificate and private key are required to upload a custom certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/custom_certificates", params)
+	if err != nil {
+		return CustomCertificate{}, err
+	}
+
+	var r CustomCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomCertificate{}, fmt.Errorf("failed to unmarshal custom certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// List returns all custom certificates in a zone that match the provided
+// `CustomCertificateListParams`.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-list-ssl-configurations
+func (s *CustomCertificatesService) List(ctx context.Context, zoneID string, params CustomCertificateListParams) ([]CustomCertificate, ResultInfo, error) {
+	var certs []CustomCertificate
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []CustomCertificate, pageInfo ResultInfo) bool {
+		certs = append(certs, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []CustomCertificate{}, ResultInfo{}, err
+	}
+
+	return certs, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-list-ssl-configurations
+func (s *CustomCertificatesService) ForEachPage(ctx context.Context, zoneID string, params CustomCertificateListParams, fn func(items []CustomCertificate, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/custom_certificates", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r CustomCertificatesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal custom certificate JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single custom certificate.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-ssl-configuration-details
+func (s *CustomCertificatesService) Get(ctx context.Context, zoneID, certificateID string) (CustomCertificate, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomCertificate{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if certificateID == "" {
+		return CustomCertificate{}, fmt.Errorf(errMissingResourceID, "certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/custom_certificates/"+certificateID, nil)
+	if err != nil {
+		return CustomCertificate{}, err
+	}
+
+	var r CustomCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomCertificate{}, fmt.Errorf("failed to unmarshal custom certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces the certificate, key or options of a custom certificate.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-edit-ssl-configuration
+func (s *CustomCertificatesService) Update(ctx context.Context, zoneID, certificateID string, params CustomCertificateParams) (CustomCertificate, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomCertificate{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if certificateID == "" {
+		return CustomCertificate{}, fmt.Errorf(errMissingResourceID, "certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/custom_certificates/"+certificateID, params)
+	if err != nil {
+		return CustomCertificate{}, err
+	}
+
+	var r CustomCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomCertificate{}, fmt.Errorf("failed to unmarshal custom certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a custom certificate from a zone.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-delete-ssl-configuration
+func (s *CustomCertificatesService) Delete(ctx context.Context, zoneID, certificateID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if certificateID == "" {
+		return fmt.Errorf(errMissingResourceID, "certificate")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/custom_certificates/"+certificateID, nil)
+	return err
+}
+
+// Reprioritize changes the order in which custom certificates are served when
+// more than one matches a hostname. Lower priorities are served first.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-re-prioritize-ssl-certificates
+func (s *CustomCertificatesService) Reprioritize(ctx context.Context, zoneID string, priorities []CustomCertificatePriority) ([]CustomCertificate, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []CustomCertificate{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/custom_certificates/prioritize", customCertificatePrioritizeRequest{Certificates: priorities})
+	if err != nil {
+		return []CustomCertificate{}, err
+	}
+
+	var r CustomCertificatesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CustomCertificate{}, fmt.Errorf("failed to unmarshal custom certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
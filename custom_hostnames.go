@@ -0,0 +1,358 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type CustomHostnamesService service
+
+// CustomHostnameSSLSettings represents the SSL settings for a custom hostname.
+type CustomHostnameSSLSettings struct {
+	HTTP2         string   `json:"http2,omitempty"`
+	HTTP3         string   `json:"http3,omitempty"`
+	TLS13         string   `json:"tls_1_3,omitempty"`
+	MinTLSVersion string   `json:"min_tls_version,omitempty"`
+	Ciphers       []string `json:"ciphers,omitempty"`
+	EarlyHints    string   `json:"early_hints,omitempty"`
+}
+
+// SSLValidationRecord displays Domain Control Validation tokens.
+type SSLValidationRecord struct {
+	CnameTarget string   `json:"cname_target,omitempty"`
+	CnameName   string   `json:"cname,omitempty"`
+	TxtName     string   `json:"txt_name,omitempty"`
+	TxtValue    string   `json:"txt_value,omitempty"`
+	HTTPUrl     string   `json:"http_url,omitempty"`
+	HTTPBody    string   `json:"http_body,omitempty"`
+	Emails      []string `json:"emails,omitempty"`
+}
+
+// SSLValidationError represents errors that occurred during SSL validation.
+type SSLValidationError struct {
+	Message string `json:"message,omitempty"`
+}
+
+// CustomHostnameSSLCertificates represents the certificate issued for a
+// custom hostname.
+type CustomHostnameSSLCertificates struct {
+	Issuer            string     `json:"issuer"`
+	SerialNumber      string     `json:"serial_number"`
+	Signature         string     `json:"signature"`
+	ExpiresOn         *time.Time `json:"expires_on"`
+	IssuedOn          *time.Time `json:"issued_on"`
+	FingerprintSha256 string     `json:"fingerprint_sha256"`
+	ID                string     `json:"id"`
+}
+
+// CustomHostnameSSL represents the SSL section in a given custom hostname.
+type CustomHostnameSSL struct {
+	ID                   string                          `json:"id,omitempty"`
+	Status               string                          `json:"status,omitempty"`
+	Method               string                          `json:"method,omitempty"`
+	Type                 string                          `json:"type,omitempty"`
+	Wildcard             *bool                           `json:"wildcard,omitempty"`
+	CustomCertificate    string                          `json:"custom_certificate,omitempty"`
+	CustomKey            string                          `json:"custom_key,omitempty"`
+	CertificateAuthority string                          `json:"certificate_authority,omitempty"`
+	BundleMethod         string                          `json:"bundle_method,omitempty"`
+	Issuer               string                          `json:"issuer,omitempty"`
+	SerialNumber         string                          `json:"serial_number,omitempty"`
+	Settings             CustomHostnameSSLSettings       `json:"settings,omitempty"`
+	Certificates         []CustomHostnameSSLCertificates `json:"certificates,omitempty"`
+	ValidationRecords    []SSLValidationRecord           `json:"validation_records,omitempty"`
+	ValidationErrors     []SSLValidationError            `json:"validation_errors,omitempty"`
+}
+
+// CustomHostnameOwnershipVerification represents ownership verification
+// status of a given custom hostname.
+type CustomHostnameOwnershipVerification struct {
+	Type  string `json:"type,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// CustomHostnameOwnershipVerificationHTTP represents the HTTP based ownership
+// verification details of a given custom hostname.
+type CustomHostnameOwnershipVerificationHTTP struct {
+	HTTPUrl  string `json:"http_url,omitempty"`
+	HTTPBody string `json:"http_body,omitempty"`
+}
+
+// CustomHostname represents a custom hostname in a zone.
+type CustomHostname struct {
+	ID                        string                                  `json:"id,omitempty"`
+	Hostname                  string                                  `json:"hostname,omitempty"`
+	CustomOriginServer        string                                  `json:"custom_origin_server,omitempty"`
+	CustomOriginSNI           string                                  `json:"custom_origin_sni,omitempty"`
+	SSL                       *CustomHostnameSSL                      `json:"ssl,omitempty"`
+	CustomMetadata            map[string]interface{}                  `json:"custom_metadata,omitempty"`
+	Status                    string                                  `json:"status,omitempty"`
+	VerificationErrors        []string                                `json:"verification_errors,omitempty"`
+	OwnershipVerification     CustomHostnameOwnershipVerification     `json:"ownership_verification,omitempty"`
+	OwnershipVerificationHTTP CustomHostnameOwnershipVerificationHTTP `json:"ownership_verification_http,omitempty"`
+	CreatedAt                 *time.Time                              `json:"created_at,omitempty"`
+}
+
+// CustomHostnameResponse represents the response from the custom hostnames
+// endpoint containing a single custom hostname.
+type CustomHostnameResponse struct {
+	Response
+	Result CustomHostname `json:"result"`
+}
+
+// CustomHostnamesResponse represents the response from the custom hostnames
+// endpoint containing multiple custom hostnames.
+type CustomHostnamesResponse struct {
+	Response
+	Result     []CustomHostname `json:"result"`
+	ResultInfo ResultInfo       `json:"result_info"`
+}
+
+// CustomHostnameListParams contains the filters available when listing custom
+// hostnames.
+type CustomHostnameListParams struct {
+	Hostname string `url:"hostname,omitempty"`
+	ID       string `url:"id,omitempty"`
+	Order    string `url:"order,omitempty"`
+	SSL      *int   `url:"ssl,omitempty"`
+
+	PaginationParams
+}
+
+// CustomHostnameParams contains the fields used to create or edit a custom
+// hostname. Hostname can only be set on creation.
+type CustomHostnameParams struct {
+	Hostname           string                 `json:"hostname,omitempty"`
+	CustomOriginServer string                 `json:"custom_origin_server,omitempty"`
+	CustomOriginSNI    string                 `json:"custom_origin_sni,omitempty"`
+	SSL                *CustomHostnameSSL     `json:"ssl,omitempty"`
+	CustomMetadata     map[string]interface{} `json:"custom_metadata,omitempty"`
+}
+
+// CustomHostnameFallbackOrigin represents a custom hostname fallback origin.
+type CustomHostnameFallbackOrigin struct {
+	Origin    string     `json:"origin,omitempty"`
+	Status    string     `json:"status,omitempty"`
+	Errors    []string   `json:"errors,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CustomHostnameFallbackOriginResponse represents the response from the
+// fallback origin endpoint.
+type CustomHostnameFallbackOriginResponse struct {
+	Response
+	Result CustomHostnameFallbackOrigin `json:"result"`
+}
+
+// Create adds a new custom hostname to a zone.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-create-custom-hostname
+func (s *CustomHostnamesService) Create(ctx context.Context, zoneID string, params CustomHostnameParams) (CustomHostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomHostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.Hostname == "" {
+		return CustomHostname{}, errors.New("hostname is required to create a custom hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/custom_hostnames", params)
+	if err != nil {
+		return CustomHostname{}, err
+	}
+
+	var r CustomHostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomHostname{}, fmt.Errorf("failed to unmarshal custom hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// List returns all custom hostnames in a zone that match the provided
+// `CustomHostnameListParams`.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-list-custom-hostnames
+func (s *CustomHostnamesService) List(ctx context.Context, zoneID string, params CustomHostnameListParams) ([]CustomHostname, ResultInfo, error) {
+	var hostnames []CustomHostname
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []CustomHostname, pageInfo ResultInfo) bool {
+		hostnames = append(hostnames, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []CustomHostname{}, ResultInfo{}, err
+	}
+
+	return hostnames, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-list-custom-hostnames
+func (s *CustomHostnamesService) ForEachPage(ctx context.Context, zoneID string, params CustomHostnameListParams, fn func(items []CustomHostname, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/custom_hostnames", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r CustomHostnamesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal custom hostname JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single custom hostname.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-custom-hostname-details
+func (s *CustomHostnamesService) Get(ctx context.Context, zoneID, customHostnameID string) (CustomHostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomHostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if customHostnameID == "" {
+		return CustomHostname{}, fmt.Errorf(errMissingResourceID, "custom hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/custom_hostnames/"+customHostnameID, nil)
+	if err != nil {
+		return CustomHostname{}, err
+	}
+
+	var r CustomHostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomHostname{}, fmt.Errorf("failed to unmarshal custom hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update edits the SSL settings, origin or metadata of a custom hostname.
+// Changing the SSL settings triggers a new certificate validation.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-edit-custom-hostname
+func (s *CustomHostnamesService) Update(ctx context.Context, zoneID, customHostnameID string, params CustomHostnameParams) (CustomHostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomHostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if customHostnameID == "" {
+		return CustomHostname{}, fmt.Errorf(errMissingResourceID, "custom hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/custom_hostnames/"+customHostnameID, params)
+	if err != nil {
+		return CustomHostname{}, err
+	}
+
+	var r CustomHostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomHostname{}, fmt.Errorf("failed to unmarshal custom hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSSL edits only the SSL configuration of a custom hostname.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-edit-custom-hostname
+func (s *CustomHostnamesService) UpdateSSL(ctx context.Context, zoneID, customHostnameID string, ssl CustomHostnameSSL) (CustomHostname, error) {
+	return s.Update(ctx, zoneID, customHostnameID, CustomHostnameParams{SSL: &ssl})
+}
+
+// Delete removes a custom hostname and any issued SSL certificates.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-for-a-zone-delete-custom-hostname-and-any-issued-ssl-certificates-
+func (s *CustomHostnamesService) Delete(ctx context.Context, zoneID, customHostnameID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if customHostnameID == "" {
+		return fmt.Errorf(errMissingResourceID, "custom hostname")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/custom_hostnames/"+customHostnameID, nil)
+	return err
+}
+
+// GetFallbackOrigin fetches the fallback origin used for all custom hostnames
+// in a zone.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-fallback-origin-for-a-zone-get-fallback-origin-for-custom-hostnames
+func (s *CustomHostnamesService) GetFallbackOrigin(ctx context.Context, zoneID string) (CustomHostnameFallbackOrigin, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomHostnameFallbackOrigin{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/custom_hostnames/fallback_origin", nil)
+	if err != nil {
+		return CustomHostnameFallbackOrigin{}, err
+	}
+
+	var r CustomHostnameFallbackOriginResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomHostnameFallbackOrigin{}, fmt.Errorf("failed to unmarshal fallback origin JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateFallbackOrigin sets the fallback origin used for all custom hostnames
+// in a zone.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-fallback-origin-for-a-zone-update-fallback-origin-for-custom-hostnames
+func (s *CustomHostnamesService) UpdateFallbackOrigin(ctx context.Context, zoneID, origin string) (CustomHostnameFallbackOrigin, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomHostnameFallbackOrigin{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if origin == "" {
+		return CustomHostnameFallbackOrigin{}, errors.New("origin is required to set a fallback origin")
+	}
+
+	params := CustomHostnameFallbackOrigin{Origin: origin}
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/custom_hostnames/fallback_origin", params)
+	if err != nil {
+		return CustomHostnameFallbackOrigin{}, err
+	}
+
+	var r CustomHostnameFallbackOriginResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomHostnameFallbackOrigin{}, fmt.Errorf("failed to unmarshal fallback origin JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteFallbackOrigin removes the fallback origin for custom hostnames in a
+// zone.
+//
+// API reference: https://api.cloudflare.com/#custom-hostname-fallback-origin-for-a-zone-delete-fallback-origin-for-custom-hostnames
+func (s *CustomHostnamesService) DeleteFallbackOrigin(ctx context.Context, zoneID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/custom_hostnames/fallback_origin", nil)
+	return err
+}
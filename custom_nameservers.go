@@ -0,0 +1,213 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type CustomNameserversService service
+
+// CustomNameserverRecord is a glue record that must resolve for a custom
+// nameserver to be verified.
+type CustomNameserverRecord struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CustomNameserver is a nameserver of an account under a hostname of one of
+// its zones. Nameservers sharing an NSSet are assigned to zones together.
+// DNSRecords are the glue records verified before the nameserver is
+// usable.
+type CustomNameserver struct {
+	NSName     string                   `json:"ns_name"`
+	NSSet      int                      `json:"ns_set,omitempty"`
+	Status     string                   `json:"status,omitempty"`
+	ZoneTag    string                   `json:"zone_tag,omitempty"`
+	DNSRecords []CustomNameserverRecord `json:"dns_records,omitempty"`
+}
+
+// CustomNameserverZoneUsage sets whether a zone is served by the account's
+// custom nameservers of NSSet.
+type CustomNameserverZoneUsage struct {
+	Enabled bool `json:"enabled"`
+	NSSet   int  `json:"ns_set,omitempty"`
+}
+
+// CustomNameserverResponse represents the response from the custom
+// nameservers endpoint containing a single nameserver.
+type CustomNameserverResponse struct {
+	Response
+	Result CustomNameserver `json:"result"`
+}
+
+// CustomNameserversResponse represents the response from the custom
+// nameservers endpoint containing multiple nameservers.
+type CustomNameserversResponse struct {
+	Response
+	Result []CustomNameserver `json:"result"`
+}
+
+// CustomNameserverAvailabilityResponse represents the response from the
+// custom nameserver availability endpoint.
+type CustomNameserverAvailabilityResponse struct {
+	Response
+	Result []string `json:"result"`
+}
+
+// CustomNameserverZoneUsageResponse represents the response from the zone
+// custom nameservers endpoint.
+type CustomNameserverZoneUsageResponse struct {
+	Response
+	Result CustomNameserverZoneUsage `json:"result"`
+}
+
+// List returns the custom nameservers of an account.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-list-account-custom-nameservers
+func (s *CustomNameserversService) List(ctx context.Context, accountID string) ([]CustomNameserver, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []CustomNameserver{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/custom_ns", nil)
+	if err != nil {
+		return []CustomNameserver{}, err
+	}
+
+	var r CustomNameserversResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CustomNameserver{}, fmt.Errorf("failed to unmarshal custom nameserver JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create adds a custom nameserver to an account. The returned glue records
+// must be created in the zone owning the nameserver hostname before the
+// nameserver can be verified.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-add-account-custom-nameserver
+func (s *CustomNameserversService) Create(ctx context.Context, accountID string, nameserver CustomNameserver) (CustomNameserver, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return CustomNameserver{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if nameserver.NSName == "" {
+		return CustomNameserver{}, errors.New("nameserver name must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/custom_ns", nameserver)
+	if err != nil {
+		return CustomNameserver{}, err
+	}
+
+	var r CustomNameserverResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomNameserver{}, fmt.Errorf("failed to unmarshal custom nameserver JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a custom nameserver from an account.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-delete-account-custom-nameserver
+func (s *CustomNameserversService) Delete(ctx context.Context, accountID, nsName string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if nsName == "" {
+		return fmt.Errorf(errMissingResourceID, "custom nameserver")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/custom_ns/"+nsName, nil)
+	return err
+}
+
+// Availability returns the zone hostnames that custom nameservers of an
+// account can be created under.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-get-eligible-zones-for-account-custom-nameservers
+func (s *CustomNameserversService) Availability(ctx context.Context, accountID string) ([]string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []string{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/custom_ns"+"/availability", nil)
+	if err != nil {
+		return []string{}, err
+	}
+
+	var r CustomNameserverAvailabilityResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []string{}, fmt.Errorf("failed to unmarshal custom nameserver availability JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Verify checks the glue records of the custom nameservers of an account
+// and returns their updated status.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-verify-account-custom-nameserver-glue-records
+func (s *CustomNameserversService) Verify(ctx context.Context, accountID string) ([]CustomNameserver, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []CustomNameserver{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/custom_ns"+"/verify", nil)
+	if err != nil {
+		return []CustomNameserver{}, err
+	}
+
+	var r CustomNameserversResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []CustomNameserver{}, fmt.Errorf("failed to unmarshal custom nameserver JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetZoneUsage returns whether a zone uses the custom nameservers of its
+// account.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-usage-for-a-zone-get-account-custom-nameserver-related-zone-metadata
+func (s *CustomNameserversService) GetZoneUsage(ctx context.Context, zoneID string) (CustomNameserverZoneUsage, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return CustomNameserverZoneUsage{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/custom_ns", nil)
+	if err != nil {
+		return CustomNameserverZoneUsage{}, err
+	}
+
+	var r CustomNameserverZoneUsageResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CustomNameserverZoneUsage{}, fmt.Errorf("failed to unmarshal custom nameserver zone usage JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateZoneUsage sets whether a zone uses the custom nameservers of its
+// account.
+//
+// API reference: https://api.cloudflare.com/#account-level-custom-nameservers-usage-for-a-zone-set-account-custom-nameserver-related-zone-metadata
+func (s *CustomNameserversService) UpdateZoneUsage(ctx context.Context, zoneID string, usage CustomNameserverZoneUsage) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/custom_ns", usage)
+	return err
+}
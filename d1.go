@@ -0,0 +1,267 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type D1Service service
+
+// D1Database is a D1 SQL database.
+type D1Database struct {
+	UUID      string     `json:"uuid"`
+	Name      string     `json:"name"`
+	Version   string     `json:"version,omitempty"`
+	NumTables int        `json:"num_tables,omitempty"`
+	FileSize  int64      `json:"file_size,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// D1DatabaseResponse represents the response from the D1 endpoint containing
+// a single database.
+type D1DatabaseResponse struct {
+	Response
+	Result D1Database `json:"result"`
+}
+
+// D1DatabasesResponse represents the response from the D1 endpoint
+// containing multiple databases.
+type D1DatabasesResponse struct {
+	Response
+	Result     []D1Database `json:"result"`
+	ResultInfo ResultInfo   `json:"result_info"`
+}
+
+// D1DatabaseListParams contains the filters available when listing D1
+// databases.
+type D1DatabaseListParams struct {
+	Name string `url:"name,omitempty"`
+
+	PaginationParams
+}
+
+// D1DatabaseCreateParams contains the details needed to create a D1
+// database. PrimaryLocationHint is optional and may be one of "wnam", "enam",
+// "weur", "eeur", "apac" or "oc".
+type D1DatabaseCreateParams struct {
+	Name                string `json:"name"`
+	PrimaryLocationHint string `json:"primary_location_hint,omitempty"`
+}
+
+// D1QueryParams contains a SQL statement, or statements separated by
+// semicolons, and the values bound to its "?" placeholders.
+type D1QueryParams struct {
+	SQL    string        `json:"sql"`
+	Params []interface{} `json:"params,omitempty"`
+}
+
+// D1QueryMeta contains the execution details of a statement.
+type D1QueryMeta struct {
+	ChangedDB   bool    `json:"changed_db"`
+	Changes     int     `json:"changes"`
+	Duration    float64 `json:"duration"`
+	LastRowID   int64   `json:"last_row_id"`
+	RowsRead    int     `json:"rows_read"`
+	RowsWritten int     `json:"rows_written"`
+	SizeAfter   int64   `json:"size_after"`
+}
+
+// D1QueryResult is the outcome of a single statement. Results holds the
+// returned rows as a JSON array of objects keyed by column name; use
+// DecodeD1Rows to decode them.
+type D1QueryResult struct {
+	Results json.RawMessage `json:"results"`
+	Success bool            `json:"success"`
+	Meta    D1QueryMeta     `json:"meta"`
+}
+
+// D1QueryResponse represents the response from the D1 query endpoint.
+type D1QueryResponse struct {
+	Response
+	Result []D1QueryResult `json:"result"`
+}
+
+// List returns the D1 databases of an account.
+//
+// API reference: https://api.cloudflare.com/#d1-database-list-d1-databases
+func (s *D1Service) List(ctx context.Context, accountID string, params D1DatabaseListParams) ([]D1Database, ResultInfo, error) {
+	var databases []D1Database
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []D1Database, pageInfo ResultInfo) bool {
+		databases = append(databases, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []D1Database{}, ResultInfo{}, err
+	}
+
+	return databases, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#d1-database-list-d1-databases
+func (s *D1Service) ForEachPage(ctx context.Context, accountID string, params D1DatabaseListParams, fn func(items []D1Database, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/d1/database", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r D1DatabasesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal d1 database JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single D1 database.
+//
+// API reference: https://api.cloudflare.com/#d1-database-get-d1-database
+func (s *D1Service) Get(ctx context.Context, accountID, databaseID string) (D1Database, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return D1Database{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if databaseID == "" {
+		return D1Database{}, fmt.Errorf(errMissingResourceID, "database")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/d1/database/"+databaseID, nil)
+	if err != nil {
+		return D1Database{}, err
+	}
+
+	var r D1DatabaseResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return D1Database{}, fmt.Errorf("failed to unmarshal d1 database JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new D1 database.
+//
+// API reference: https://api.cloudflare.com/#d1-database-create-d1-database
+func (s *D1Service) Create(ctx context.Context, accountID string, params D1DatabaseCreateParams) (D1Database, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return D1Database{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Name == "" {
+		return D1Database{}, errors.New("name is required to create a D1 database")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/d1/database", params)
+	if err != nil {
+		return D1Database{}, err
+	}
+
+	var r D1DatabaseResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return D1Database{}, fmt.Errorf("failed to unmarshal d1 database JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a D1 database and all of its data.
+//
+// API reference: https://api.cloudflare.com/#d1-database-delete-d1-database
+func (s *D1Service) Delete(ctx context.Context, accountID, databaseID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if databaseID == "" {
+		return fmt.Errorf(errMissingResourceID, "database")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/d1/database/"+databaseID, nil)
+	return err
+}
+
+// Query executes SQL against a D1 database and returns the result of each
+// statement. Values should always be passed as parameters rather than
+// interpolated into the SQL.
+//
+// API reference: https://api.cloudflare.com/#d1-database-query-d1-database
+func (s *D1Service) Query(ctx context.Context, accountID, databaseID string, params D1QueryParams) ([]D1QueryResult, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []D1QueryResult{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if databaseID == "" {
+		return []D1QueryResult{}, fmt.Errorf(errMissingResourceID, "database")
+	}
+
+	if params.SQL == "" {
+		return []D1QueryResult{}, errors.New("SQL must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/d1/database/"+databaseID+"/query", params)
+	if err != nil {
+		return []D1QueryResult{}, err
+	}
+
+	var r D1QueryResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []D1QueryResult{}, fmt.Errorf("failed to unmarshal d1 query JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DecodeD1Rows decodes the rows of a statement into a slice of T. Columns are
+// matched to fields using the same rules as encoding/json, so struct fields
+// can be mapped with `json` tags.
+func DecodeD1Rows[T any](result D1QueryResult) ([]T, error) {
+	rows := []T{}
+	if len(result.Results) == 0 {
+		return rows, nil
+	}
+
+	if err := json.Unmarshal(result.Results, &rows); err != nil {
+		return []T{}, fmt.Errorf("failed to unmarshal d1 rows: %w", err)
+	}
+
+	return rows, nil
+}
+
+// QueryD1 executes SQL against a D1 database and decodes the rows returned by
+// the final statement into a slice of T.
+//
+//	type User struct {
+//		ID    int    `json:"id"`
+//		Email string `json:"email"`
+//	}
+//
+//	users, err := cloudflare.QueryD1[User](ctx, client.D1, accountID, databaseID, cloudflare.D1QueryParams{
+//		SQL:    "SELECT id, email FROM users WHERE created_at > ?",
+//		Params: []interface{}{since.Unix()},
+//	})
+func QueryD1[T any](ctx context.Context, s *D1Service, accountID, databaseID string, params D1QueryParams) ([]T, error) {
+	results, err := s.Query(ctx, accountID, databaseID, params)
+	if err != nil {
+		return []T{}, err
+	}
+
+	if len(results) == 0 {
+		return []T{}, nil
+	}
+
+	return DecodeD1Rows[T](results[len(results)-1])
+}
@@ -0,0 +1,163 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+)
+
+// DDoSSensitivityLevel is how readily a DDoS managed rule triggers. Lower
+// sensitivities require more traffic before the rule acts.
+type DDoSSensitivityLevel string
+
+const (
+	DDoSSensitivityHigh           DDoSSensitivityLevel = "default"
+	DDoSSensitivityMedium         DDoSSensitivityLevel = "medium"
+	DDoSSensitivityLow            DDoSSensitivityLevel = "low"
+	DDoSSensitivityEssentiallyOff DDoSSensitivityLevel = "eoff"
+)
+
+const (
+	// DDoSL7ManagedRulesetID is the ID of the HTTP DDoS Attack Protection
+	// managed ruleset executed in the ddos_l7 phase.
+	DDoSL7ManagedRulesetID = "4d21379b4f9f4bb088e0729962c8b3cf"
+
+	// DDoSL4ManagedRulesetID is the ID of the Network-layer DDoS Attack
+	// Protection managed ruleset executed in the ddos_l4 phase.
+	DDoSL4ManagedRulesetID = "3b64149bfa6e4220bbbc2bd6db589552"
+)
+
+// DDoSOverride changes the action or sensitivity of DDoS managed rules. It
+// applies to the rule RuleID, to every rule tagged Tag, or to the whole
+// ruleset when neither is set. Use DDoSRuleOverride, DDoSTagOverride and
+// DDoSRulesetOverride to build one.
+type DDoSOverride struct {
+	RuleID           string
+	Tag              string
+	Action           RulesetRuleAction
+	SensitivityLevel DDoSSensitivityLevel
+}
+
+// DDoSRuleOverride overrides a single DDoS managed rule. An empty action or
+// sensitivity leaves it unchanged.
+func DDoSRuleOverride(ruleID string, action RulesetRuleAction, sensitivity DDoSSensitivityLevel) DDoSOverride {
+	return DDoSOverride{RuleID: ruleID, Action: action, SensitivityLevel: sensitivity}
+}
+
+// DDoSTagOverride overrides every DDoS managed rule tagged tag, such as
+// "botnets" or "udp". An empty action or sensitivity leaves them unchanged.
+func DDoSTagOverride(tag string, action RulesetRuleAction, sensitivity DDoSSensitivityLevel) DDoSOverride {
+	return DDoSOverride{Tag: tag, Action: action, SensitivityLevel: sensitivity}
+}
+
+// DDoSRulesetOverride overrides every rule of the DDoS managed ruleset. Rule
+// and tag overrides take precedence over it.
+func DDoSRulesetOverride(action RulesetRuleAction, sensitivity DDoSSensitivityLevel) DDoSOverride {
+	return DDoSOverride{Action: action, SensitivityLevel: sensitivity}
+}
+
+// DDoSL7OverrideRule returns a rule of the ddos_l7 phase executing the HTTP
+// DDoS managed ruleset with overrides for requests matching expression. An
+// empty expression matches every request.
+func DDoSL7OverrideRule(expression, description string, overrides ...DDoSOverride) (RulesetRule, error) {
+	return ddosOverrideRule(RulesetPhaseDDoSL7, DDoSL7ManagedRulesetID, expression, description, overrides)
+}
+
+// DDoSL4OverrideRule returns a rule of the ddos_l4 phase executing the
+// Network-layer DDoS managed ruleset with overrides for packets matching
+// expression. An empty expression matches every packet.
+func DDoSL4OverrideRule(expression, description string, overrides ...DDoSOverride) (RulesetRule, error) {
+	return ddosOverrideRule(RulesetPhaseDDoSL4, DDoSL4ManagedRulesetID, expression, description, overrides)
+}
+
+func ddosOverrideRule(phase RulesetPhase, rulesetID, expression, description string, overrides []DDoSOverride) (RulesetRule, error) {
+	if len(overrides) == 0 {
+		return RulesetRule{}, errors.New("at least one override must be provided")
+	}
+
+	if expression == "" {
+		expression = "true"
+	}
+
+	params := &RulesetRuleActionParametersOverrides{}
+	seen := make(map[string]bool, len(overrides))
+	for _, o := range overrides {
+		if err := validateDDoSOverride(phase, o); err != nil {
+			return RulesetRule{}, err
+		}
+
+		switch {
+		case o.RuleID != "":
+			if seen["rule:"+o.RuleID] {
+				return RulesetRule{}, fmt.Errorf("rule %s is overridden more than once", o.RuleID)
+			}
+			seen["rule:"+o.RuleID] = true
+
+			params.Rules = append(params.Rules, RulesetRuleActionParametersRules{
+				ID:               o.RuleID,
+				Action:           o.Action,
+				SensitivityLevel: string(o.SensitivityLevel),
+			})
+		case o.Tag != "":
+			if seen["tag:"+o.Tag] {
+				return RulesetRule{}, fmt.Errorf("tag %s is overridden more than once", o.Tag)
+			}
+			seen["tag:"+o.Tag] = true
+
+			params.Categories = append(params.Categories, RulesetRuleActionParametersCategories{
+				Category:         o.Tag,
+				Action:           o.Action,
+				SensitivityLevel: string(o.SensitivityLevel),
+			})
+		default:
+			if seen["ruleset"] {
+				return RulesetRule{}, errors.New("the ruleset is overridden more than once")
+			}
+			seen["ruleset"] = true
+
+			params.Action = o.Action
+			params.SensitivityLevel = string(o.SensitivityLevel)
+		}
+	}
+
+	return RulesetRule{
+		Action:      RulesetRuleActionExecute,
+		Expression:  expression,
+		Description: description,
+		Enabled:     Bool(true),
+		ActionParameters: &RulesetRuleActionParameters{
+			ID:        rulesetID,
+			Overrides: params,
+		},
+	}, nil
+}
+
+// validateDDoSOverride checks that an override targets either a rule or a
+// tag and uses an action and sensitivity available in phase. Network-layer
+// rules cannot challenge as there is no HTTP response to challenge with.
+func validateDDoSOverride(phase RulesetPhase, o DDoSOverride) error {
+	if o.RuleID != "" && o.Tag != "" {
+		return errors.New("an override cannot target both a rule and a tag")
+	}
+
+	if o.Action == "" && o.SensitivityLevel == "" {
+		return errors.New("an override must change the action or sensitivity")
+	}
+
+	switch o.SensitivityLevel {
+	case "", DDoSSensitivityHigh, DDoSSensitivityMedium, DDoSSensitivityLow, DDoSSensitivityEssentiallyOff:
+	default:
+		return fmt.Errorf("invalid sensitivity level: %q", o.SensitivityLevel)
+	}
+
+	switch o.Action {
+	case "", RulesetRuleActionBlock, RulesetRuleActionLog, RulesetRuleActionDDoSDynamic:
+	case RulesetRuleActionChallenge, RulesetRuleActionJSChallenge, RulesetRuleActionManagedChallenge:
+		if phase != RulesetPhaseDDoSL7 {
+			return fmt.Errorf("action %s is not available in the %s phase", o.Action, phase)
+		}
+	default:
+		return fmt.Errorf("invalid DDoS override action: %q", o.Action)
+	}
+
+	return nil
+}
@@ -0,0 +1,262 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SplitTunnelMode selects whether a split tunnel list names the traffic
+// excluded from WARP or the only traffic included in it.
+type SplitTunnelMode string
+
+const (
+	SplitTunnelModeExclude SplitTunnelMode = "exclude"
+	SplitTunnelModeInclude SplitTunnelMode = "include"
+)
+
+// SplitTunnelEntry is a single address or hostname of a split tunnel list.
+// Exactly one of Address and Host should be set.
+type SplitTunnelEntry struct {
+	Address     string `json:"address,omitempty"`
+	Host        string `json:"host,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// FallbackDomain is a domain whose DNS queries WARP sends to DNSServer, or
+// to the device's resolver when DNSServer is empty, instead of Gateway.
+type FallbackDomain struct {
+	Suffix      string   `json:"suffix"`
+	Description string   `json:"description,omitempty"`
+	DNSServer   []string `json:"dns_server,omitempty"`
+}
+
+// SplitTunnelResponse represents the response from the split tunnel
+// endpoints.
+type SplitTunnelResponse struct {
+	Response
+	Result []SplitTunnelEntry `json:"result"`
+}
+
+// FallbackDomainResponse represents the response from the fallback domain
+// endpoints.
+type FallbackDomainResponse struct {
+	Response
+	Result []FallbackDomain `json:"result"`
+}
+
+// devicePolicyURI returns the path of a device settings policy, using the
+// account's default policy when policyID is empty.
+func devicePolicyURI(accountID, policyID string) string {
+	if policyID == "" {
+		return "/accounts/" + accountID + "/devices/policy"
+	}
+	return "/accounts/" + accountID + "/devices/policy/" + policyID
+}
+
+// GetSplitTunnel returns the split tunnel list of a device settings policy.
+// An empty policyID selects the account's default policy.
+//
+// API reference: https://api.cloudflare.com/#devices-get-split-tunnel-exclude-list
+func (s *DevicesService) GetSplitTunnel(ctx context.Context, accountID, policyID string, mode SplitTunnelMode) ([]SplitTunnelEntry, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []SplitTunnelEntry{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if mode != SplitTunnelModeExclude && mode != SplitTunnelModeInclude {
+		return []SplitTunnelEntry{}, fmt.Errorf("invalid split tunnel mode: %q", mode)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, devicePolicyURI(accountID, policyID)+"/"+string(mode), nil)
+	if err != nil {
+		return []SplitTunnelEntry{}, err
+	}
+
+	var r SplitTunnelResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SplitTunnelEntry{}, fmt.Errorf("failed to unmarshal split tunnel JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSplitTunnel replaces the split tunnel list of a device settings
+// policy. An empty policyID selects the account's default policy.
+//
+// API reference: https://api.cloudflare.com/#devices-set-split-tunnel-exclude-list
+func (s *DevicesService) UpdateSplitTunnel(ctx context.Context, accountID, policyID string, mode SplitTunnelMode, entries []SplitTunnelEntry) ([]SplitTunnelEntry, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []SplitTunnelEntry{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if mode != SplitTunnelModeExclude && mode != SplitTunnelModeInclude {
+		return []SplitTunnelEntry{}, fmt.Errorf("invalid split tunnel mode: %q", mode)
+	}
+
+	if entries == nil {
+		entries = []SplitTunnelEntry{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, devicePolicyURI(accountID, policyID)+"/"+string(mode), entries)
+	if err != nil {
+		return []SplitTunnelEntry{}, err
+	}
+
+	var r SplitTunnelResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SplitTunnelEntry{}, fmt.Errorf("failed to unmarshal split tunnel JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// AddSplitTunnelEntries appends entries to a split tunnel list, keeping the
+// existing entries. Entries whose address or host is already present replace
+// the existing entry.
+func (s *DevicesService) AddSplitTunnelEntries(ctx context.Context, accountID, policyID string, mode SplitTunnelMode, entries ...SplitTunnelEntry) ([]SplitTunnelEntry, error) {
+	current, err := s.GetSplitTunnel(ctx, accountID, policyID, mode)
+	if err != nil {
+		return []SplitTunnelEntry{}, err
+	}
+
+	index := make(map[string]int, len(current))
+	for i, e := range current {
+		index[e.Address+"|"+e.Host] = i
+	}
+	for _, e := range entries {
+		if i, ok := index[e.Address+"|"+e.Host]; ok {
+			current[i] = e
+			continue
+		}
+		index[e.Address+"|"+e.Host] = len(current)
+		current = append(current, e)
+	}
+
+	return s.UpdateSplitTunnel(ctx, accountID, policyID, mode, current)
+}
+
+// RemoveSplitTunnelEntries removes the entries matching the given addresses
+// or hosts from a split tunnel list, keeping every other entry.
+func (s *DevicesService) RemoveSplitTunnelEntries(ctx context.Context, accountID, policyID string, mode SplitTunnelMode, addressesOrHosts ...string) ([]SplitTunnelEntry, error) {
+	current, err := s.GetSplitTunnel(ctx, accountID, policyID, mode)
+	if err != nil {
+		return []SplitTunnelEntry{}, err
+	}
+
+	remove := make(map[string]bool, len(addressesOrHosts))
+	for _, v := range addressesOrHosts {
+		remove[v] = true
+	}
+
+	kept := make([]SplitTunnelEntry, 0, len(current))
+	for _, e := range current {
+		if (e.Address != "" && remove[e.Address]) || (e.Host != "" && remove[e.Host]) {
+			continue
+		}
+		kept = append(kept, e)
+	}
+
+	return s.UpdateSplitTunnel(ctx, accountID, policyID, mode, kept)
+}
+
+// GetFallbackDomains returns the local domain fallback list of a device
+// settings policy. An empty policyID selects the account's default policy.
+//
+// API reference: https://api.cloudflare.com/#devices-get-local-domain-fallback-list
+func (s *DevicesService) GetFallbackDomains(ctx context.Context, accountID, policyID string) ([]FallbackDomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []FallbackDomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, devicePolicyURI(accountID, policyID)+"/fallback_domains", nil)
+	if err != nil {
+		return []FallbackDomain{}, err
+	}
+
+	var r FallbackDomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []FallbackDomain{}, fmt.Errorf("failed to unmarshal fallback domain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateFallbackDomains replaces the local domain fallback list of a device
+// settings policy. An empty policyID selects the account's default policy.
+//
+// API reference: https://api.cloudflare.com/#devices-set-local-domain-fallback-list
+func (s *DevicesService) UpdateFallbackDomains(ctx context.Context, accountID, policyID string, domains []FallbackDomain) ([]FallbackDomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []FallbackDomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if domains == nil {
+		domains = []FallbackDomain{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, devicePolicyURI(accountID, policyID)+"/fallback_domains", domains)
+	if err != nil {
+		return []FallbackDomain{}, err
+	}
+
+	var r FallbackDomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []FallbackDomain{}, fmt.Errorf("failed to unmarshal fallback domain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// AddFallbackDomains appends domains to a local domain fallback list,
+// keeping the existing domains. Domains whose suffix is already present
+// replace the existing entry.
+func (s *DevicesService) AddFallbackDomains(ctx context.Context, accountID, policyID string, domains ...FallbackDomain) ([]FallbackDomain, error) {
+	current, err := s.GetFallbackDomains(ctx, accountID, policyID)
+	if err != nil {
+		return []FallbackDomain{}, err
+	}
+
+	index := make(map[string]int, len(current))
+	for i, d := range current {
+		index[d.Suffix] = i
+	}
+	for _, d := range domains {
+		if i, ok := index[d.Suffix]; ok {
+			current[i] = d
+			continue
+		}
+		index[d.Suffix] = len(current)
+		current = append(current, d)
+	}
+
+	return s.UpdateFallbackDomains(ctx, accountID, policyID, current)
+}
+
+// RemoveFallbackDomains removes the domains with the given suffixes from a
+// local domain fallback list, keeping every other domain.
+func (s *DevicesService) RemoveFallbackDomains(ctx context.Context, accountID, policyID string, suffixes ...string) ([]FallbackDomain, error) {
+	current, err := s.GetFallbackDomains(ctx, accountID, policyID)
+	if err != nil {
+		return []FallbackDomain{}, err
+	}
+
+	remove := make(map[string]bool, len(suffixes))
+	for _, suffix := range suffixes {
+		remove[suffix] = true
+	}
+
+	kept := make([]FallbackDomain, 0, len(current))
+	for _, d := range current {
+		if remove[d.Suffix] {
+			continue
+		}
+		kept = append(kept, d)
+	}
+
+	return s.UpdateFallbackDomains(ctx, accountID, policyID, kept)
+}
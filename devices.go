@@ -0,0 +1,462 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type DevicesService service
+
+// Device is a device enrolled in Zero Trust with the WARP client.
+type Device struct {
+	ID           string      `json:"id"`
+	Key          string      `json:"key,omitempty"`
+	Name         string      `json:"name"`
+	DeviceType   string      `json:"device_type"`
+	Model        string      `json:"model"`
+	OSVersion    string      `json:"os_version"`
+	Version      string      `json:"version"`
+	SerialNumber string      `json:"serial_number,omitempty"`
+	MacAddress   string      `json:"mac_address,omitempty"`
+	IP           string      `json:"ip"`
+	User         *DeviceUser `json:"user,omitempty"`
+	Created      *time.Time  `json:"created,omitempty"`
+	Updated      *time.Time  `json:"updated,omitempty"`
+	LastSeen     *time.Time  `json:"last_seen,omitempty"`
+	RevokedAt    *time.Time  `json:"revoked_at,omitempty"`
+	Deleted      bool        `json:"deleted,omitempty"`
+}
+
+// DeviceUser is the user a device is registered to.
+type DeviceUser struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// DevicePostureRule is a check run against enrolled devices whose result
+// can be required by Access and Gateway policies.
+//
+// Expiration and Schedule are durations such as "1h" or "5m"; Schedule is
+// how often the check runs and Expiration how long a result stays valid.
+type DevicePostureRule struct {
+	ID          string                   `json:"id,omitempty"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Type        string                   `json:"type"`
+	Schedule    string                   `json:"schedule,omitempty"`
+	Expiration  string                   `json:"expiration,omitempty"`
+	Match       []DevicePostureRuleMatch `json:"match,omitempty"`
+	Input       DevicePostureRuleInput   `json:"input,omitempty"`
+}
+
+// DevicePostureRuleMatch limits a posture rule to a platform such as
+// "windows", "mac", "linux", "android" or "ios".
+type DevicePostureRuleMatch struct {
+	Platform string `json:"platform,omitempty"`
+}
+
+// DevicePostureRuleInput holds the rule type specific conditions. Only the
+// fields relevant to the rule's type should be set.
+type DevicePostureRuleInput struct {
+	ID               string   `json:"id,omitempty"`
+	Path             string   `json:"path,omitempty"`
+	Exists           bool     `json:"exists,omitempty"`
+	Thumbprint       string   `json:"thumbprint,omitempty"`
+	Sha256           string   `json:"sha256,omitempty"`
+	Running          bool     `json:"running,omitempty"`
+	RequireAll       bool     `json:"requireAll,omitempty"`
+	CheckDisks       []string `json:"checkDisks,omitempty"`
+	Enabled          bool     `json:"enabled,omitempty"`
+	Version          string   `json:"version,omitempty"`
+	Operator         string   `json:"operator,omitempty"`
+	Domain           string   `json:"domain,omitempty"`
+	OSDistroName     string   `json:"os_distro_name,omitempty"`
+	OSDistroRevision string   `json:"os_distro_revision,omitempty"`
+	ComplianceStatus string   `json:"compliance_status,omitempty"`
+	ConnectionID     string   `json:"connection_id,omitempty"`
+	Overall          string   `json:"overall,omitempty"`
+	SensorConfig     string   `json:"sensor_config,omitempty"`
+	VersionOperator  string   `json:"versionOperator,omitempty"`
+	CountOperator    string   `json:"countOperator,omitempty"`
+	IssueCount       string   `json:"issue_count,omitempty"`
+	Score            int      `json:"score,omitempty"`
+}
+
+// DevicePostureIntegration connects a third party device management or
+// endpoint security provider to posture checks.
+//
+// Interval is how often the provider is polled, such as "10m".
+type DevicePostureIntegration struct {
+	ID       string                         `json:"id,omitempty"`
+	Name     string                         `json:"name"`
+	Type     string                         `json:"type"`
+	Interval string                         `json:"interval,omitempty"`
+	Config   DevicePostureIntegrationConfig `json:"config"`
+}
+
+// DevicePostureIntegrationConfig holds the credentials of an integration.
+// Which fields are required depends on the provider, for example
+// crowdstrike_s2s uses ClientID, ClientSecret, APIURL and CustomerID while
+// intune uses ClientID, ClientSecret and CustomerID.
+type DevicePostureIntegrationConfig struct {
+	ClientID           string `json:"client_id,omitempty"`
+	ClientSecret       string `json:"client_secret,omitempty"`
+	ClientKey          string `json:"client_key,omitempty"`
+	AuthURL            string `json:"auth_url,omitempty"`
+	APIURL             string `json:"api_url,omitempty"`
+	CustomerID         string `json:"customer_id,omitempty"`
+	AccessClientID     string `json:"access_client_id,omitempty"`
+	AccessClientSecret string `json:"access_client_secret,omitempty"`
+}
+
+// DeviceResponse represents the response from the devices endpoint
+// containing a single device.
+type DeviceResponse struct {
+	Response
+	Result Device `json:"result"`
+}
+
+// DevicesResponse represents the response from the devices endpoint
+// containing multiple devices.
+type DevicesResponse struct {
+	Response
+	Result []Device `json:"result"`
+}
+
+// DevicePostureRuleResponse represents the response from the posture rules
+// endpoint containing a single rule.
+type DevicePostureRuleResponse struct {
+	Response
+	Result DevicePostureRule `json:"result"`
+}
+
+// DevicePostureRulesResponse represents the response from the posture rules
+// endpoint containing multiple rules.
+type DevicePostureRulesResponse struct {
+	Response
+	Result []DevicePostureRule `json:"result"`
+}
+
+// DevicePostureIntegrationResponse represents the response from the posture
+// integrations endpoint containing a single integration.
+type DevicePostureIntegrationResponse struct {
+	Response
+	Result DevicePostureIntegration `json:"result"`
+}
+
+// DevicePostureIntegrationsResponse represents the response from the
+// posture integrations endpoint containing multiple integrations.
+type DevicePostureIntegrationsResponse struct {
+	Response
+	Result []DevicePostureIntegration `json:"result"`
+}
+
+// List returns the devices enrolled in an account.
+//
+// API reference: https://api.cloudflare.com/#devices-list-devices
+func (s *DevicesService) List(ctx context.Context, accountID string) ([]Device, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []Device{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/devices", nil)
+	if err != nil {
+		return []Device{}, err
+	}
+
+	var r DevicesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Device{}, fmt.Errorf("failed to unmarshal device JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single enrolled device.
+//
+// API reference: https://api.cloudflare.com/#devices-device-details
+func (s *DevicesService) Get(ctx context.Context, accountID, deviceID string) (Device, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Device{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if deviceID == "" {
+		return Device{}, fmt.Errorf(errMissingResourceID, "device")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/devices/"+deviceID, nil)
+	if err != nil {
+		return Device{}, err
+	}
+
+	var r DeviceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Device{}, fmt.Errorf("failed to unmarshal device JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Revoke revokes the registrations of devices, signing them out of WARP.
+//
+// API reference: https://api.cloudflare.com/#devices-revoke-devices
+func (s *DevicesService) Revoke(ctx context.Context, accountID string, deviceIDs []string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if len(deviceIDs) == 0 {
+		return errors.New("at least one device ID must be provided")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/devices/revoke", deviceIDs)
+	return err
+}
+
+// Unrevoke restores the registrations of previously revoked devices.
+//
+// API reference: https://api.cloudflare.com/#devices-unrevoke-devices
+func (s *DevicesService) Unrevoke(ctx context.Context, accountID string, deviceIDs []string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if len(deviceIDs) == 0 {
+		return errors.New("at least one device ID must be provided")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/devices/unrevoke", deviceIDs)
+	return err
+}
+
+// ListPostureRules returns the device posture rules of an account.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-list-device-posture-rules
+func (s *DevicesService) ListPostureRules(ctx context.Context, accountID string) ([]DevicePostureRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []DevicePostureRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/devices/posture", nil)
+	if err != nil {
+		return []DevicePostureRule{}, err
+	}
+
+	var r DevicePostureRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []DevicePostureRule{}, fmt.Errorf("failed to unmarshal device posture rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetPostureRule fetches a single device posture rule.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-device-posture-rules-details
+func (s *DevicesService) GetPostureRule(ctx context.Context, accountID, ruleID string) (DevicePostureRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DevicePostureRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return DevicePostureRule{}, fmt.Errorf(errMissingResourceID, "device posture rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/devices/posture/"+ruleID, nil)
+	if err != nil {
+		return DevicePostureRule{}, err
+	}
+
+	var r DevicePostureRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DevicePostureRule{}, fmt.Errorf("failed to unmarshal device posture rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreatePostureRule creates a new device posture rule.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-create-device-posture-rule
+func (s *DevicesService) CreatePostureRule(ctx context.Context, accountID string, rule DevicePostureRule) (DevicePostureRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DevicePostureRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/devices/posture", rule)
+	if err != nil {
+		return DevicePostureRule{}, err
+	}
+
+	var r DevicePostureRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DevicePostureRule{}, fmt.Errorf("failed to unmarshal device posture rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdatePostureRule replaces an existing device posture rule.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-update-device-posture-rule
+func (s *DevicesService) UpdatePostureRule(ctx context.Context, accountID, ruleID string, rule DevicePostureRule) (DevicePostureRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DevicePostureRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return DevicePostureRule{}, fmt.Errorf(errMissingResourceID, "device posture rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/devices/posture/"+ruleID, rule)
+	if err != nil {
+		return DevicePostureRule{}, err
+	}
+
+	var r DevicePostureRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DevicePostureRule{}, fmt.Errorf("failed to unmarshal device posture rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeletePostureRule removes a device posture rule.
+//
+// API reference: https://api.cloudflare.com/#device-posture-rules-delete-device-posture-rule
+func (s *DevicesService) DeletePostureRule(ctx context.Context, accountID, ruleID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return fmt.Errorf(errMissingResourceID, "device posture rule")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/devices/posture/"+ruleID, nil)
+	return err
+}
+
+// ListPostureIntegrations returns the device posture integrations of an
+// account.
+//
+// API reference: https://api.cloudflare.com/#device-posture-integrations-list-your-device-posture-integrations
+func (s *DevicesService) ListPostureIntegrations(ctx context.Context, accountID string) ([]DevicePostureIntegration, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []DevicePostureIntegration{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/devices/posture/integration", nil)
+	if err != nil {
+		return []DevicePostureIntegration{}, err
+	}
+
+	var r DevicePostureIntegrationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []DevicePostureIntegration{}, fmt.Errorf("failed to unmarshal device posture integration JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetPostureIntegration fetches a single device posture integration.
+//
+// API reference: https://api.cloudflare.com/#device-posture-integrations-device-posture-integration-details
+func (s *DevicesService) GetPostureIntegration(ctx context.Context, accountID, integrationID string) (DevicePostureIntegration, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DevicePostureIntegration{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if integrationID == "" {
+		return DevicePostureIntegration{}, fmt.Errorf(errMissingResourceID, "device posture integration")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/devices/posture/integration/"+integrationID, nil)
+	if err != nil {
+		return DevicePostureIntegration{}, err
+	}
+
+	var r DevicePostureIntegrationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DevicePostureIntegration{}, fmt.Errorf("failed to unmarshal device posture integration JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreatePostureIntegration connects a new device posture provider.
+//
+// API reference: https://api.cloudflare.com/#device-posture-integrations-create-device-posture-integration
+func (s *DevicesService) CreatePostureIntegration(ctx context.Context, accountID string, integration DevicePostureIntegration) (DevicePostureIntegration, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DevicePostureIntegration{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/devices/posture/integration", integration)
+	if err != nil {
+		return DevicePostureIntegration{}, err
+	}
+
+	var r DevicePostureIntegrationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DevicePostureIntegration{}, fmt.Errorf("failed to unmarshal device posture integration JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdatePostureIntegration modifies an existing device posture integration.
+//
+// API reference: https://api.cloudflare.com/#device-posture-integrations-update-device-posture-integration
+func (s *DevicesService) UpdatePostureIntegration(ctx context.Context, accountID, integrationID string, integration DevicePostureIntegration) (DevicePostureIntegration, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DevicePostureIntegration{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if integrationID == "" {
+		return DevicePostureIntegration{}, fmt.Errorf(errMissingResourceID, "device posture integration")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/devices/posture/integration/"+integrationID, integration)
+	if err != nil {
+		return DevicePostureIntegration{}, err
+	}
+
+	var r DevicePostureIntegrationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DevicePostureIntegration{}, fmt.Errorf("failed to unmarshal device posture integration JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeletePostureIntegration removes a device posture integration.
+//
+// API reference: https://api.cloudflare.com/#device-posture-integrations-delete-device-posture-integration
+func (s *DevicesService) DeletePostureIntegration(ctx context.Context, accountID, integrationID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if integrationID == "" {
+		return fmt.Errorf(errMissingResourceID, "device posture integration")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/devices/posture/integration/"+integrationID, nil)
+	return err
+}
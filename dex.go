@@ -0,0 +1,401 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type DEXService service
+
+// DEXTest is a synthetic test run by WARP clients to measure the experience
+// of reaching an application.
+//
+// Interval is how often the test runs, such as "30m".
+type DEXTest struct {
+	ID             string                `json:"test_id,omitempty"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description,omitempty"`
+	Interval       string                `json:"interval"`
+	Enabled        bool                  `json:"enabled"`
+	Data           DEXTestData           `json:"data"`
+	Targeted       bool                  `json:"targeted,omitempty"`
+	TargetPolicies []DEXTestTargetPolicy `json:"target_policies,omitempty"`
+	Updated        *time.Time            `json:"updated,omitempty"`
+	Created        *time.Time            `json:"created,omitempty"`
+}
+
+// DEXTestData is what a test checks. Kind is "http" or "traceroute"; Method
+// only applies to HTTP tests and must be "GET".
+type DEXTestData struct {
+	Kind   string `json:"kind"`
+	Host   string `json:"host"`
+	Method string `json:"method,omitempty"`
+}
+
+// DEXTestTargetPolicy limits a test to the devices of a device settings
+// policy.
+type DEXTestTargetPolicy struct {
+	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Default bool   `json:"default,omitempty"`
+}
+
+// DEXFleetStatus is a live summary of the devices connected to an account.
+type DEXFleetStatus struct {
+	DeviceStats DEXDeviceStats `json:"deviceStats"`
+}
+
+// DEXDeviceStats breaks down the number of unique devices.
+type DEXDeviceStats struct {
+	UniqueDevicesTotal int                  `json:"uniqueDevicesTotal"`
+	ByStatus           []DEXDeviceBreakdown `json:"byStatus"`
+	ByPlatform         []DEXDeviceBreakdown `json:"byPlatform"`
+	ByVersion          []DEXDeviceBreakdown `json:"byVersion"`
+	ByColo             []DEXDeviceBreakdown `json:"byColo"`
+	ByMode             []DEXDeviceBreakdown `json:"byMode"`
+}
+
+// DEXDeviceBreakdown is the number of unique devices with a given value.
+type DEXDeviceBreakdown struct {
+	Value              string `json:"value"`
+	UniqueDevicesTotal int    `json:"uniqueDevicesTotal"`
+}
+
+// DEXAggregate summarises a metric over a time range. Each slot covers one
+// interval of the query.
+type DEXAggregate struct {
+	Avg   float64       `json:"avg"`
+	Min   float64       `json:"min"`
+	Max   float64       `json:"max"`
+	Slots []DEXTimeSlot `json:"slots"`
+}
+
+// DEXTimeSlot is the value of a metric during one interval.
+type DEXTimeSlot struct {
+	Timestamp string  `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// DEXHTTPStatusSlot counts HTTP responses by status class during one
+// interval.
+type DEXHTTPStatusSlot struct {
+	Timestamp string `json:"timestamp"`
+	Status200 int    `json:"status200"`
+	Status300 int    `json:"status300"`
+	Status400 int    `json:"status400"`
+	Status500 int    `json:"status500"`
+}
+
+// DEXHTTPTestStats are the aggregated results of an HTTP test.
+type DEXHTTPTestStats struct {
+	UniqueDevicesTotal   int                 `json:"uniqueDevicesTotal"`
+	AvailabilityPct      DEXAggregate        `json:"availabilityPct"`
+	DNSResponseTimeMs    DEXAggregate        `json:"dnsResponseTimeMs"`
+	ResourceFetchTimeMs  DEXAggregate        `json:"resourceFetchTimeMs"`
+	ServerResponseTimeMs DEXAggregate        `json:"serverResponseTimeMs"`
+	HTTPStatusCode       []DEXHTTPStatusSlot `json:"httpStatusCode"`
+}
+
+// DEXHTTPTestResults are the results of an HTTP test over a time range.
+type DEXHTTPTestResults struct {
+	Name            string             `json:"name"`
+	Kind            string             `json:"kind"`
+	Interval        string             `json:"interval"`
+	Target          string             `json:"target"`
+	HTTPStats       *DEXHTTPTestStats  `json:"httpStats"`
+	HTTPStatsByColo []DEXHTTPTestStats `json:"httpStatsByColo"`
+}
+
+// DEXTracerouteTestStats are the aggregated results of a traceroute test.
+type DEXTracerouteTestStats struct {
+	UniqueDevicesTotal int          `json:"uniqueDevicesTotal"`
+	AvailabilityPct    DEXAggregate `json:"availabilityPct"`
+	HopsCount          DEXAggregate `json:"hopsCount"`
+	PacketLossPct      DEXAggregate `json:"packetLossPct"`
+	RoundTripTimeMs    DEXAggregate `json:"roundTripTimeMs"`
+}
+
+// DEXTracerouteTestResults are the results of a traceroute test over a time
+// range.
+type DEXTracerouteTestResults struct {
+	Name                  string                   `json:"name"`
+	Kind                  string                   `json:"kind"`
+	Interval              string                   `json:"interval"`
+	Target                string                   `json:"target"`
+	TracerouteStats       *DEXTracerouteTestStats  `json:"tracerouteStats"`
+	TracerouteStatsByColo []DEXTracerouteTestStats `json:"tracerouteStatsByColo"`
+}
+
+// DEXFleetStatusParams selects the window of a live fleet status query.
+type DEXFleetStatusParams struct {
+	SinceMinutes int `url:"since_minutes"`
+}
+
+// DEXTestResultParams describes a test results query. Interval is "minute"
+// or "hour".
+type DEXTestResultParams struct {
+	From      time.Time `url:"from"`
+	To        time.Time `url:"to"`
+	Interval  string    `url:"interval"`
+	DeviceIDs []string  `url:"deviceId,omitempty"`
+	Colo      string    `url:"colo,omitempty"`
+}
+
+// DEXTestResponse represents the response from the DEX tests endpoint
+// containing a single test.
+type DEXTestResponse struct {
+	Response
+	Result DEXTest `json:"result"`
+}
+
+// DEXTestsResponse represents the response from the DEX tests endpoint
+// containing multiple tests.
+type DEXTestsResponse struct {
+	Response
+	Result     []DEXTest  `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// DEXTestListParams contains the options available when listing DEX tests.
+type DEXTestListParams struct {
+	PaginationParams
+}
+
+// DEXFleetStatusResponse represents the response from the live fleet status
+// endpoint.
+type DEXFleetStatusResponse struct {
+	Response
+	Result DEXFleetStatus `json:"result"`
+}
+
+// DEXHTTPTestResultsResponse represents the response from the HTTP test
+// results endpoint.
+type DEXHTTPTestResultsResponse struct {
+	Response
+	Result DEXHTTPTestResults `json:"result"`
+}
+
+// DEXTracerouteTestResultsResponse represents the response from the
+// traceroute test results endpoint.
+type DEXTracerouteTestResultsResponse struct {
+	Response
+	Result DEXTracerouteTestResults `json:"result"`
+}
+
+// ListTests returns the DEX tests of an account.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-details
+func (s *DEXService) ListTests(ctx context.Context, accountID string, params DEXTestListParams) ([]DEXTest, ResultInfo, error) {
+	var tests []DEXTest
+	var info ResultInfo
+	err := s.ForEachTestsPage(ctx, accountID, params, func(page []DEXTest, pageInfo ResultInfo) bool {
+		tests = append(tests, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []DEXTest{}, ResultInfo{}, err
+	}
+
+	return tests, info, nil
+}
+
+// ForEachTestsPage is like ListTests but passes each page of results to fn as
+// it is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-details
+func (s *DEXService) ForEachTestsPage(ctx context.Context, accountID string, params DEXTestListParams, fn func(items []DEXTest, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/dex/devices/dex_tests", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r DEXTestsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal dex test JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetTest fetches a single DEX test.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-get-device-dex-test
+func (s *DEXService) GetTest(ctx context.Context, accountID, testID string) (DEXTest, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DEXTest{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if testID == "" {
+		return DEXTest{}, fmt.Errorf(errMissingResourceID, "dex test")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/dex/devices/dex_tests/"+testID, nil)
+	if err != nil {
+		return DEXTest{}, err
+	}
+
+	var r DEXTestResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DEXTest{}, fmt.Errorf("failed to unmarshal dex test JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateTest creates a new DEX test.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-create-device-dex-test
+func (s *DEXService) CreateTest(ctx context.Context, accountID string, test DEXTest) (DEXTest, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DEXTest{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if test.Data.Kind != "http" && test.Data.Kind != "traceroute" {
+		return DEXTest{}, errors.New("dex test kind must be either http or traceroute")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/dex/devices/dex_tests", test)
+	if err != nil {
+		return DEXTest{}, err
+	}
+
+	var r DEXTestResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DEXTest{}, fmt.Errorf("failed to unmarshal dex test JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateTest replaces an existing DEX test.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-update-device-dex-test
+func (s *DEXService) UpdateTest(ctx context.Context, accountID, testID string, test DEXTest) (DEXTest, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DEXTest{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if testID == "" {
+		return DEXTest{}, fmt.Errorf(errMissingResourceID, "dex test")
+	}
+
+	if test.Data.Kind != "http" && test.Data.Kind != "traceroute" {
+		return DEXTest{}, errors.New("dex test kind must be either http or traceroute")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/dex/devices/dex_tests/"+testID, test)
+	if err != nil {
+		return DEXTest{}, err
+	}
+
+	var r DEXTestResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DEXTest{}, fmt.Errorf("failed to unmarshal dex test JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteTest removes a DEX test.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-delete-device-dex-test
+func (s *DEXService) DeleteTest(ctx context.Context, accountID, testID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if testID == "" {
+		return fmt.Errorf(errMissingResourceID, "dex test")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/dex/devices/dex_tests/"+testID, nil)
+	return err
+}
+
+// FleetStatus returns a live breakdown of the devices connected to an
+// account.
+//
+// API reference: https://api.cloudflare.com/#dex-fleet-status-live
+func (s *DEXService) FleetStatus(ctx context.Context, accountID string, params DEXFleetStatusParams) (DEXFleetStatus, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DEXFleetStatus{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/dex/fleet-status/live", params), nil)
+	if err != nil {
+		return DEXFleetStatus{}, err
+	}
+
+	var r DEXFleetStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DEXFleetStatus{}, fmt.Errorf("failed to unmarshal dex fleet status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// HTTPTestResults returns the aggregated results of an HTTP test.
+//
+// API reference: https://api.cloudflare.com/#dex-synthetic-application-monitor-get-details-and-aggregate-metrics-for-an-http-test
+func (s *DEXService) HTTPTestResults(ctx context.Context, accountID, testID string, params DEXTestResultParams) (DEXHTTPTestResults, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DEXHTTPTestResults{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if testID == "" {
+		return DEXHTTPTestResults{}, fmt.Errorf(errMissingResourceID, "dex test")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/dex/http-tests/"+testID, params), nil)
+	if err != nil {
+		return DEXHTTPTestResults{}, err
+	}
+
+	var r DEXHTTPTestResultsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DEXHTTPTestResults{}, fmt.Errorf("failed to unmarshal dex http test results JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// TracerouteTestResults returns the aggregated results of a traceroute
+// test.
+//
+// API reference: https://api.cloudflare.com/#dex-synthetic-application-monitor-get-details-and-aggregate-metrics-for-a-traceroute-test
+func (s *DEXService) TracerouteTestResults(ctx context.Context, accountID, testID string, params DEXTestResultParams) (DEXTracerouteTestResults, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DEXTracerouteTestResults{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if testID == "" {
+		return DEXTracerouteTestResults{}, fmt.Errorf(errMissingResourceID, "dex test")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/dex/traceroute-tests/"+testID, params), nil)
+	if err != nil {
+		return DEXTracerouteTestResults{}, err
+	}
+
+	var r DEXTracerouteTestResultsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DEXTracerouteTestResults{}, fmt.Errorf("failed to unmarshal dex traceroute test results JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,238 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type DLPService service
+
+// DLPConfidenceThreshold controls how confident a detection must be before
+// a profile matches.
+type DLPConfidenceThreshold string
+
+const (
+	DLPConfidenceThresholdLow      DLPConfidenceThreshold = "low"
+	DLPConfidenceThresholdMedium   DLPConfidenceThreshold = "medium"
+	DLPConfidenceThresholdHigh     DLPConfidenceThreshold = "high"
+	DLPConfidenceThresholdVeryHigh DLPConfidenceThreshold = "very_high"
+)
+
+// DLPProfile is a set of detection entries that Gateway HTTP rules can match
+// with `any(dlp.profiles[*] in {"<id>"})`. Profiles are either "predefined",
+// where only entries can be toggled, or "custom".
+type DLPProfile struct {
+	ID                  string                 `json:"id,omitempty"`
+	Name                string                 `json:"name,omitempty"`
+	Type                string                 `json:"type,omitempty"`
+	Description         string                 `json:"description,omitempty"`
+	AllowedMatchCount   int                    `json:"allowed_match_count"`
+	ConfidenceThreshold DLPConfidenceThreshold `json:"confidence_threshold,omitempty"`
+	OCREnabled          bool                   `json:"ocr_enabled,omitempty"`
+	ContextAwareness    *DLPContextAwareness   `json:"context_awareness,omitempty"`
+	Entries             []DLPEntry             `json:"entries,omitempty"`
+	CreatedAt           *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt           *time.Time             `json:"updated_at,omitempty"`
+}
+
+// DLPEntry is a single detection of a profile. Entries of custom profiles
+// match Pattern; entries of predefined profiles can only be enabled or
+// disabled.
+type DLPEntry struct {
+	ID        string      `json:"id,omitempty"`
+	Name      string      `json:"name,omitempty"`
+	ProfileID string      `json:"profile_id,omitempty"`
+	Enabled   bool        `json:"enabled"`
+	Type      string      `json:"type,omitempty"`
+	Pattern   *DLPPattern `json:"pattern,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+// DLPPattern is a regular expression matched against content. Validation
+// optionally checks matches further, for example "luhn" for card numbers.
+type DLPPattern struct {
+	Regex      string `json:"regex"`
+	Validation string `json:"validation,omitempty"`
+}
+
+// DLPContextAwareness requires supporting keywords near a match before the
+// profile triggers.
+type DLPContextAwareness struct {
+	Enabled bool                    `json:"enabled"`
+	Skip    DLPContextAwarenessSkip `json:"skip"`
+}
+
+// DLPContextAwarenessSkip lists the content context awareness is not applied
+// to.
+type DLPContextAwarenessSkip struct {
+	Files bool `json:"files"`
+}
+
+// DLPProfileResponse represents the response from the DLP profiles endpoint
+// containing a single profile.
+type DLPProfileResponse struct {
+	Response
+	Result DLPProfile `json:"result"`
+}
+
+// DLPProfilesResponse represents the response from the DLP profiles endpoint
+// containing multiple profiles.
+type DLPProfilesResponse struct {
+	Response
+	Result []DLPProfile `json:"result"`
+}
+
+// dlpProfilesCreateParams is the request body used to create custom
+// profiles.
+type dlpProfilesCreateParams struct {
+	Profiles []DLPProfile `json:"profiles"`
+}
+
+// ListProfiles returns the predefined and custom DLP profiles of an account.
+//
+// API reference: https://api.cloudflare.com/#dlp-profiles-list-all-profiles
+func (s *DLPService) ListProfiles(ctx context.Context, accountID string) ([]DLPProfile, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []DLPProfile{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/dlp/profiles", nil)
+	if err != nil {
+		return []DLPProfile{}, err
+	}
+
+	var r DLPProfilesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []DLPProfile{}, fmt.Errorf("failed to unmarshal dlp profile JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetProfile fetches a single DLP profile.
+//
+// API reference: https://api.cloudflare.com/#dlp-profiles-get-dlp-profile
+func (s *DLPService) GetProfile(ctx context.Context, accountID, profileID string) (DLPProfile, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DLPProfile{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if profileID == "" {
+		return DLPProfile{}, fmt.Errorf(errMissingResourceID, "dlp profile")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/dlp/profiles/"+profileID, nil)
+	if err != nil {
+		return DLPProfile{}, err
+	}
+
+	var r DLPProfileResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DLPProfile{}, fmt.Errorf("failed to unmarshal dlp profile JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateCustomProfiles creates one or more custom DLP profiles.
+//
+// API reference: https://api.cloudflare.com/#dlp-profiles-create-custom-profiles
+func (s *DLPService) CreateCustomProfiles(ctx context.Context, accountID string, profiles []DLPProfile) ([]DLPProfile, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []DLPProfile{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if len(profiles) == 0 {
+		return []DLPProfile{}, errors.New("at least one profile must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/dlp/profiles/custom", dlpProfilesCreateParams{Profiles: profiles})
+	if err != nil {
+		return []DLPProfile{}, err
+	}
+
+	var r DLPProfilesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []DLPProfile{}, fmt.Errorf("failed to unmarshal dlp profile JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateCustomProfile replaces a custom DLP profile and its entries.
+//
+// API reference: https://api.cloudflare.com/#dlp-profiles-update-custom-profile
+func (s *DLPService) UpdateCustomProfile(ctx context.Context, accountID, profileID string, profile DLPProfile) (DLPProfile, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DLPProfile{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if profileID == "" {
+		return DLPProfile{}, fmt.Errorf(errMissingResourceID, "dlp profile")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/dlp/profiles/custom/"+profileID, profile)
+	if err != nil {
+		return DLPProfile{}, err
+	}
+
+	var r DLPProfileResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DLPProfile{}, fmt.Errorf("failed to unmarshal dlp profile JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteCustomProfile removes a custom DLP profile.
+//
+// API reference: https://api.cloudflare.com/#dlp-profiles-delete-custom-profile
+func (s *DLPService) DeleteCustomProfile(ctx context.Context, accountID, profileID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if profileID == "" {
+		return fmt.Errorf(errMissingResourceID, "dlp profile")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/dlp/profiles/custom/"+profileID, nil)
+	return err
+}
+
+// UpdatePredefinedProfile enables or disables the entries of a predefined
+// DLP profile and changes its match thresholds. Only the entry IDs and
+// Enabled flags are used.
+//
+// API reference: https://api.cloudflare.com/#dlp-profiles-update-predefined-profile
+func (s *DLPService) UpdatePredefinedProfile(ctx context.Context, accountID, profileID string, profile DLPProfile) (DLPProfile, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DLPProfile{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if profileID == "" {
+		return DLPProfile{}, fmt.Errorf(errMissingResourceID, "dlp profile")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/dlp/profiles/predefined/"+profileID, profile)
+	if err != nil {
+		return DLPProfile{}, err
+	}
+
+	var r DLPProfileResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DLPProfile{}, fmt.Errorf("failed to unmarshal dlp profile JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,285 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+type DNSFirewallService service
+
+// DNSFirewallCluster is a DNS Firewall cluster: a set of Cloudflare
+// addresses, DNSFirewallIPs, caching and protecting the authoritative
+// nameservers at UpstreamIPs.
+//
+// Cached responses are kept for at least MinimumCacheTTL and at most
+// MaximumCacheTTL seconds, and negative responses for at most
+// NegativeCacheTTL seconds. Ratelimit caps the queries per second sent
+// upstream by each Cloudflare data center and Retries is the number of
+// attempts made when an upstream nameserver times out.
+type DNSFirewallCluster struct {
+	ID                   string     `json:"id,omitempty"`
+	Name                 string     `json:"name"`
+	UpstreamIPs          []string   `json:"upstream_ips"`
+	DNSFirewallIPs       []string   `json:"dns_firewall_ips,omitempty"`
+	MinimumCacheTTL      uint       `json:"minimum_cache_ttl,omitempty"`
+	MaximumCacheTTL      uint       `json:"maximum_cache_ttl,omitempty"`
+	NegativeCacheTTL     *uint      `json:"negative_cache_ttl,omitempty"`
+	Ratelimit            *uint      `json:"ratelimit,omitempty"`
+	Retries              uint       `json:"retries,omitempty"`
+	DeprecateAnyRequests bool       `json:"deprecate_any_requests"`
+	ECSFallback          bool       `json:"ecs_fallback"`
+	ModifiedOn           *time.Time `json:"modified_on,omitempty"`
+}
+
+// DNSFirewallClusterResponse represents the response from the DNS Firewall
+// endpoint containing a single cluster.
+type DNSFirewallClusterResponse struct {
+	Response
+	Result DNSFirewallCluster `json:"result"`
+}
+
+// DNSFirewallClustersResponse represents the response from the DNS Firewall
+// endpoint containing multiple clusters.
+type DNSFirewallClustersResponse struct {
+	Response
+	Result     []DNSFirewallCluster `json:"result"`
+	ResultInfo ResultInfo           `json:"result_info"`
+}
+
+// DNSFirewallClusterListParams contains the pagination options for listing
+// DNS Firewall clusters.
+type DNSFirewallClusterListParams struct {
+	PaginationParams
+}
+
+// DNSFirewallAnalyticsParams describes a DNS Firewall analytics report.
+// Metrics include queryCount, uncachedCount, staleCount, responseTimeAvg
+// and responseTimeMedian; dimensions include queryName, queryType,
+// responseCode, coloName and ipVersion.
+type DNSFirewallAnalyticsParams struct {
+	Metrics    []string   `url:"metrics,comma,omitempty"`
+	Dimensions []string   `url:"dimensions,comma,omitempty"`
+	Since      *time.Time `url:"since,omitempty"`
+	Until      *time.Time `url:"until,omitempty"`
+	Filters    string     `url:"filters,omitempty"`
+	Sort       []string   `url:"sort,comma,omitempty"`
+	Limit      int        `url:"limit,omitempty"`
+}
+
+// DNSFirewallAnalytics is the result of a DNS Firewall analytics report.
+type DNSFirewallAnalytics struct {
+	Rows    int                       `json:"rows"`
+	Data    []DNSFirewallAnalyticsRow `json:"data"`
+	DataLag float64                   `json:"data_lag"`
+	Min     map[string]float64        `json:"min"`
+	Max     map[string]float64        `json:"max"`
+	Totals  map[string]float64        `json:"totals"`
+}
+
+// DNSFirewallAnalyticsRow holds the metrics of one combination of dimension
+// values, in the order they were requested.
+type DNSFirewallAnalyticsRow struct {
+	Dimensions []string  `json:"dimensions"`
+	Metrics    []float64 `json:"metrics"`
+}
+
+// DNSFirewallAnalyticsResponse represents the response from the DNS
+// Firewall analytics report endpoint.
+type DNSFirewallAnalyticsResponse struct {
+	Response
+	Result DNSFirewallAnalytics `json:"result"`
+}
+
+// List returns the DNS Firewall clusters of an account.
+//
+// API reference: https://api.cloudflare.com/#dns-firewall-list-dns-firewall-clusters
+func (s *DNSFirewallService) List(ctx context.Context, accountID string, params DNSFirewallClusterListParams) ([]DNSFirewallCluster, ResultInfo, error) {
+	var clusters []DNSFirewallCluster
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []DNSFirewallCluster, pageInfo ResultInfo) bool {
+		clusters = append(clusters, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []DNSFirewallCluster{}, ResultInfo{}, err
+	}
+
+	return clusters, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#dns-firewall-list-dns-firewall-clusters
+func (s *DNSFirewallService) ForEachPage(ctx context.Context, accountID string, params DNSFirewallClusterListParams, fn func(items []DNSFirewallCluster, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/dns_firewall", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r DNSFirewallClustersResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal dns firewall cluster JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single DNS Firewall cluster.
+//
+// API reference: https://api.cloudflare.com/#dns-firewall-dns-firewall-cluster-details
+func (s *DNSFirewallService) Get(ctx context.Context, accountID, clusterID string) (DNSFirewallCluster, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DNSFirewallCluster{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if clusterID == "" {
+		return DNSFirewallCluster{}, fmt.Errorf(errMissingResourceID, "dns firewall cluster")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/dns_firewall/"+clusterID, nil)
+	if err != nil {
+		return DNSFirewallCluster{}, err
+	}
+
+	var r DNSFirewallClusterResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DNSFirewallCluster{}, fmt.Errorf("failed to unmarshal dns firewall cluster JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create adds a DNS Firewall cluster to an account.
+//
+// API reference: https://api.cloudflare.com/#dns-firewall-create-dns-firewall-cluster
+func (s *DNSFirewallService) Create(ctx context.Context, accountID string, cluster DNSFirewallCluster) (DNSFirewallCluster, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DNSFirewallCluster{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if err := validateDNSFirewallCluster(cluster); err != nil {
+		return DNSFirewallCluster{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/dns_firewall", cluster)
+	if err != nil {
+		return DNSFirewallCluster{}, err
+	}
+
+	var r DNSFirewallClusterResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DNSFirewallCluster{}, fmt.Errorf("failed to unmarshal dns firewall cluster JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes a DNS Firewall cluster.
+//
+// API reference: https://api.cloudflare.com/#dns-firewall-update-dns-firewall-cluster
+func (s *DNSFirewallService) Update(ctx context.Context, accountID, clusterID string, cluster DNSFirewallCluster) (DNSFirewallCluster, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DNSFirewallCluster{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if clusterID == "" {
+		return DNSFirewallCluster{}, fmt.Errorf(errMissingResourceID, "dns firewall cluster")
+	}
+
+	if err := validateDNSFirewallCluster(cluster); err != nil {
+		return DNSFirewallCluster{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/dns_firewall/"+clusterID, cluster)
+	if err != nil {
+		return DNSFirewallCluster{}, err
+	}
+
+	var r DNSFirewallClusterResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DNSFirewallCluster{}, fmt.Errorf("failed to unmarshal dns firewall cluster JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a DNS Firewall cluster.
+//
+// API reference: https://api.cloudflare.com/#dns-firewall-delete-dns-firewall-cluster
+func (s *DNSFirewallService) Delete(ctx context.Context, accountID, clusterID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if clusterID == "" {
+		return fmt.Errorf(errMissingResourceID, "dns firewall cluster")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/dns_firewall/"+clusterID, nil)
+	return err
+}
+
+// Analytics returns a report of the queries answered by a DNS Firewall
+// cluster.
+//
+// API reference: https://api.cloudflare.com/#dns-firewall-analytics-table
+func (s *DNSFirewallService) Analytics(ctx context.Context, accountID, clusterID string, params DNSFirewallAnalyticsParams) (DNSFirewallAnalytics, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return DNSFirewallAnalytics{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if clusterID == "" {
+		return DNSFirewallAnalytics{}, fmt.Errorf(errMissingResourceID, "dns firewall cluster")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/dns_firewall/"+clusterID+"/dns_analytics/report", params), nil)
+	if err != nil {
+		return DNSFirewallAnalytics{}, err
+	}
+
+	var r DNSFirewallAnalyticsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DNSFirewallAnalytics{}, fmt.Errorf("failed to unmarshal dns firewall analytics JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// validateDNSFirewallCluster checks the name, upstream addresses and cache
+// TTLs of a cluster.
+func validateDNSFirewallCluster(cluster DNSFirewallCluster) error {
+	if cluster.Name == "" {
+		return errors.New("name must be provided")
+	}
+
+	if len(cluster.UpstreamIPs) == 0 {
+		return errors.New("at least one upstream IP must be provided")
+	}
+
+	for _, ip := range cluster.UpstreamIPs {
+		if net.ParseIP(ip) == nil {
+			return fmt.Errorf("invalid upstream IP: %q", ip)
+		}
+	}
+
+	if cluster.MaximumCacheTTL != 0 && cluster.MinimumCacheTTL > cluster.MaximumCacheTTL {
+		return errors.New("minimum cache TTL must not exceed the maximum cache TTL")
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type DNSSECService service
+
+// DNSSECStatus is the DNSSEC state of a zone.
+type DNSSECStatus string
+
+const (
+	DNSSECStatusActive          DNSSECStatus = "active"
+	DNSSECStatusPending         DNSSECStatus = "pending"
+	DNSSECStatusDisabled        DNSSECStatus = "disabled"
+	DNSSECStatusPendingDisabled DNSSECStatus = "pending-disabled"
+	DNSSECStatusError           DNSSECStatus = "error"
+)
+
+// DNSSEC represents the DNSSEC configuration of a zone.
+//
+// DS is the DS record to add at the registrar. Registrars asking for its
+// components use KeyTag, Algorithm, DigestType and Digest, or Flags,
+// Algorithm and PublicKey for the DNSKEY record. MultiSigner allows other
+// providers to sign the zone alongside Cloudflare and Presigned serves
+// signatures transferred from the primary of a secondary zone.
+type DNSSEC struct {
+	Status          DNSSECStatus `json:"status"`
+	Flags           int          `json:"flags"`
+	Algorithm       string       `json:"algorithm"`
+	KeyType         string       `json:"key_type"`
+	DigestType      string       `json:"digest_type"`
+	DigestAlgorithm string       `json:"digest_algorithm"`
+	Digest          string       `json:"digest"`
+	DS              string       `json:"ds"`
+	KeyTag          int          `json:"key_tag"`
+	PublicKey       string       `json:"public_key"`
+	MultiSigner     bool         `json:"dnssec_multi_signer"`
+	Presigned       bool         `json:"dnssec_presigned"`
+	ModifiedOn      *time.Time   `json:"modified_on,omitempty"`
+}
+
+// DNSSECUpdateParams contains the DNSSEC settings of a zone to change.
+// Fields left empty are not changed. Status may only be set to active or
+// disabled.
+type DNSSECUpdateParams struct {
+	Status      DNSSECStatus `json:"status,omitempty"`
+	MultiSigner *bool        `json:"dnssec_multi_signer,omitempty"`
+	Presigned   *bool        `json:"dnssec_presigned,omitempty"`
+}
+
+// DNSSECResponse represents the response from the DNSSEC endpoint.
+type DNSSECResponse struct {
+	Response
+	Result DNSSEC `json:"result"`
+}
+
+// Get returns the DNSSEC configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#dnssec-dnssec-details
+func (s *DNSSECService) Get(ctx context.Context, zoneID string) (DNSSEC, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return DNSSEC{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/dnssec", nil)
+	if err != nil {
+		return DNSSEC{}, err
+	}
+
+	var r DNSSECResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DNSSEC{}, fmt.Errorf("failed to unmarshal dnssec JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the DNSSEC configuration of a zone. Enabling DNSSEC
+// returns a pending configuration whose DS record must be added at the
+// registrar before it becomes active.
+//
+// API reference: https://api.cloudflare.com/#dnssec-edit-dnssec-status
+func (s *DNSSECService) Update(ctx context.Context, zoneID string, params DNSSECUpdateParams) (DNSSEC, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return DNSSEC{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	switch params.Status {
+	case "", DNSSECStatusActive, DNSSECStatusDisabled:
+	default:
+		return DNSSEC{}, fmt.Errorf("invalid dnssec status: %q", params.Status)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/dnssec", params)
+	if err != nil {
+		return DNSSEC{}, err
+	}
+
+	var r DNSSECResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return DNSSEC{}, fmt.Errorf("failed to unmarshal dnssec JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes the DNSSEC records of a zone. The DS record must be removed
+// at the registrar first or the zone will fail to resolve.
+//
+// API reference: https://api.cloudflare.com/#dnssec-delete-dnssec-records
+func (s *DNSSECService) Delete(ctx context.Context, zoneID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/dnssec", nil)
+	return err
+}
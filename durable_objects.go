@@ -0,0 +1,146 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type DurableObjectsService service
+
+// DurableObjectNamespace is a namespace of Durable Objects backed by a class
+// exported from a worker script.
+type DurableObjectNamespace struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Script    string `json:"script"`
+	Class     string `json:"class"`
+	UseSQLite bool   `json:"use_sqlite,omitempty"`
+}
+
+// DurableObjectNamespacesResponse represents the response from the Durable
+// Object namespaces endpoint.
+type DurableObjectNamespacesResponse struct {
+	Response
+	Result     []DurableObjectNamespace `json:"result"`
+	ResultInfo ResultInfo               `json:"result_info"`
+}
+
+// DurableObjectNamespaceListParams contains the options available when
+// listing Durable Object namespaces.
+type DurableObjectNamespaceListParams struct {
+	PaginationParams
+}
+
+// DurableObject is a single object within a namespace.
+type DurableObject struct {
+	ID            string `json:"id"`
+	HasStoredData bool   `json:"hasStoredData"`
+}
+
+// DurableObjectsResponse represents the response from the Durable Objects
+// endpoint.
+type DurableObjectsResponse struct {
+	Response
+	Result     []DurableObject `json:"result"`
+	ResultInfo ResultInfo      `json:"result_info"`
+}
+
+// DurableObjectListParams contains the options available when listing the
+// objects of a namespace. Limit must be between 10 and 10,000 when set.
+type DurableObjectListParams struct {
+	Limit int `url:"limit,omitempty"`
+
+	CursorPaginationParams
+}
+
+// ListNamespaces returns the Durable Object namespaces of an account.
+//
+// API reference: https://api.cloudflare.com/#durable-objects-namespace-list-namespaces
+func (s *DurableObjectsService) ListNamespaces(ctx context.Context, accountID string, params DurableObjectNamespaceListParams) ([]DurableObjectNamespace, ResultInfo, error) {
+	var namespaces []DurableObjectNamespace
+	var info ResultInfo
+	err := s.ForEachNamespacesPage(ctx, accountID, params, func(page []DurableObjectNamespace, pageInfo ResultInfo) bool {
+		namespaces = append(namespaces, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []DurableObjectNamespace{}, ResultInfo{}, err
+	}
+
+	return namespaces, info, nil
+}
+
+// ForEachNamespacesPage is like ListNamespaces but passes each page of results
+// to fn as it is fetched instead of collecting them. Paging stops early when
+// fn returns false.
+//
+// API reference: https://api.cloudflare.com/#durable-objects-namespace-list-namespaces
+func (s *DurableObjectsService) ForEachNamespacesPage(ctx context.Context, accountID string, params DurableObjectNamespaceListParams, fn func(items []DurableObjectNamespace, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/workers/durable_objects/namespaces", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r DurableObjectNamespacesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal durable object namespace JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// ListObjects returns the objects within a Durable Object namespace that have
+// stored data.
+//
+// Unless a cursor is provided, every object is fetched by following the
+// cursors returned by the API, Limit objects at a time. Otherwise a single
+// page is
+// fetched and the returned ResultInfo.Cursor can be passed back in
+// `DurableObjectListParams` to retrieve the next page.
+//
+// API reference: https://api.cloudflare.com/#durable-objects-namespace-list-objects
+func (s *DurableObjectsService) ListObjects(ctx context.Context, accountID, namespaceID string, params DurableObjectListParams) ([]DurableObject, ResultInfo, error) {
+	var objects []DurableObject
+	var info ResultInfo
+	err := s.ForEachObjectsPage(ctx, accountID, namespaceID, params, func(page []DurableObject, pageInfo ResultInfo) bool {
+		objects = append(objects, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []DurableObject{}, ResultInfo{}, err
+	}
+
+	return objects, info, nil
+}
+
+// ForEachObjectsPage is like ListObjects but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#durable-objects-namespace-list-objects
+func (s *DurableObjectsService) ForEachObjectsPage(ctx context.Context, accountID, namespaceID string, params DurableObjectListParams, fn func(items []DurableObject, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	return s.client.listCursorPages(ctx, "/accounts/"+accountID+"/workers/durable_objects/namespaces/"+namespaceID+"/objects", "cursor", &params, &params.CursorPaginationParams, func(res []byte) (ResultInfo, error) {
+		var r DurableObjectsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal durable object JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
@@ -0,0 +1,521 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type EmailRoutingService service
+
+// defaultEmailAddressPollInterval is how often
+// WaitForAddressVerification checks an address when no interval is given.
+const defaultEmailAddressPollInterval = 10 * time.Second
+
+// EmailRoutingSettings is the Email Routing configuration of a zone. Status
+// reports whether the zone's DNS records are configured for Email Routing.
+type EmailRoutingSettings struct {
+	ID         string     `json:"id"`
+	Tag        string     `json:"tag"`
+	Name       string     `json:"name"`
+	Enabled    bool       `json:"enabled"`
+	SkipWizard bool       `json:"skip_wizard"`
+	Status     string     `json:"status"`
+	Created    *time.Time `json:"created,omitempty"`
+	Modified   *time.Time `json:"modified,omitempty"`
+}
+
+// EmailRoutingRule applies Actions to the messages matching all of its
+// Matchers. Rules are evaluated in ascending Priority order.
+type EmailRoutingRule struct {
+	Tag      string                    `json:"tag,omitempty"`
+	Name     string                    `json:"name,omitempty"`
+	Priority int                       `json:"priority,omitempty"`
+	Enabled  *bool                     `json:"enabled,omitempty"`
+	Matchers []EmailRoutingRuleMatcher `json:"matchers"`
+	Actions  []EmailRoutingRuleAction  `json:"actions"`
+}
+
+// EmailRoutingRuleMatcher selects the messages a rule applies to. Type is
+// "literal" to match Field, which is always "to", against Value, or "all" to
+// match every message in the catch-all rule.
+type EmailRoutingRuleMatcher struct {
+	Type  string `json:"type"`
+	Field string `json:"field,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// EmailRoutingRuleAction is what happens to a matched message. Type is
+// "forward" with the destination addresses as Value, "worker" with the name
+// of a Worker, or "drop".
+type EmailRoutingRuleAction struct {
+	Type  string   `json:"type"`
+	Value []string `json:"value,omitempty"`
+}
+
+// EmailRoutingAddress is a destination address messages can be forwarded
+// to. Verified is nil until the owner confirms the address.
+type EmailRoutingAddress struct {
+	ID       string     `json:"id,omitempty"`
+	Tag      string     `json:"tag,omitempty"`
+	Email    string     `json:"email"`
+	Verified *time.Time `json:"verified,omitempty"`
+	Created  *time.Time `json:"created,omitempty"`
+	Modified *time.Time `json:"modified,omitempty"`
+}
+
+// EmailRoutingRuleListParams contains the filters available when listing
+// routing rules.
+type EmailRoutingRuleListParams struct {
+	Enabled *bool `url:"enabled,omitempty"`
+
+	PaginationParams
+}
+
+// EmailRoutingAddressListParams contains the filters available when listing
+// destination addresses.
+type EmailRoutingAddressListParams struct {
+	Verified *bool `url:"verified,omitempty"`
+
+	PaginationParams
+}
+
+// EmailRoutingSettingsResponse represents the response from the Email
+// Routing settings endpoints.
+type EmailRoutingSettingsResponse struct {
+	Response
+	Result EmailRoutingSettings `json:"result"`
+}
+
+// EmailRoutingRuleResponse represents the response from the routing rules
+// endpoint containing a single rule.
+type EmailRoutingRuleResponse struct {
+	Response
+	Result EmailRoutingRule `json:"result"`
+}
+
+// EmailRoutingRulesResponse represents the response from the routing rules
+// endpoint containing multiple rules.
+type EmailRoutingRulesResponse struct {
+	Response
+	Result     []EmailRoutingRule `json:"result"`
+	ResultInfo ResultInfo         `json:"result_info"`
+}
+
+// EmailRoutingAddressResponse represents the response from the destination
+// addresses endpoint containing a single address.
+type EmailRoutingAddressResponse struct {
+	Response
+	Result EmailRoutingAddress `json:"result"`
+}
+
+// EmailRoutingAddressesResponse represents the response from the
+// destination addresses endpoint containing multiple addresses.
+type EmailRoutingAddressesResponse struct {
+	Response
+	Result     []EmailRoutingAddress `json:"result"`
+	ResultInfo ResultInfo            `json:"result_info"`
+}
+
+// GetSettings returns the Email Routing configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#email-routing-settings-get-email-routing-settings
+func (s *EmailRoutingService) GetSettings(ctx context.Context, zoneID string) (EmailRoutingSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/email/routing", nil)
+	if err != nil {
+		return EmailRoutingSettings{}, err
+	}
+
+	var r EmailRoutingSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingSettings{}, fmt.Errorf("failed to unmarshal email routing settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Enable turns on Email Routing for a zone, adding the MX and SPF records it
+// needs.
+//
+// API reference: https://api.cloudflare.com/#email-routing-settings-enable-email-routing
+func (s *EmailRoutingService) Enable(ctx context.Context, zoneID string) (EmailRoutingSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/email/routing/enable", struct{}{})
+	if err != nil {
+		return EmailRoutingSettings{}, err
+	}
+
+	var r EmailRoutingSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingSettings{}, fmt.Errorf("failed to unmarshal email routing settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Disable turns off Email Routing for a zone. Its DNS records are left in
+// place, so mail is rejected until they are removed.
+//
+// API reference: https://api.cloudflare.com/#email-routing-settings-disable-email-routing
+func (s *EmailRoutingService) Disable(ctx context.Context, zoneID string) (EmailRoutingSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/email/routing/disable", struct{}{})
+	if err != nil {
+		return EmailRoutingSettings{}, err
+	}
+
+	var r EmailRoutingSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingSettings{}, fmt.Errorf("failed to unmarshal email routing settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListRules returns the routing rules of a zone, excluding the catch-all
+// rule.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-list-routing-rules
+func (s *EmailRoutingService) ListRules(ctx context.Context, zoneID string, params EmailRoutingRuleListParams) ([]EmailRoutingRule, ResultInfo, error) {
+	var rules []EmailRoutingRule
+	var info ResultInfo
+	err := s.ForEachRulesPage(ctx, zoneID, params, func(page []EmailRoutingRule, pageInfo ResultInfo) bool {
+		rules = append(rules, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []EmailRoutingRule{}, ResultInfo{}, err
+	}
+
+	return rules, info, nil
+}
+
+// ForEachRulesPage is like ListRules but passes each page of results to fn as
+// it is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-list-routing-rules
+func (s *EmailRoutingService) ForEachRulesPage(ctx context.Context, zoneID string, params EmailRoutingRuleListParams, fn func(items []EmailRoutingRule, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/email/routing/rules", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r EmailRoutingRulesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal email routing rule JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetRule fetches a single routing rule.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-get-routing-rule
+func (s *EmailRoutingService) GetRule(ctx context.Context, zoneID, ruleTag string) (EmailRoutingRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if ruleTag == "" {
+		return EmailRoutingRule{}, fmt.Errorf(errMissingResourceID, "email routing rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/email/routing/rules/"+ruleTag, nil)
+	if err != nil {
+		return EmailRoutingRule{}, err
+	}
+
+	var r EmailRoutingRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingRule{}, fmt.Errorf("failed to unmarshal email routing rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateRule creates a routing rule. Addresses forwarded to must already be
+// verified destination addresses of the account.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-create-routing-rule
+func (s *EmailRoutingService) CreateRule(ctx context.Context, zoneID string, rule EmailRoutingRule) (EmailRoutingRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(rule.Matchers) == 0 || len(rule.Actions) == 0 {
+		return EmailRoutingRule{}, errors.New("at least one matcher and action must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/email/routing/rules", rule)
+	if err != nil {
+		return EmailRoutingRule{}, err
+	}
+
+	var r EmailRoutingRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingRule{}, fmt.Errorf("failed to unmarshal email routing rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateRule replaces a routing rule.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-update-routing-rule
+func (s *EmailRoutingService) UpdateRule(ctx context.Context, zoneID, ruleTag string, rule EmailRoutingRule) (EmailRoutingRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if ruleTag == "" {
+		return EmailRoutingRule{}, fmt.Errorf(errMissingResourceID, "email routing rule")
+	}
+
+	if len(rule.Matchers) == 0 || len(rule.Actions) == 0 {
+		return EmailRoutingRule{}, errors.New("at least one matcher and action must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/email/routing/rules/"+ruleTag, rule)
+	if err != nil {
+		return EmailRoutingRule{}, err
+	}
+
+	var r EmailRoutingRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingRule{}, fmt.Errorf("failed to unmarshal email routing rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteRule removes a routing rule.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-delete-routing-rule
+func (s *EmailRoutingService) DeleteRule(ctx context.Context, zoneID, ruleTag string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if ruleTag == "" {
+		return fmt.Errorf(errMissingResourceID, "email routing rule")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/email/routing/rules/"+ruleTag, nil)
+	return err
+}
+
+// GetCatchAllRule returns the rule applied to messages no other rule
+// matches.
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-get-catch-all-rule
+func (s *EmailRoutingService) GetCatchAllRule(ctx context.Context, zoneID string) (EmailRoutingRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/email/routing/rules/catch_all", nil)
+	if err != nil {
+		return EmailRoutingRule{}, err
+	}
+
+	var r EmailRoutingRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingRule{}, fmt.Errorf("failed to unmarshal email routing rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateCatchAllRule replaces the catch-all rule. Its matcher is always of
+// type "all" and is added when omitted; its action must be "forward",
+// "worker" or "drop".
+//
+// API reference: https://api.cloudflare.com/#email-routing-routing-rules-update-catch-all-rule
+func (s *EmailRoutingService) UpdateCatchAllRule(ctx context.Context, zoneID string, rule EmailRoutingRule) (EmailRoutingRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return EmailRoutingRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(rule.Actions) == 0 {
+		return EmailRoutingRule{}, errors.New("at least one action must be provided")
+	}
+
+	if len(rule.Matchers) == 0 {
+		rule.Matchers = []EmailRoutingRuleMatcher{{Type: "all"}}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/email/routing/rules/catch_all", rule)
+	if err != nil {
+		return EmailRoutingRule{}, err
+	}
+
+	var r EmailRoutingRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingRule{}, fmt.Errorf("failed to unmarshal email routing rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListAddresses returns the destination addresses of an account.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-list-destination-addresses
+func (s *EmailRoutingService) ListAddresses(ctx context.Context, accountID string, params EmailRoutingAddressListParams) ([]EmailRoutingAddress, ResultInfo, error) {
+	var addresses []EmailRoutingAddress
+	var info ResultInfo
+	err := s.ForEachAddressesPage(ctx, accountID, params, func(page []EmailRoutingAddress, pageInfo ResultInfo) bool {
+		addresses = append(addresses, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []EmailRoutingAddress{}, ResultInfo{}, err
+	}
+
+	return addresses, info, nil
+}
+
+// ForEachAddressesPage is like ListAddresses but passes each page of results
+// to fn as it is fetched instead of collecting them. Paging stops early when
+// fn returns false.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-list-destination-addresses
+func (s *EmailRoutingService) ForEachAddressesPage(ctx context.Context, accountID string, params EmailRoutingAddressListParams, fn func(items []EmailRoutingAddress, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/email/routing/addresses", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r EmailRoutingAddressesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal email routing address JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetAddress fetches a single destination address.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-get-a-destination-address
+func (s *EmailRoutingService) GetAddress(ctx context.Context, accountID, addressID string) (EmailRoutingAddress, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return EmailRoutingAddress{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressID == "" {
+		return EmailRoutingAddress{}, fmt.Errorf(errMissingResourceID, "email routing address")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/email/routing/addresses/"+addressID, nil)
+	if err != nil {
+		return EmailRoutingAddress{}, err
+	}
+
+	var r EmailRoutingAddressResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingAddress{}, fmt.Errorf("failed to unmarshal email routing address JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateAddress adds a destination address to an account and sends it a
+// verification email. The address cannot be forwarded to until the link in
+// the email is followed; WaitForAddressVerification blocks until then.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-create-a-destination-address
+func (s *EmailRoutingService) CreateAddress(ctx context.Context, accountID, email string) (EmailRoutingAddress, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return EmailRoutingAddress{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if email == "" {
+		return EmailRoutingAddress{}, errors.New("email must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/email/routing/addresses", EmailRoutingAddress{Email: email})
+	if err != nil {
+		return EmailRoutingAddress{}, err
+	}
+
+	var r EmailRoutingAddressResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return EmailRoutingAddress{}, fmt.Errorf("failed to unmarshal email routing address JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteAddress removes a destination address. Rules forwarding to it stop
+// delivering to it.
+//
+// API reference: https://api.cloudflare.com/#email-routing-destination-addresses-delete-destination-address
+func (s *EmailRoutingService) DeleteAddress(ctx context.Context, accountID, addressID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if addressID == "" {
+		return fmt.Errorf(errMissingResourceID, "email routing address")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/email/routing/addresses/"+addressID, nil)
+	return err
+}
+
+// WaitForAddressVerification polls a destination address every interval, or
+// ten seconds if interval is zero, until its owner verifies it and returns
+// the verified address. An error is returned if ctx is done first.
+func (s *EmailRoutingService) WaitForAddressVerification(ctx context.Context, accountID, addressID string, interval time.Duration) (EmailRoutingAddress, error) {
+	if interval <= 0 {
+		interval = defaultEmailAddressPollInterval
+	}
+
+	for {
+		address, err := s.GetAddress(ctx, accountID, addressID)
+		if err != nil {
+			return EmailRoutingAddress{}, err
+		}
+
+		if address.Verified != nil {
+			return address, nil
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return EmailRoutingAddress{}, ctx.Err()
+		}
+	}
+}
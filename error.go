@@ -29,7 +29,9 @@ const (
 	errResultInfo                = "incorrect pagination info (result_info) in responses"
 	errManualPagination          = "unexpected pagination options passed to functions that handle pagination automatically"
 
-	errInvalidZoneIdentifer = "invalid zone identifier: %s"
+	errInvalidZoneIdentifer     = "invalid zone identifier: %s"
+	errInvalidAccountIdentifier = "invalid account identifier: %s"
+	errMissingResourceID        = "%s ID is empty and must be provided"
 )
 
 // APIRequestError is a type of error raised by API calls made by this library.
@@ -0,0 +1,250 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type FiltersService service
+
+// Filter holds the structure of the filter type.
+type Filter struct {
+	ID          string `json:"id,omitempty"`
+	Expression  string `json:"expression"`
+	Paused      bool   `json:"paused"`
+	Description string `json:"description"`
+
+	// Property is mentioned in documentation however isn't populated in
+	// any of the API requests. For now, let's just omit it unless it's
+	// provided.
+	Ref string `json:"ref,omitempty"`
+}
+
+// FilterResponse is the response for a single filter.
+type FilterResponse struct {
+	Response
+	Result Filter `json:"result"`
+}
+
+// FiltersResponse is the response for multiple filters.
+type FiltersResponse struct {
+	Response
+	Result     []Filter   `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// FilterListParams contains the filters available when listing filters.
+type FilterListParams struct {
+	ID          string `url:"id,omitempty"`
+	Expression  string `url:"expression,omitempty"`
+	Description string `url:"description,omitempty"`
+	Ref         string `url:"ref,omitempty"`
+	Paused      *bool  `url:"paused,omitempty"`
+
+	PaginationParams
+}
+
+// filterValidateExpression is the payload of the expression validation
+// endpoint.
+type filterValidateExpression struct {
+	Expression string `json:"expression"`
+}
+
+// List returns all filters for a zone that match the provided `FilterListParams`.
+//
+// API reference: https://api.cloudflare.com/#filters-list-filters
+func (s *FiltersService) List(ctx context.Context, zoneID string, params FilterListParams) ([]Filter, ResultInfo, error) {
+	var filters []Filter
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []Filter, pageInfo ResultInfo) bool {
+		filters = append(filters, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []Filter{}, ResultInfo{}, err
+	}
+
+	return filters, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#filters-list-filters
+func (s *FiltersService) ForEachPage(ctx context.Context, zoneID string, params FilterListParams, fn func(items []Filter, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/filters", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r FiltersResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal filter JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single filter.
+//
+// API reference: https://api.cloudflare.com/#filters-get-by-filter-id
+func (s *FiltersService) Get(ctx context.Context, zoneID, filterID string) (Filter, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Filter{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if filterID == "" {
+		return Filter{}, fmt.Errorf(errMissingResourceID, "filter")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/filters/"+filterID, nil)
+	if err != nil {
+		return Filter{}, err
+	}
+
+	var r FilterResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Filter{}, fmt.Errorf("failed to unmarshal filter JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates new filters in bulk. The API does not support creating a
+// single filter on its own; pass a slice with one element instead.
+//
+// API reference: https://api.cloudflare.com/#filters-create-filters
+func (s *FiltersService) Create(ctx context.Context, zoneID string, filters []Filter) ([]Filter, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []Filter{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(filters) == 0 {
+		return []Filter{}, errors.New("at least one filter is required")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/filters", filters)
+	if err != nil {
+		return []Filter{}, err
+	}
+
+	var r FiltersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Filter{}, fmt.Errorf("failed to unmarshal filter JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update modifies a single filter.
+//
+// API reference: https://api.cloudflare.com/#filters-update-filter
+func (s *FiltersService) Update(ctx context.Context, zoneID, filterID string, filter Filter) (Filter, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Filter{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if filterID == "" {
+		return Filter{}, fmt.Errorf(errMissingResourceID, "filter")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/filters/"+filterID, filter)
+	if err != nil {
+		return Filter{}, err
+	}
+
+	var r FilterResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Filter{}, fmt.Errorf("failed to unmarshal filter JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// BulkUpdate modifies multiple filters at once. Each filter must have its ID
+// set.
+//
+// API reference: https://api.cloudflare.com/#filters-update-filters
+func (s *FiltersService) BulkUpdate(ctx context.Context, zoneID string, filters []Filter) ([]Filter, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []Filter{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	for _, filter := range filters {
+		if filter.ID == "" {
+			return []Filter{}, fmt.Errorf(errMissingResourceID, "filter")
+		}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/filters", filters)
+	if err != nil {
+		return []Filter{}, err
+	}
+
+	var r FiltersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Filter{}, fmt.Errorf("failed to unmarshal filter JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a single filter.
+//
+// API reference: https://api.cloudflare.com/#filters-delete-filter
+func (s *FiltersService) Delete(ctx context.Context, zoneID, filterID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if filterID == "" {
+		return fmt.Errorf(errMissingResourceID, "filter")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/filters/"+filterID, nil)
+	return err
+}
+
+// BulkDelete removes multiple filters at once.
+//
+// API reference: https://api.cloudflare.com/#filters-delete-filters
+func (s *FiltersService) BulkDelete(ctx context.Context, zoneID string, filterIDs []string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(filterIDs) == 0 {
+		return errors.New("at least one filter ID is required")
+	}
+
+	v := url.Values{}
+	for _, id := range filterIDs {
+		v.Add("id", id)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/filters?"+v.Encode(), nil)
+	return err
+}
+
+// ValidateExpression checks whether expression is valid filter syntax. A nil
+// error means the expression is valid; otherwise the error describes the
+// problem reported by the API.
+//
+// API reference: https://api.cloudflare.com/#filters-validate-a-filter-expression
+func (s *FiltersService) ValidateExpression(ctx context.Context, expression string) error {
+	_, err := s.client.Call(ctx, http.MethodPost, "/filters/validate-expr", filterValidateExpression{Expression: expression})
+	return err
+}
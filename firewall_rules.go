@@ -0,0 +1,238 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type FirewallRulesService service
+
+// FirewallRule is the struct of the firewall rule.
+type FirewallRule struct {
+	ID          string      `json:"id,omitempty"`
+	Paused      bool        `json:"paused"`
+	Description string      `json:"description"`
+	Action      string      `json:"action"`
+	Priority    interface{} `json:"priority"`
+	Filter      Filter      `json:"filter"`
+	Products    []string    `json:"products,omitempty"`
+	Ref         string      `json:"ref,omitempty"`
+	CreatedOn   time.Time   `json:"created_on,omitempty"`
+	ModifiedOn  time.Time   `json:"modified_on,omitempty"`
+}
+
+// FirewallRuleResponse contains a single FirewallRule.
+type FirewallRuleResponse struct {
+	Response
+	Result FirewallRule `json:"result"`
+}
+
+// FirewallRulesResponse contains multiple FirewallRule.
+type FirewallRulesResponse struct {
+	Response
+	Result     []FirewallRule `json:"result"`
+	ResultInfo ResultInfo     `json:"result_info"`
+}
+
+// FirewallRuleListParams contains the filters available when listing firewall
+// rules.
+type FirewallRuleListParams struct {
+	ID          string `url:"id,omitempty"`
+	Action      string `url:"action,omitempty"`
+	Description string `url:"description,omitempty"`
+	Paused      *bool  `url:"paused,omitempty"`
+
+	PaginationParams
+}
+
+// List returns all firewall rules for a zone that match the provided
+// `FirewallRuleListParams`.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-list-firewall-rules
+func (s *FirewallRulesService) List(ctx context.Context, zoneID string, params FirewallRuleListParams) ([]FirewallRule, ResultInfo, error) {
+	var rules []FirewallRule
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []FirewallRule, pageInfo ResultInfo) bool {
+		rules = append(rules, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []FirewallRule{}, ResultInfo{}, err
+	}
+
+	return rules, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-list-firewall-rules
+func (s *FirewallRulesService) ForEachPage(ctx context.Context, zoneID string, params FirewallRuleListParams, fn func(items []FirewallRule, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/firewall/rules", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r FirewallRulesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal firewall rule JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single firewall rule.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-get-firewall-rule
+func (s *FirewallRulesService) Get(ctx context.Context, zoneID, firewallRuleID string) (FirewallRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return FirewallRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if firewallRuleID == "" {
+		return FirewallRule{}, fmt.Errorf(errMissingResourceID, "firewall rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/firewall/rules/"+firewallRuleID, nil)
+	if err != nil {
+		return FirewallRule{}, err
+	}
+
+	var r FirewallRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("failed to unmarshal firewall rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates new firewall rules in bulk. Rules may either reference an
+// existing filter by ID or define a new filter inline.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-create-firewall-rules
+func (s *FirewallRulesService) Create(ctx context.Context, zoneID string, rules []FirewallRule) ([]FirewallRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []FirewallRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(rules) == 0 {
+		return []FirewallRule{}, errors.New("at least one firewall rule is required")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/firewall/rules", rules)
+	if err != nil {
+		return []FirewallRule{}, err
+	}
+
+	var r FirewallRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []FirewallRule{}, fmt.Errorf("failed to unmarshal firewall rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update modifies a single firewall rule.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-update-a-firewall-rule
+func (s *FirewallRulesService) Update(ctx context.Context, zoneID, firewallRuleID string, rule FirewallRule) (FirewallRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return FirewallRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if firewallRuleID == "" {
+		return FirewallRule{}, fmt.Errorf(errMissingResourceID, "firewall rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/firewall/rules/"+firewallRuleID, rule)
+	if err != nil {
+		return FirewallRule{}, err
+	}
+
+	var r FirewallRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return FirewallRule{}, fmt.Errorf("failed to unmarshal firewall rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// BulkUpdate modifies multiple firewall rules at once. Each rule must have its
+// ID set.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-update-firewall-rules
+func (s *FirewallRulesService) BulkUpdate(ctx context.Context, zoneID string, rules []FirewallRule) ([]FirewallRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []FirewallRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	for _, rule := range rules {
+		if rule.ID == "" {
+			return []FirewallRule{}, fmt.Errorf(errMissingResourceID, "firewall rule")
+		}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/firewall/rules", rules)
+	if err != nil {
+		return []FirewallRule{}, err
+	}
+
+	var r FirewallRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []FirewallRule{}, fmt.Errorf("failed to unmarshal firewall rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a single firewall rule. The filter it references is left in
+// place.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-delete-a-firewall-rule
+func (s *FirewallRulesService) Delete(ctx context.Context, zoneID, firewallRuleID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if firewallRuleID == "" {
+		return fmt.Errorf(errMissingResourceID, "firewall rule")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/firewall/rules/"+firewallRuleID, nil)
+	return err
+}
+
+// BulkDelete removes multiple firewall rules at once.
+//
+// API reference: https://api.cloudflare.com/#firewall-rules-delete-firewall-rules
+func (s *FirewallRulesService) BulkDelete(ctx context.Context, zoneID string, firewallRuleIDs []string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(firewallRuleIDs) == 0 {
+		return errors.New("at least one firewall rule ID is required")
+	}
+
+	v := url.Values{}
+	for _, id := range firewallRuleIDs {
+		v.Add("id", id)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/firewall/rules?"+v.Encode(), nil)
+	return err
+}
@@ -0,0 +1,342 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// GatewayListType is the kind of values a Gateway list holds.
+type GatewayListType string
+
+const (
+	GatewayListTypeSerial GatewayListType = "SERIAL"
+	GatewayListTypeURL    GatewayListType = "URL"
+	GatewayListTypeDomain GatewayListType = "DOMAIN"
+	GatewayListTypeEmail  GatewayListType = "EMAIL"
+	GatewayListTypeIP     GatewayListType = "IP"
+)
+
+// GatewayList is a named list of values that Gateway rules reference with
+// GatewayInList.
+type GatewayList struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Type        GatewayListType   `json:"type"`
+	Count       int               `json:"count,omitempty"`
+	Items       []GatewayListItem `json:"items,omitempty"`
+	CreatedAt   *time.Time        `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time        `json:"updated_at,omitempty"`
+}
+
+// GatewayListItem is a single value of a Gateway list.
+type GatewayListItem struct {
+	Value       string     `json:"value"`
+	Description string     `json:"description,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+// GatewayListResponse represents the response from the Gateway lists
+// endpoint containing a single list.
+type GatewayListResponse struct {
+	Response
+	Result GatewayList `json:"result"`
+}
+
+// GatewayListsResponse represents the response from the Gateway lists
+// endpoint containing multiple lists.
+type GatewayListsResponse struct {
+	Response
+	Result     []GatewayList `json:"result"`
+	ResultInfo ResultInfo    `json:"result_info"`
+}
+
+// GatewayListItemsResponse represents the response from the Gateway list
+// items endpoint.
+type GatewayListItemsResponse struct {
+	Response
+	Result     []GatewayListItem `json:"result"`
+	ResultInfo ResultInfo        `json:"result_info"`
+}
+
+// GatewayListListParams contains the filters available when listing Gateway
+// lists.
+type GatewayListListParams struct {
+	Type GatewayListType `url:"type,omitempty"`
+
+	PaginationParams
+}
+
+// GatewayListItemListParams contains the options available when listing the
+// items of a Gateway list.
+type GatewayListItemListParams struct {
+	PaginationParams
+}
+
+// GatewayListUpdateParams contains the fields that can be changed on a
+// Gateway list. Items are changed with PatchListItems.
+type GatewayListUpdateParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// gatewayListPatchParams is the request body used to add and remove list
+// items.
+type gatewayListPatchParams struct {
+	Append []GatewayListItem `json:"append"`
+	Remove []string          `json:"remove"`
+}
+
+// ParseGatewayListItemsCSV reads list items from CSV with the value in the
+// first column and an optional description in the second. A leading header
+// row whose first column is "value" is skipped.
+func ParseGatewayListItemsCSV(r io.Reader) ([]GatewayListItem, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read gateway list CSV: %w", err)
+	}
+
+	items := make([]GatewayListItem, 0, len(records))
+	for i, record := range records {
+		value := strings.TrimSpace(record[0])
+		if i == 0 && strings.EqualFold(value, "value") {
+			continue
+		}
+		if value == "" {
+			continue
+		}
+
+		item := GatewayListItem{Value: value}
+		if len(record) > 1 {
+			item.Description = strings.TrimSpace(record[1])
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// ListLists returns the Gateway lists of an account. Every page is fetched
+// unless params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-list-zero-trust-lists
+func (s *GatewayService) ListLists(ctx context.Context, accountID string, params GatewayListListParams) ([]GatewayList, ResultInfo, error) {
+	var lists []GatewayList
+	var info ResultInfo
+	err := s.ForEachListsPage(ctx, accountID, params, func(page []GatewayList, pageInfo ResultInfo) bool {
+		lists = append(lists, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []GatewayList{}, ResultInfo{}, err
+	}
+
+	return lists, info, nil
+}
+
+// ForEachListsPage is like ListLists but passes each page of results to fn as
+// it is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-list-zero-trust-lists
+func (s *GatewayService) ForEachListsPage(ctx context.Context, accountID string, params GatewayListListParams, fn func(items []GatewayList, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/gateway/lists", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r GatewayListsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal gateway list JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetList fetches a single Gateway list without its items.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-zero-trust-list-details
+func (s *GatewayService) GetList(ctx context.Context, accountID, listID string) (GatewayList, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayList{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return GatewayList{}, fmt.Errorf(errMissingResourceID, "gateway list")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/gateway/lists/"+listID, nil)
+	if err != nil {
+		return GatewayList{}, err
+	}
+
+	var r GatewayListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayList{}, fmt.Errorf("failed to unmarshal gateway list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListItems returns the items of a Gateway list.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-zero-trust-list-items
+func (s *GatewayService) ListItems(ctx context.Context, accountID, listID string, params GatewayListItemListParams) ([]GatewayListItem, ResultInfo, error) {
+	var items []GatewayListItem
+	var info ResultInfo
+	err := s.ForEachItemsPage(ctx, accountID, listID, params, func(page []GatewayListItem, pageInfo ResultInfo) bool {
+		items = append(items, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []GatewayListItem{}, ResultInfo{}, err
+	}
+
+	return items, info, nil
+}
+
+// ForEachItemsPage is like ListItems but passes each page of results to fn as
+// it is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-zero-trust-list-items
+func (s *GatewayService) ForEachItemsPage(ctx context.Context, accountID, listID string, params GatewayListItemListParams, fn func(items []GatewayListItem, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return fmt.Errorf(errMissingResourceID, "gateway list")
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/gateway/lists/"+listID+"/items", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r GatewayListItemsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal gateway list item JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// CreateList creates a new Gateway list along with its initial items.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-create-zero-trust-list
+func (s *GatewayService) CreateList(ctx context.Context, accountID string, list GatewayList) (GatewayList, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayList{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if list.Name == "" || list.Type == "" {
+		return GatewayList{}, errors.New("name and type are required to create a gateway list")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/gateway/lists", list)
+	if err != nil {
+		return GatewayList{}, err
+	}
+
+	var r GatewayListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayList{}, fmt.Errorf("failed to unmarshal gateway list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateList renames a Gateway list or changes its description.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-update-zero-trust-list
+func (s *GatewayService) UpdateList(ctx context.Context, accountID, listID string, params GatewayListUpdateParams) (GatewayList, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayList{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return GatewayList{}, fmt.Errorf(errMissingResourceID, "gateway list")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/gateway/lists/"+listID, params)
+	if err != nil {
+		return GatewayList{}, err
+	}
+
+	var r GatewayListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayList{}, fmt.Errorf("failed to unmarshal gateway list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// PatchListItems adds and removes items of a Gateway list in a single
+// request, leaving other items untouched. Items are removed by value.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-patch-zero-trust-list
+func (s *GatewayService) PatchListItems(ctx context.Context, accountID, listID string, add []GatewayListItem, remove []string) (GatewayList, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayList{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return GatewayList{}, fmt.Errorf(errMissingResourceID, "gateway list")
+	}
+
+	if add == nil {
+		add = []GatewayListItem{}
+	}
+	if remove == nil {
+		remove = []string{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/gateway/lists/"+listID, gatewayListPatchParams{Append: add, Remove: remove})
+	if err != nil {
+		return GatewayList{}, err
+	}
+
+	var r GatewayListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayList{}, fmt.Errorf("failed to unmarshal gateway list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteList removes a Gateway list. Lists referenced by rules cannot be
+// deleted.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-lists-delete-zero-trust-list
+func (s *GatewayService) DeleteList(ctx context.Context, accountID, listID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return fmt.Errorf(errMissingResourceID, "gateway list")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/gateway/lists/"+listID, nil)
+	return err
+}
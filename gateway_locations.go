@@ -0,0 +1,174 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GatewayLocation is a network whose DNS queries are filtered by Gateway.
+type GatewayLocation struct {
+	ID              string                    `json:"id,omitempty"`
+	Name            string                    `json:"name"`
+	Networks        []GatewayLocationNetwork  `json:"networks"`
+	ClientDefault   bool                      `json:"client_default"`
+	ECSSupport      *bool                     `json:"ecs_support,omitempty"`
+	Endpoints       *GatewayLocationEndpoints `json:"endpoints,omitempty"`
+	DOHSubdomain    string                    `json:"doh_subdomain,omitempty"`
+	IP              string                    `json:"ip,omitempty"`
+	IPv4Destination string                    `json:"ipv4_destination,omitempty"`
+	CreatedAt       *time.Time                `json:"created_at,omitempty"`
+	UpdatedAt       *time.Time                `json:"updated_at,omitempty"`
+}
+
+// GatewayLocationNetwork is a source network, in CIDR notation, belonging to
+// a location.
+type GatewayLocationNetwork struct {
+	Network string `json:"network"`
+}
+
+// GatewayLocationEndpoints controls which DNS endpoints a location accepts
+// queries on and from which networks.
+type GatewayLocationEndpoints struct {
+	IPv4 GatewayLocationEndpoint `json:"ipv4"`
+	IPv6 GatewayLocationEndpoint `json:"ipv6"`
+	DOH  GatewayLocationEndpoint `json:"doh"`
+	DOT  GatewayLocationEndpoint `json:"dot"`
+}
+
+// GatewayLocationEndpoint is a single DNS endpoint of a location.
+// RequireToken only applies to DNS over HTTPS.
+type GatewayLocationEndpoint struct {
+	Enabled      bool                     `json:"enabled"`
+	Networks     []GatewayLocationNetwork `json:"networks,omitempty"`
+	RequireToken bool                     `json:"require_token,omitempty"`
+}
+
+// GatewayLocationResponse represents the response from the Gateway locations
+// endpoint containing a single location.
+type GatewayLocationResponse struct {
+	Response
+	Result GatewayLocation `json:"result"`
+}
+
+// GatewayLocationsResponse represents the response from the Gateway
+// locations endpoint containing multiple locations.
+type GatewayLocationsResponse struct {
+	Response
+	Result []GatewayLocation `json:"result"`
+}
+
+// ListLocations returns the Gateway DNS locations of an account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-locations-list-zero-trust-gateway-locations
+func (s *GatewayService) ListLocations(ctx context.Context, accountID string) ([]GatewayLocation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []GatewayLocation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/gateway/locations", nil)
+	if err != nil {
+		return []GatewayLocation{}, err
+	}
+
+	var r GatewayLocationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []GatewayLocation{}, fmt.Errorf("failed to unmarshal gateway location JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetLocation fetches a single Gateway location.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-locations-zero-trust-gateway-location-details
+func (s *GatewayService) GetLocation(ctx context.Context, accountID, locationID string) (GatewayLocation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayLocation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if locationID == "" {
+		return GatewayLocation{}, fmt.Errorf(errMissingResourceID, "gateway location")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/gateway/locations/"+locationID, nil)
+	if err != nil {
+		return GatewayLocation{}, err
+	}
+
+	var r GatewayLocationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayLocation{}, fmt.Errorf("failed to unmarshal gateway location JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateLocation creates a new Gateway location. The assigned IPv6 address
+// and DNS over HTTPS subdomain are returned on the location.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-locations-create-zero-trust-gateway-location
+func (s *GatewayService) CreateLocation(ctx context.Context, accountID string, location GatewayLocation) (GatewayLocation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayLocation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/gateway/locations", location)
+	if err != nil {
+		return GatewayLocation{}, err
+	}
+
+	var r GatewayLocationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayLocation{}, fmt.Errorf("failed to unmarshal gateway location JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateLocation replaces the configuration of a Gateway location.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-locations-update-zero-trust-gateway-location
+func (s *GatewayService) UpdateLocation(ctx context.Context, accountID, locationID string, location GatewayLocation) (GatewayLocation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayLocation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if locationID == "" {
+		return GatewayLocation{}, fmt.Errorf(errMissingResourceID, "gateway location")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/gateway/locations/"+locationID, location)
+	if err != nil {
+		return GatewayLocation{}, err
+	}
+
+	var r GatewayLocationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayLocation{}, fmt.Errorf("failed to unmarshal gateway location JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteLocation removes a Gateway location.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-locations-delete-zero-trust-gateway-location
+func (s *GatewayService) DeleteLocation(ctx context.Context, accountID, locationID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if locationID == "" {
+		return fmt.Errorf(errMissingResourceID, "gateway location")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/gateway/locations/"+locationID, nil)
+	return err
+}
@@ -0,0 +1,324 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type GatewayService service
+
+// GatewayRuleAction is the action taken on traffic matching a Gateway rule.
+type GatewayRuleAction string
+
+const (
+	GatewayRuleActionAllow        GatewayRuleAction = "allow"
+	GatewayRuleActionBlock        GatewayRuleAction = "block"
+	GatewayRuleActionSafeSearch   GatewayRuleAction = "safesearch"
+	GatewayRuleActionYTRestricted GatewayRuleAction = "ytrestricted"
+	GatewayRuleActionOn           GatewayRuleAction = "on"
+	GatewayRuleActionOff          GatewayRuleAction = "off"
+	GatewayRuleActionScan         GatewayRuleAction = "scan"
+	GatewayRuleActionNoScan       GatewayRuleAction = "noscan"
+	GatewayRuleActionIsolate      GatewayRuleAction = "isolate"
+	GatewayRuleActionNoIsolate    GatewayRuleAction = "noisolate"
+	GatewayRuleActionOverride     GatewayRuleAction = "override"
+	GatewayRuleActionL4Override   GatewayRuleAction = "l4_override"
+	GatewayRuleActionEgress       GatewayRuleAction = "egress"
+	GatewayRuleActionAuditSSH     GatewayRuleAction = "audit_ssh"
+	GatewayRuleActionResolve      GatewayRuleAction = "resolve"
+)
+
+// GatewayFilter is the kind of traffic a Gateway rule inspects.
+type GatewayFilter string
+
+const (
+	GatewayFilterDNS     GatewayFilter = "dns"
+	GatewayFilterHTTP    GatewayFilter = "http"
+	GatewayFilterNetwork GatewayFilter = "l4"
+	GatewayFilterEgress  GatewayFilter = "egress"
+)
+
+// GatewayExpression is a wirefilter expression matching traffic, identity
+// or device posture attributes, such as `dns.fqdn == "example.com"`.
+type GatewayExpression string
+
+// GatewayAnd returns an expression matching when every expression matches.
+// Empty expressions are ignored.
+func GatewayAnd(exprs ...GatewayExpression) GatewayExpression {
+	return joinGatewayExpressions(" and ", exprs)
+}
+
+// GatewayOr returns an expression matching when any expression matches.
+// Empty expressions are ignored.
+func GatewayOr(exprs ...GatewayExpression) GatewayExpression {
+	return joinGatewayExpressions(" or ", exprs)
+}
+
+// GatewayIn returns an expression matching when field equals one of values,
+// for example GatewayIn("identity.email", "a@example.com").
+func GatewayIn(field string, values ...string) GatewayExpression {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, strconv.Quote(v))
+	}
+	return GatewayExpression(field + " in {" + strings.Join(quoted, " ") + "}")
+}
+
+// GatewayInList returns an expression matching when field is one of the
+// items of a Gateway list.
+func GatewayInList(field, listID string) GatewayExpression {
+	return GatewayExpression(field + " in $" + listID)
+}
+
+func joinGatewayExpressions(sep string, exprs []GatewayExpression) GatewayExpression {
+	var nonEmpty []GatewayExpression
+	for _, e := range exprs {
+		if e != "" {
+			nonEmpty = append(nonEmpty, e)
+		}
+	}
+	if len(nonEmpty) == 1 {
+		return nonEmpty[0]
+	}
+
+	parts := make([]string, 0, len(nonEmpty))
+	for _, e := range nonEmpty {
+		parts = append(parts, "("+string(e)+")")
+	}
+	return GatewayExpression(strings.Join(parts, sep))
+}
+
+// GatewayRule is a Zero Trust Gateway DNS, HTTP or network policy.
+type GatewayRule struct {
+	ID            string               `json:"id,omitempty"`
+	Name          string               `json:"name"`
+	Description   string               `json:"description"`
+	Precedence    uint64               `json:"precedence"`
+	Enabled       bool                 `json:"enabled"`
+	Action        GatewayRuleAction    `json:"action"`
+	Filters       []GatewayFilter      `json:"filters"`
+	Traffic       GatewayExpression    `json:"traffic"`
+	Identity      GatewayExpression    `json:"identity"`
+	DevicePosture GatewayExpression    `json:"device_posture"`
+	RuleSettings  GatewayRuleSettings  `json:"rule_settings,omitempty"`
+	Schedule      *GatewayRuleSchedule `json:"schedule,omitempty"`
+	CreatedAt     *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time           `json:"updated_at,omitempty"`
+	DeletedAt     *time.Time           `json:"deleted_at,omitempty"`
+}
+
+// GatewayRuleSettings holds the action specific settings of a rule.
+type GatewayRuleSettings struct {
+	BlockPageEnabled                bool                             `json:"block_page_enabled,omitempty"`
+	BlockReason                     string                           `json:"block_reason,omitempty"`
+	OverrideIPs                     []string                         `json:"override_ips,omitempty"`
+	OverrideHost                    string                           `json:"override_host,omitempty"`
+	L4Override                      *GatewayRuleL4Override           `json:"l4override,omitempty"`
+	BISOAdminControls               *GatewayRuleBISOAdminControls    `json:"biso_admin_controls,omitempty"`
+	CheckSession                    *GatewayRuleCheckSession         `json:"check_session,omitempty"`
+	AddHeaders                      map[string][]string              `json:"add_headers,omitempty"`
+	InsecureDisableDNSSECValidation bool                             `json:"insecure_disable_dnssec_validation,omitempty"`
+	Egress                          *GatewayRuleEgress               `json:"egress,omitempty"`
+	UntrustedCertAction             string                           `json:"untrusted_cert_action,omitempty"`
+	PayloadLog                      *GatewayRulePayloadLog           `json:"payload_log,omitempty"`
+	AuditSSH                        *GatewayRuleAuditSSH             `json:"audit_ssh,omitempty"`
+	NotificationSettings            *GatewayRuleNotificationSettings `json:"notification_settings,omitempty"`
+	ResolveDNSThroughCloudflare     *bool                            `json:"resolve_dns_through_cloudflare,omitempty"`
+	AllowChildBypass                *bool                            `json:"allow_child_bypass,omitempty"`
+	BypassParentRule                *bool                            `json:"bypass_parent_rule,omitempty"`
+	IPCategories                    bool                             `json:"ip_categories,omitempty"`
+}
+
+// GatewayRuleL4Override redirects network traffic to another destination.
+type GatewayRuleL4Override struct {
+	IP   string `json:"ip"`
+	Port int    `json:"port"`
+}
+
+// GatewayRuleBISOAdminControls restricts what users can do inside an
+// isolated browser session.
+type GatewayRuleBISOAdminControls struct {
+	DisablePrinting  bool `json:"dp"`
+	DisableCopyPaste bool `json:"dcp"`
+	DisableDownload  bool `json:"dd"`
+	DisableUpload    bool `json:"du"`
+	DisableKeyboard  bool `json:"dk"`
+}
+
+// GatewayRuleCheckSession requires users to have authenticated within
+// Duration, such as "24h".
+type GatewayRuleCheckSession struct {
+	Enforce  bool   `json:"enforce"`
+	Duration string `json:"duration"`
+}
+
+// GatewayRuleEgress selects the source IPs used for egress traffic.
+type GatewayRuleEgress struct {
+	IPv4         string `json:"ipv4"`
+	IPv6         string `json:"ipv6"`
+	IPv4Fallback string `json:"ipv4_fallback,omitempty"`
+}
+
+// GatewayRulePayloadLog controls logging of matched payloads.
+type GatewayRulePayloadLog struct {
+	Enabled bool `json:"enabled"`
+}
+
+// GatewayRuleAuditSSH controls logging of SSH commands.
+type GatewayRuleAuditSSH struct {
+	CommandLogging bool `json:"command_logging"`
+}
+
+// GatewayRuleNotificationSettings customises the WARP client notification
+// shown when a rule blocks traffic.
+type GatewayRuleNotificationSettings struct {
+	Enabled    bool   `json:"enabled"`
+	Message    string `json:"msg,omitempty"`
+	SupportURL string `json:"support_url,omitempty"`
+}
+
+// GatewayRuleSchedule limits a rule to certain times. Each day is a comma
+// separated list of ranges such as "08:00-12:30,13:30-17:00"; days left
+// empty are not active. TimeZone is an IANA name and defaults to the user's
+// time zone when empty.
+type GatewayRuleSchedule struct {
+	Monday    string `json:"mon,omitempty"`
+	Tuesday   string `json:"tue,omitempty"`
+	Wednesday string `json:"wed,omitempty"`
+	Thursday  string `json:"thu,omitempty"`
+	Friday    string `json:"fri,omitempty"`
+	Saturday  string `json:"sat,omitempty"`
+	Sunday    string `json:"sun,omitempty"`
+	TimeZone  string `json:"time_zone,omitempty"`
+}
+
+// GatewayRuleResponse represents the response from the Gateway rules
+// endpoint containing a single rule.
+type GatewayRuleResponse struct {
+	Response
+	Result GatewayRule `json:"result"`
+}
+
+// GatewayRulesResponse represents the response from the Gateway rules
+// endpoint containing multiple rules.
+type GatewayRulesResponse struct {
+	Response
+	Result []GatewayRule `json:"result"`
+}
+
+// ListRules returns the Gateway rules of an account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-list-zero-trust-gateway-rules
+func (s *GatewayService) ListRules(ctx context.Context, accountID string) ([]GatewayRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []GatewayRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/gateway/rules", nil)
+	if err != nil {
+		return []GatewayRule{}, err
+	}
+
+	var r GatewayRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []GatewayRule{}, fmt.Errorf("failed to unmarshal gateway rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetRule fetches a single Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-zero-trust-gateway-rule-details
+func (s *GatewayService) GetRule(ctx context.Context, accountID, ruleID string) (GatewayRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return GatewayRule{}, fmt.Errorf(errMissingResourceID, "gateway rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/gateway/rules/"+ruleID, nil)
+	if err != nil {
+		return GatewayRule{}, err
+	}
+
+	var r GatewayRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayRule{}, fmt.Errorf("failed to unmarshal gateway rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateRule creates a new Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-create-zero-trust-gateway-rule
+func (s *GatewayService) CreateRule(ctx context.Context, accountID string, rule GatewayRule) (GatewayRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/gateway/rules", rule)
+	if err != nil {
+		return GatewayRule{}, err
+	}
+
+	var r GatewayRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayRule{}, fmt.Errorf("failed to unmarshal gateway rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateRule replaces an existing Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-update-zero-trust-gateway-rule
+func (s *GatewayService) UpdateRule(ctx context.Context, accountID, ruleID string, rule GatewayRule) (GatewayRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return GatewayRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return GatewayRule{}, fmt.Errorf(errMissingResourceID, "gateway rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/gateway/rules/"+ruleID, rule)
+	if err != nil {
+		return GatewayRule{}, err
+	}
+
+	var r GatewayRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return GatewayRule{}, fmt.Errorf("failed to unmarshal gateway rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteRule removes a Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-rules-delete-zero-trust-gateway-rule
+func (s *GatewayService) DeleteRule(ctx context.Context, accountID, ruleID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return fmt.Errorf(errMissingResourceID, "gateway rule")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/gateway/rules/"+ruleID, nil)
+	return err
+}
@@ -1,8 +1,10 @@
 module github.com/jacobbednarz/cloudflare-go-experimental
 
-go 1.17
+go 1.18
 
 require (
+	github.com/google/go-querystring v1.1.0
+	github.com/gorilla/websocket v1.5.0
 	github.com/pkg/errors v0.9.1
 	golang.org/x/time v0.0.0-20211116232009-f0f3c7e86c11
 )
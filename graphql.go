@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQLError is an error reported in the errors member of a GraphQL
+// response. Path locates the field that failed, if any.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+func (e GraphQLError) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+
+	path := make([]string, 0, len(e.Path))
+	for _, p := range e.Path {
+		path = append(path, fmt.Sprint(p))
+	}
+	return strings.Join(path, ".") + ": " + e.Message
+}
+
+// GraphQLErrors is returned by GraphQL when the response holds errors.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return "graphql: " + strings.Join(messages, ", ")
+}
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// GraphQL runs a query against the GraphQL Analytics API and decodes the
+// data member of the response into out. Errors reported by the API are
+// returned as GraphQLErrors; any partial data is still decoded into out.
+func (c *Client) GraphQL(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	if strings.TrimSpace(query) == "" {
+		return errors.New("query must be provided")
+	}
+
+	res, err := c.Call(ctx, http.MethodPost, "/graphql", graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+
+	var r graphQLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal graphql JSON data: %w", err)
+	}
+
+	if out != nil && len(r.Data) > 0 && string(r.Data) != "null" {
+		if err := json.Unmarshal(r.Data, out); err != nil {
+			return fmt.Errorf("failed to unmarshal graphql JSON data: %w", err)
+		}
+	}
+
+	if len(r.Errors) > 0 {
+		return r.Errors
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type HealthChecksService service
+
+// HealthCheck is a standalone health check of an origin server.
+type HealthCheck struct {
+	ID                   string                 `json:"id,omitempty"`
+	CreatedOn            *time.Time             `json:"created_on,omitempty"`
+	ModifiedOn           *time.Time             `json:"modified_on,omitempty"`
+	Name                 string                 `json:"name"`
+	Description          string                 `json:"description"`
+	Suspended            bool                   `json:"suspended"`
+	Address              string                 `json:"address"`
+	Type                 string                 `json:"type,omitempty"`
+	Interval             int                    `json:"interval,omitempty"`
+	Retries              int                    `json:"retries,omitempty"`
+	Timeout              int                    `json:"timeout,omitempty"`
+	ConsecutiveFails     int                    `json:"consecutive_fails,omitempty"`
+	ConsecutiveSuccesses int                    `json:"consecutive_successes,omitempty"`
+	CheckRegions         []string               `json:"check_regions"`
+	HTTPConfig           *HealthCheckHTTPConfig `json:"http_config,omitempty"`
+	TCPConfig            *HealthCheckTCPConfig  `json:"tcp_config,omitempty"`
+	Status               string                 `json:"status,omitempty"`
+	FailureReason        string                 `json:"failure_reason,omitempty"`
+}
+
+// HealthCheckHTTPConfig configures health checks of type HTTP or HTTPS.
+type HealthCheckHTTPConfig struct {
+	Method          string              `json:"method,omitempty"`
+	Port            uint16              `json:"port,omitempty"`
+	Path            string              `json:"path,omitempty"`
+	ExpectedCodes   []string            `json:"expected_codes,omitempty"`
+	ExpectedBody    string              `json:"expected_body,omitempty"`
+	FollowRedirects bool                `json:"follow_redirects"`
+	AllowInsecure   bool                `json:"allow_insecure"`
+	Header          map[string][]string `json:"header,omitempty"`
+}
+
+// HealthCheckTCPConfig configures health checks of type TCP.
+type HealthCheckTCPConfig struct {
+	Method string `json:"method,omitempty"`
+	Port   uint16 `json:"port,omitempty"`
+}
+
+// HealthCheckResponse represents the response from the health checks
+// endpoint containing a single health check.
+type HealthCheckResponse struct {
+	Response
+	Result HealthCheck `json:"result"`
+}
+
+// HealthChecksResponse represents the response from the health checks
+// endpoint containing multiple health checks.
+type HealthChecksResponse struct {
+	Response
+	Result     []HealthCheck `json:"result"`
+	ResultInfo ResultInfo    `json:"result_info"`
+}
+
+// HealthCheckListParams contains the options available when listing health
+// checks.
+type HealthCheckListParams struct {
+	PaginationParams
+}
+
+// List returns the health checks of a zone.
+//
+// API reference: https://api.cloudflare.com/#health-checks-list-health-checks
+func (s *HealthChecksService) List(ctx context.Context, zoneID string, params HealthCheckListParams) ([]HealthCheck, ResultInfo, error) {
+	var healthChecks []HealthCheck
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []HealthCheck, pageInfo ResultInfo) bool {
+		healthChecks = append(healthChecks, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []HealthCheck{}, ResultInfo{}, err
+	}
+
+	return healthChecks, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#health-checks-list-health-checks
+func (s *HealthChecksService) ForEachPage(ctx context.Context, zoneID string, params HealthCheckListParams, fn func(items []HealthCheck, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/healthchecks", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r HealthChecksResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal health check JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single health check.
+//
+// API reference: https://api.cloudflare.com/#health-checks-health-check-details
+func (s *HealthChecksService) Get(ctx context.Context, zoneID, healthCheckID string) (HealthCheck, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return HealthCheck{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if healthCheckID == "" {
+		return HealthCheck{}, fmt.Errorf(errMissingResourceID, "health check")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/healthchecks/"+healthCheckID, nil)
+	if err != nil {
+		return HealthCheck{}, err
+	}
+
+	var r HealthCheckResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HealthCheck{}, fmt.Errorf("failed to unmarshal health check JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new health check.
+//
+// API reference: https://api.cloudflare.com/#health-checks-create-health-check
+func (s *HealthChecksService) Create(ctx context.Context, zoneID string, healthCheck HealthCheck) (HealthCheck, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return HealthCheck{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/healthchecks", healthCheck)
+	if err != nil {
+		return HealthCheck{}, err
+	}
+
+	var r HealthCheckResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HealthCheck{}, fmt.Errorf("failed to unmarshal health check JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces the configuration of an existing health check.
+//
+// API reference: https://api.cloudflare.com/#health-checks-update-health-check
+func (s *HealthChecksService) Update(ctx context.Context, zoneID, healthCheckID string, healthCheck HealthCheck) (HealthCheck, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return HealthCheck{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if healthCheckID == "" {
+		return HealthCheck{}, fmt.Errorf(errMissingResourceID, "health check")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/healthchecks/"+healthCheckID, healthCheck)
+	if err != nil {
+		return HealthCheck{}, err
+	}
+
+	var r HealthCheckResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HealthCheck{}, fmt.Errorf("failed to unmarshal health check JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a health check.
+//
+// API reference: https://api.cloudflare.com/#health-checks-delete-health-check
+func (s *HealthChecksService) Delete(ctx context.Context, zoneID, healthCheckID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if healthCheckID == "" {
+		return fmt.Errorf(errMissingResourceID, "health check")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/healthchecks/"+healthCheckID, nil)
+	return err
+}
+
+// CreatePreview runs a health check without saving it. The results are
+// retrieved with GetPreview using the returned health check ID.
+//
+// API reference: https://api.cloudflare.com/#health-checks-create-preview-health-check
+func (s *HealthChecksService) CreatePreview(ctx context.Context, zoneID string, healthCheck HealthCheck) (HealthCheck, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return HealthCheck{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/healthchecks/preview", healthCheck)
+	if err != nil {
+		return HealthCheck{}, err
+	}
+
+	var r HealthCheckResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HealthCheck{}, fmt.Errorf("failed to unmarshal health check JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetPreview fetches a health check preview and its current status.
+//
+// API reference: https://api.cloudflare.com/#health-checks-health-check-preview-details
+func (s *HealthChecksService) GetPreview(ctx context.Context, zoneID, previewID string) (HealthCheck, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return HealthCheck{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if previewID == "" {
+		return HealthCheck{}, fmt.Errorf(errMissingResourceID, "preview")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/healthchecks/preview/"+previewID, nil)
+	if err != nil {
+		return HealthCheck{}, err
+	}
+
+	var r HealthCheckResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return HealthCheck{}, fmt.Errorf("failed to unmarshal health check JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeletePreview removes a health check preview.
+//
+// API reference: https://api.cloudflare.com/#health-checks-delete-preview-health-check
+func (s *HealthChecksService) DeletePreview(ctx context.Context, zoneID, previewID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if previewID == "" {
+		return fmt.Errorf(errMissingResourceID, "preview")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/healthchecks/preview/"+previewID, nil)
+	return err
+}
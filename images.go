@@ -0,0 +1,642 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"time"
+)
+
+type ImagesService service
+
+// Image is an image stored in Cloudflare Images. Variants holds the
+// delivery URL of each variant.
+type Image struct {
+	ID                string                 `json:"id"`
+	Filename          string                 `json:"filename"`
+	Meta              map[string]interface{} `json:"meta,omitempty"`
+	RequireSignedURLs bool                   `json:"requireSignedURLs"`
+	Variants          []string               `json:"variants"`
+	Uploaded          *time.Time             `json:"uploaded,omitempty"`
+}
+
+// ImageUploadParams contains the image to upload and its options. Exactly
+// one of File and URL must be set; Filename names the file read from File.
+// ID sets a custom ID instead of a generated one.
+type ImageUploadParams struct {
+	File              io.Reader
+	Filename          string
+	URL               string
+	ID                string
+	Metadata          map[string]interface{}
+	RequireSignedURLs bool
+}
+
+// ImageUpdateParams contains the fields that can be changed on an image.
+// Unset fields are left unchanged.
+type ImageUpdateParams struct {
+	RequireSignedURLs *bool                  `json:"requireSignedURLs,omitempty"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ImageListParams contains the options available when listing images.
+// SortOrder is "asc" or "desc". The cursor is sent as the continuation token
+// of the page to fetch.
+type ImageListParams struct {
+	PerPage   int    `url:"per_page,omitempty"`
+	SortOrder string `url:"sort_order,omitempty"`
+
+	CursorPaginationParams
+}
+
+// ImagesStats reports the storage used by an account.
+type ImagesStats struct {
+	Count struct {
+		Allowed int `json:"allowed"`
+		Current int `json:"current"`
+	} `json:"count"`
+}
+
+// ImageVariant is a named set of resizing options images are delivered
+// with.
+type ImageVariant struct {
+	ID                     string              `json:"id"`
+	Options                ImageVariantOptions `json:"options"`
+	NeverRequireSignedURLs bool                `json:"neverRequireSignedURLs,omitempty"`
+}
+
+// ImageVariantOptions are the resizing options of a variant. Fit is one of
+// "scale-down", "contain", "cover", "crop" or "pad" and Metadata one of
+// "keep", "copyright" or "none".
+type ImageVariantOptions struct {
+	Fit      string `json:"fit"`
+	Metadata string `json:"metadata"`
+	Width    int    `json:"width,omitempty"`
+	Height   int    `json:"height,omitempty"`
+}
+
+// ImagesSigningKey is a key used to sign image delivery URLs.
+type ImagesSigningKey struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ImageDirectUploadParams contains the options of a direct creator upload.
+// Expiry must be between two minutes and six hours from now and defaults to
+// 30 minutes. ID sets a custom ID for the uploaded image.
+type ImageDirectUploadParams struct {
+	ID                string
+	Metadata          map[string]interface{}
+	RequireSignedURLs bool
+	Expiry            *time.Time
+}
+
+// ImageDirectUpload is a one-time URL a client can upload a single image to
+// without credentials. ID is the ID the uploaded image will have.
+type ImageDirectUpload struct {
+	ID        string `json:"id"`
+	UploadURL string `json:"uploadURL"`
+}
+
+// ImageDirectUploadResponse represents the response from the direct upload
+// endpoint.
+type ImageDirectUploadResponse struct {
+	Response
+	Result ImageDirectUpload `json:"result"`
+}
+
+// ImageResponse represents the response from the images endpoint containing
+// a single image.
+type ImageResponse struct {
+	Response
+	Result Image `json:"result"`
+}
+
+// ImagesListResponse represents the response from the images list endpoint.
+type ImagesListResponse struct {
+	Response
+	Result struct {
+		Images            []Image `json:"images"`
+		ContinuationToken string  `json:"continuation_token"`
+	} `json:"result"`
+}
+
+// ImagesStatsResponse represents the response from the images stats
+// endpoint.
+type ImagesStatsResponse struct {
+	Response
+	Result ImagesStats `json:"result"`
+}
+
+// ImageVariantResponse represents the response from the variants endpoint
+// containing a single variant.
+type ImageVariantResponse struct {
+	Response
+	Result struct {
+		Variant ImageVariant `json:"variant"`
+	} `json:"result"`
+}
+
+// ImageVariantsResponse represents the response from the variants endpoint
+// containing every variant.
+type ImageVariantsResponse struct {
+	Response
+	Result struct {
+		Variants map[string]ImageVariant `json:"variants"`
+	} `json:"result"`
+}
+
+// ImagesSigningKeysResponse represents the response from the signing keys
+// endpoints.
+type ImagesSigningKeysResponse struct {
+	Response
+	Result struct {
+		Keys []ImagesSigningKey `json:"keys"`
+	} `json:"result"`
+}
+
+// Upload uploads an image from a reader or has Cloudflare fetch it from a
+// URL.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-upload-an-image-via-url
+func (s *ImagesService) Upload(ctx context.Context, accountID string, params ImageUploadParams) (Image, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Image{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if (params.File == nil) == (params.URL == "") {
+		return Image{}, errors.New("exactly one of file and url must be provided")
+	}
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	if params.File != nil {
+		filename := params.Filename
+		if filename == "" {
+			filename = "image"
+		}
+		part, err := w.CreateFormFile("file", filename)
+		if err != nil {
+			return Image{}, err
+		}
+		if _, err := io.Copy(part, params.File); err != nil {
+			return Image{}, fmt.Errorf("failed to read image: %w", err)
+		}
+	}
+
+	var metadata []byte
+	if params.Metadata != nil {
+		var err error
+		metadata, err = json.Marshal(params.Metadata)
+		if err != nil {
+			return Image{}, fmt.Errorf("failed to marshal image metadata: %w", err)
+		}
+	}
+
+	fields := [][2]string{
+		{"url", params.URL},
+		{"id", params.ID},
+		{"metadata", string(metadata)},
+	}
+	if params.RequireSignedURLs {
+		fields = append(fields, [2]string{"requireSignedURLs", "true"})
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		if err := w.WriteField(f[0], f[1]); err != nil {
+			return Image{}, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return Image{}, err
+	}
+
+	res, err := s.client.CallWithHeaders(ctx, http.MethodPost, "/accounts/"+accountID+"/images/v1", body, http.Header{"Content-Type": []string{w.FormDataContentType()}})
+	if err != nil {
+		return Image{}, err
+	}
+
+	var r ImageResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Image{}, fmt.Errorf("failed to unmarshal image JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// List returns the images of an account. Unless a cursor is provided every
+// image is fetched; otherwise a single page is returned and ResultInfo.Cursor
+// holds the cursor of the next page.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-list-images-v2
+func (s *ImagesService) List(ctx context.Context, accountID string, params ImageListParams) ([]Image, ResultInfo, error) {
+	var images []Image
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []Image, pageInfo ResultInfo) bool {
+		images = append(images, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []Image{}, ResultInfo{}, err
+	}
+
+	return images, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-list-images-v2
+func (s *ImagesService) ForEachPage(ctx context.Context, accountID string, params ImageListParams, fn func(items []Image, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listCursorPages(ctx, "/accounts/"+accountID+"/images/v2", "continuation_token", &params, &params.CursorPaginationParams, func(res []byte) (ResultInfo, error) {
+		var r ImagesListResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal image JSON data: %w", err)
+		}
+		info := ResultInfo{Cursor: r.Result.ContinuationToken}
+		if !fn(r.Result.Images, info) {
+			return ResultInfo{}, errStopPagination
+		}
+		return info, nil
+	})
+}
+
+// Get fetches the details of a single image.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-image-details
+func (s *ImagesService) Get(ctx context.Context, accountID, imageID string) (Image, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Image{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if imageID == "" {
+		return Image{}, fmt.Errorf(errMissingResourceID, "image")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/images/v1/"+imageID, nil)
+	if err != nil {
+		return Image{}, err
+	}
+
+	var r ImageResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Image{}, fmt.Errorf("failed to unmarshal image JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the metadata and signed URL requirement of an image.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-update-image
+func (s *ImagesService) Update(ctx context.Context, accountID, imageID string, params ImageUpdateParams) (Image, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Image{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if imageID == "" {
+		return Image{}, fmt.Errorf(errMissingResourceID, "image")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/images/v1/"+imageID, params)
+	if err != nil {
+		return Image{}, err
+	}
+
+	var r ImageResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Image{}, fmt.Errorf("failed to unmarshal image JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes an image and its variants from the cache.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-delete-image
+func (s *ImagesService) Delete(ctx context.Context, accountID, imageID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if imageID == "" {
+		return fmt.Errorf(errMissingResourceID, "image")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/images/v1/"+imageID, nil)
+	return err
+}
+
+// GetBaseImage returns the original bytes of an image as uploaded.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-base-image
+func (s *ImagesService) GetBaseImage(ctx context.Context, accountID, imageID string) ([]byte, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if imageID == "" {
+		return nil, fmt.Errorf(errMissingResourceID, "image")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/images/v1/"+imageID+"/blob", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Stats returns the number of images stored in an account and its limit.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-images-usage-statistics
+func (s *ImagesService) Stats(ctx context.Context, accountID string) (ImagesStats, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return ImagesStats{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/images/v1/stats", nil)
+	if err != nil {
+		return ImagesStats{}, err
+	}
+
+	var r ImagesStatsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ImagesStats{}, fmt.Errorf("failed to unmarshal images stats JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListVariants returns the variants of an account keyed by ID.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-list-variants
+func (s *ImagesService) ListVariants(ctx context.Context, accountID string) (map[string]ImageVariant, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/images/v1/variants", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ImageVariantsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal image variant JSON data: %w", err)
+	}
+
+	return r.Result.Variants, nil
+}
+
+// GetVariant fetches a single variant.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-variant-details
+func (s *ImagesService) GetVariant(ctx context.Context, accountID, variantID string) (ImageVariant, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return ImageVariant{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if variantID == "" {
+		return ImageVariant{}, fmt.Errorf(errMissingResourceID, "variant")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/images/v1/variants/"+variantID, nil)
+	if err != nil {
+		return ImageVariant{}, err
+	}
+
+	var r ImageVariantResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ImageVariant{}, fmt.Errorf("failed to unmarshal image variant JSON data: %w", err)
+	}
+
+	return r.Result.Variant, nil
+}
+
+// CreateVariant creates a variant. Up to 100 variants can be created per
+// account.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-create-a-variant
+func (s *ImagesService) CreateVariant(ctx context.Context, accountID string, variant ImageVariant) (ImageVariant, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return ImageVariant{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if variant.ID == "" {
+		return ImageVariant{}, fmt.Errorf(errMissingResourceID, "variant")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/images/v1/variants", variant)
+	if err != nil {
+		return ImageVariant{}, err
+	}
+
+	var r ImageVariantResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ImageVariant{}, fmt.Errorf("failed to unmarshal image variant JSON data: %w", err)
+	}
+
+	return r.Result.Variant, nil
+}
+
+// UpdateVariant changes the options of a variant. Images already cached
+// with the variant are purged.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-update-a-variant
+func (s *ImagesService) UpdateVariant(ctx context.Context, accountID, variantID string, variant ImageVariant) (ImageVariant, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return ImageVariant{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if variantID == "" {
+		return ImageVariant{}, fmt.Errorf(errMissingResourceID, "variant")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/images/v1/variants/"+variantID, variant)
+	if err != nil {
+		return ImageVariant{}, err
+	}
+
+	var r ImageVariantResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ImageVariant{}, fmt.Errorf("failed to unmarshal image variant JSON data: %w", err)
+	}
+
+	return r.Result.Variant, nil
+}
+
+// DeleteVariant removes a variant.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-variants-delete-a-variant
+func (s *ImagesService) DeleteVariant(ctx context.Context, accountID, variantID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if variantID == "" {
+		return fmt.Errorf(errMissingResourceID, "variant")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/images/v1/variants/"+variantID, nil)
+	return err
+}
+
+// ListSigningKeys returns the keys used to sign image delivery URLs.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-keys-list-signing-keys
+func (s *ImagesService) ListSigningKeys(ctx context.Context, accountID string) ([]ImagesSigningKey, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []ImagesSigningKey{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/images/v1/keys", nil)
+	if err != nil {
+		return []ImagesSigningKey{}, err
+	}
+
+	var r ImagesSigningKeysResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ImagesSigningKey{}, fmt.Errorf("failed to unmarshal images signing key JSON data: %w", err)
+	}
+
+	return r.Result.Keys, nil
+}
+
+// CreateSigningKey creates a signing key with a random value, or replaces
+// the value of an existing key with the same name, returning every key.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-keys-create-a-new-signing-key
+func (s *ImagesService) CreateSigningKey(ctx context.Context, accountID, name string) ([]ImagesSigningKey, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []ImagesSigningKey{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if name == "" {
+		return []ImagesSigningKey{}, fmt.Errorf(errMissingResourceID, "signing key")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/images/v1/keys/"+name, nil)
+	if err != nil {
+		return []ImagesSigningKey{}, err
+	}
+
+	var r ImagesSigningKeysResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ImagesSigningKey{}, fmt.Errorf("failed to unmarshal images signing key JSON data: %w", err)
+	}
+
+	return r.Result.Keys, nil
+}
+
+// DeleteSigningKey removes a signing key, returning the keys that remain.
+// The default key cannot be deleted.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-keys-delete-signing-key
+func (s *ImagesService) DeleteSigningKey(ctx context.Context, accountID, name string) ([]ImagesSigningKey, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []ImagesSigningKey{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if name == "" {
+		return []ImagesSigningKey{}, fmt.Errorf(errMissingResourceID, "signing key")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/images/v1/keys/"+name, nil)
+	if err != nil {
+		return []ImagesSigningKey{}, err
+	}
+
+	var r ImagesSigningKeysResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ImagesSigningKey{}, fmt.Errorf("failed to unmarshal images signing key JSON data: %w", err)
+	}
+
+	return r.Result.Keys, nil
+}
+
+// CreateDirectUpload returns a one-time URL that a browser or other client
+// can upload an image to directly, without access to the account's
+// credentials. The image is pending until the upload completes and the URL
+// stops working after its expiry.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-create-authenticated-direct-upload-url-v2
+func (s *ImagesService) CreateDirectUpload(ctx context.Context, accountID string, params ImageDirectUploadParams) (ImageDirectUpload, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return ImageDirectUpload{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	var metadata []byte
+	if params.Metadata != nil {
+		var err error
+		metadata, err = json.Marshal(params.Metadata)
+		if err != nil {
+			return ImageDirectUpload{}, fmt.Errorf("failed to marshal image metadata: %w", err)
+		}
+	}
+
+	fields := [][2]string{
+		{"id", params.ID},
+		{"metadata", string(metadata)},
+	}
+	if params.RequireSignedURLs {
+		fields = append(fields, [2]string{"requireSignedURLs", "true"})
+	}
+	if params.Expiry != nil {
+		fields = append(fields, [2]string{"expiry", params.Expiry.UTC().Format(time.RFC3339)})
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		if err := w.WriteField(f[0], f[1]); err != nil {
+			return ImageDirectUpload{}, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return ImageDirectUpload{}, err
+	}
+
+	res, err := s.client.CallWithHeaders(ctx, http.MethodPost, "/accounts/"+accountID+"/images/v2/direct_upload", body, http.Header{"Content-Type": []string{w.FormDataContentType()}})
+	if err != nil {
+		return ImageDirectUpload{}, err
+	}
+
+	var r ImageDirectUploadResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ImageDirectUpload{}, fmt.Errorf("failed to unmarshal image direct upload JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
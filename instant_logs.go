@@ -0,0 +1,237 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+)
+
+// defaultInstantLogsBufferSize is the number of events buffered by a session
+// when InstantLogsParams.BufferSize is not set.
+const defaultInstantLogsBufferSize = 256
+
+// InstantLogsJob is an Instant Logs session created for a zone.
+// DestinationConf is the WebSocket URL the logs are streamed from.
+type InstantLogsJob struct {
+	SessionID       string  `json:"session_id"`
+	DestinationConf string  `json:"destination_conf"`
+	Fields          string  `json:"fields"`
+	Filter          string  `json:"filter,omitempty"`
+	Sample          float64 `json:"sample"`
+}
+
+// InstantLogsJobResponse represents the response from the Instant Logs
+// endpoint containing a single job.
+type InstantLogsJobResponse struct {
+	Response
+	Result InstantLogsJob `json:"result"`
+}
+
+// InstantLogsJobsResponse represents the response from the Instant Logs
+// endpoint containing multiple jobs.
+type InstantLogsJobsResponse struct {
+	Response
+	Result []InstantLogsJob `json:"result"`
+}
+
+// InstantLogsJobParams contains the options of a new Instant Logs job.
+//
+// Fields is a comma separated list of the fields included in each event.
+// Sample keeps one in every Sample requests, with 1 keeping every request.
+// Filter is a JSON encoded filter expression in the same format as Logpush
+// filters.
+type InstantLogsJobParams struct {
+	Fields string  `json:"fields"`
+	Sample float64 `json:"sample,omitempty"`
+	Filter string  `json:"filter,omitempty"`
+	Kind   string  `json:"kind,omitempty"`
+}
+
+// InstantLogsParams contains the options used when streaming Instant Logs.
+type InstantLogsParams struct {
+	InstantLogsJobParams
+
+	// BufferSize is the number of events held while the consumer is busy.
+	// Defaults to 256.
+	BufferSize int
+
+	// DropWhenFull discards events once the buffer is full instead of
+	// pausing the stream. Pausing for too long causes Cloudflare to close
+	// the session, after which a new one is created.
+	DropWhenFull bool
+}
+
+// InstantLogsSession delivers the events of a running Instant Logs stream.
+type InstantLogsSession struct {
+	// dropped is accessed atomically and comes first so it is 64-bit aligned
+	// on 32-bit platforms.
+	dropped uint64
+	events  chan LogpullEntry
+
+	mu  sync.Mutex
+	err error
+}
+
+// Events returns the channel events are delivered on. It is closed once the
+// session ends, after which Err reports why.
+func (l *InstantLogsSession) Events() <-chan LogpullEntry {
+	return l.events
+}
+
+// Dropped returns the number of events discarded because the buffer was
+// full. It is always zero unless DropWhenFull was set.
+func (l *InstantLogsSession) Dropped() uint64 {
+	return atomic.LoadUint64(&l.dropped)
+}
+
+// Err returns the error that ended the session, or nil if it was ended by
+// cancelling the context passed to InstantLogs.
+func (l *InstantLogsSession) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// CreateInstantLogsJob starts an Instant Logs session for a zone. Most
+// callers should use InstantLogs which also connects to the session and
+// decodes its events.
+//
+// API reference: https://api.cloudflare.com/#instant-logs-jobs-create-instant-logs-job
+func (s *LogsService) CreateInstantLogsJob(ctx context.Context, zoneID string, params InstantLogsJobParams) (InstantLogsJob, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return InstantLogsJob{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.Kind == "" {
+		params.Kind = "instant-logs"
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/logpush/edge", params)
+	if err != nil {
+		return InstantLogsJob{}, err
+	}
+
+	var r InstantLogsJobResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return InstantLogsJob{}, fmt.Errorf("failed to unmarshal instant logs JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListInstantLogsJobs returns the Instant Logs sessions of a zone.
+//
+// API reference: https://api.cloudflare.com/#instant-logs-jobs-list-instant-logs-jobs
+func (s *LogsService) ListInstantLogsJobs(ctx context.Context, zoneID string) ([]InstantLogsJob, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []InstantLogsJob{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/logpush/edge", nil)
+	if err != nil {
+		return []InstantLogsJob{}, err
+	}
+
+	var r InstantLogsJobsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []InstantLogsJob{}, fmt.Errorf("failed to unmarshal instant logs JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// InstantLogs streams the HTTP request logs of a zone in real time. Events
+// are delivered on the returned session's channel until ctx is cancelled. If
+// the WebSocket connection drops, a new session is created and connected to
+// following the client's RetryPolicy.
+//
+// When the consumer falls behind, the stream is paused until there is room
+// in the buffer unless DropWhenFull is set, in which case excess events are
+// discarded and counted by Dropped.
+//
+// API reference: https://api.cloudflare.com/#instant-logs-jobs-create-instant-logs-job
+func (s *LogsService) InstantLogs(ctx context.Context, zoneID string, params InstantLogsParams) (*InstantLogsSession, error) {
+	// Create the first job up front so configuration errors are returned
+	// directly rather than through the session.
+	job, err := s.CreateInstantLogsJob(ctx, zoneID, params.InstantLogsJobParams)
+	if err != nil {
+		return nil, err
+	}
+
+	size := params.BufferSize
+	if size <= 0 {
+		size = defaultInstantLogsBufferSize
+	}
+
+	session := &InstantLogsSession{events: make(chan LogpullEntry, size)}
+
+	connect := func(ctx context.Context) (*websocket.Conn, error) {
+		if job.DestinationConf == "" {
+			j, err := s.CreateInstantLogsJob(ctx, zoneID, params.InstantLogsJobParams)
+			if err != nil {
+				return nil, err
+			}
+			job = j
+		}
+
+		// Sessions cannot be reconnected to so the next attempt always
+		// starts a new one.
+		url := job.DestinationConf
+		job = InstantLogsJob{}
+
+		return s.client.dialWebSocket(ctx, url, nil)
+	}
+
+	handle := func(msg []byte) error {
+		// A message may hold several newline delimited events.
+		for _, line := range bytes.Split(msg, []byte("\n")) {
+			line = bytes.TrimSpace(line)
+			if len(line) == 0 {
+				continue
+			}
+
+			decoder := json.NewDecoder(bytes.NewReader(line))
+			decoder.UseNumber()
+
+			var event LogpullEntry
+			if err := decoder.Decode(&event); err != nil {
+				return fmt.Errorf("failed to unmarshal instant logs event JSON data: %w", err)
+			}
+
+			if params.DropWhenFull {
+				select {
+				case session.events <- event:
+				default:
+					atomic.AddUint64(&session.dropped, 1)
+				}
+				continue
+			}
+
+			select {
+			case session.events <- event:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		return nil
+	}
+
+	go func() {
+		err := s.client.streamWebSocket(ctx, connect, handle)
+
+		session.mu.Lock()
+		session.err = err
+		session.mu.Unlock()
+		close(session.events)
+	}()
+
+	return session, nil
+}
@@ -0,0 +1,369 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+type IntelService service
+
+// IntelDomain is the intelligence gathered about a domain.
+type IntelDomain struct {
+	Domain                     string               `json:"domain"`
+	PopularityRank             int                  `json:"popularity_rank"`
+	RiskScore                  float64              `json:"risk_score"`
+	Application                IntelApplication     `json:"application"`
+	AdditionalInformation      IntelAdditionalInfo  `json:"additional_information"`
+	ContentCategories          []IntelCategory      `json:"content_categories"`
+	ResolvesToRefs             []IntelResolvesToRef `json:"resolves_to_refs"`
+	InheritedContentCategories []IntelCategory      `json:"inherited_content_categories,omitempty"`
+	InheritedFrom              string               `json:"inherited_from,omitempty"`
+	InheritedRiskTypes         []IntelCategory      `json:"inherited_risk_types,omitempty"`
+	RiskTypes                  []IntelCategory      `json:"risk_types"`
+}
+
+// IntelApplication is the application a domain belongs to.
+type IntelApplication struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// IntelAdditionalInfo holds further details about a domain.
+type IntelAdditionalInfo struct {
+	SuspectedMalwareFamily string `json:"suspected_malware_family"`
+}
+
+// IntelCategory is a content or security category.
+type IntelCategory struct {
+	ID              int    `json:"id"`
+	SuperCategoryID int    `json:"super_category_id,omitempty"`
+	Name            string `json:"name"`
+}
+
+// IntelResolvesToRef is an address a domain resolves to.
+type IntelResolvesToRef struct {
+	ID    string `json:"id"`
+	Value string `json:"value"`
+}
+
+// IntelIP is the intelligence gathered about an IP address.
+type IntelIP struct {
+	IP           string          `json:"ip"`
+	BelongsToRef IntelIPOwner    `json:"belongs_to_ref"`
+	RiskTypes    []IntelCategory `json:"risk_types"`
+	PTRLookup    []string        `json:"ptr_lookup,omitempty"`
+	ResultInfo   []string        `json:"result_info,omitempty"`
+}
+
+// IntelIPOwner is the network an IP address belongs to.
+type IntelIPOwner struct {
+	ID          string `json:"id"`
+	Value       int    `json:"value"`
+	Type        string `json:"type"`
+	Country     string `json:"country"`
+	Description string `json:"description"`
+}
+
+// IntelWHOIS is the WHOIS record of a domain.
+type IntelWHOIS struct {
+	Domain            string   `json:"domain"`
+	CreatedDate       string   `json:"created_date"`
+	UpdatedDate       string   `json:"updated_date"`
+	ExpirationDate    string   `json:"expiration_date"`
+	Registrant        string   `json:"registrant"`
+	RegistrantCountry string   `json:"registrant_country"`
+	RegistrantEmail   string   `json:"registrant_email"`
+	RegistrantOrg     string   `json:"registrant_org"`
+	Registrar         string   `json:"registrar"`
+	Nameservers       []string `json:"nameservers"`
+}
+
+// IntelPassiveDNS lists the hostnames observed resolving to an address.
+type IntelPassiveDNS struct {
+	Count          int                  `json:"count"`
+	Page           int                  `json:"page"`
+	PerPage        int                  `json:"per_page"`
+	ReverseRecords []IntelReverseRecord `json:"reverse_records"`
+}
+
+// IntelReverseRecord is a hostname observed resolving to an address.
+type IntelReverseRecord struct {
+	Hostname  string `json:"hostname"`
+	FirstSeen string `json:"first_seen"`
+	LastSeen  string `json:"last_seen"`
+}
+
+// IntelASN is the intelligence gathered about an autonomous system.
+type IntelASN struct {
+	ASN         int             `json:"asn"`
+	Description string          `json:"description"`
+	Country     string          `json:"country"`
+	Type        string          `json:"type"`
+	DomainCount int             `json:"domain_count"`
+	TopDomains  []string        `json:"top_domains"`
+	RiskTypes   []IntelCategory `json:"risk_types,omitempty"`
+}
+
+// IntelASNSubnets lists the subnets announced by an autonomous system.
+type IntelASNSubnets struct {
+	ASN          int      `json:"asn"`
+	IPCountTotal int      `json:"ip_count_total"`
+	Subnets      []string `json:"subnets"`
+	Count        int      `json:"count"`
+	Page         int      `json:"page"`
+	PerPage      int      `json:"per_page"`
+}
+
+// IntelIPParams selects the address looked up by IP. Exactly one of IPv4 and
+// IPv6 should be set.
+type IntelIPParams struct {
+	IPv4 string `url:"ipv4,omitempty"`
+	IPv6 string `url:"ipv6,omitempty"`
+}
+
+// IntelPassiveDNSParams selects the address and time range looked up by
+// PassiveDNS. Start and End are dates in YYYY-MM-DD format.
+type IntelPassiveDNSParams struct {
+	IPv4  string `url:"ipv4"`
+	Start string `url:"start_end_pair.start,omitempty"`
+	End   string `url:"start_end_pair.end,omitempty"`
+
+	PaginationParams
+}
+
+// intelDomainParams is the query string of the domain endpoints.
+type intelDomainParams struct {
+	Domain []string `url:"domain"`
+}
+
+// IntelDomainResponse represents the response from the domain intel
+// endpoint.
+type IntelDomainResponse struct {
+	Response
+	Result IntelDomain `json:"result"`
+}
+
+// IntelDomainsResponse represents the response from the bulk domain intel
+// endpoint.
+type IntelDomainsResponse struct {
+	Response
+	Result []IntelDomain `json:"result"`
+}
+
+// IntelIPResponse represents the response from the IP intel endpoint.
+type IntelIPResponse struct {
+	Response
+	Result []IntelIP `json:"result"`
+}
+
+// IntelWHOISResponse represents the response from the WHOIS endpoint.
+type IntelWHOISResponse struct {
+	Response
+	Result IntelWHOIS `json:"result"`
+}
+
+// IntelPassiveDNSResponse represents the response from the passive DNS
+// endpoint.
+type IntelPassiveDNSResponse struct {
+	Response
+	Result IntelPassiveDNS `json:"result"`
+}
+
+// IntelASNResponse represents the response from the ASN overview endpoint.
+type IntelASNResponse struct {
+	Response
+	Result IntelASN `json:"result"`
+}
+
+// IntelASNSubnetsResponse represents the response from the ASN subnets
+// endpoint.
+type IntelASNSubnetsResponse struct {
+	Response
+	Result IntelASNSubnets `json:"result"`
+}
+
+// Domain returns the categories, risk and popularity of a domain.
+//
+// API reference: https://api.cloudflare.com/#domain-intelligence-get-domain-details
+func (s *IntelService) Domain(ctx context.Context, accountID, domain string) (IntelDomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IntelDomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if domain == "" {
+		return IntelDomain{}, errors.New("domain must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/intel/domain", intelDomainParams{Domain: []string{domain}}), nil)
+	if err != nil {
+		return IntelDomain{}, err
+	}
+
+	var r IntelDomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IntelDomain{}, fmt.Errorf("failed to unmarshal intel domain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Domains returns the intelligence of several domains in a single request.
+//
+// API reference: https://api.cloudflare.com/#domain-intelligence-get-multiple-domain-details
+func (s *IntelService) Domains(ctx context.Context, accountID string, domains ...string) ([]IntelDomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []IntelDomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if len(domains) == 0 {
+		return []IntelDomain{}, errors.New("at least one domain must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/intel/domain/bulk", intelDomainParams{Domain: domains}), nil)
+	if err != nil {
+		return []IntelDomain{}, err
+	}
+
+	var r IntelDomainsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []IntelDomain{}, fmt.Errorf("failed to unmarshal intel domain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// IP returns the owner and risk of an IP address.
+//
+// API reference: https://api.cloudflare.com/#ip-intelligence-get-ip-overview
+func (s *IntelService) IP(ctx context.Context, accountID string, params IntelIPParams) ([]IntelIP, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []IntelIP{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if (params.IPv4 == "") == (params.IPv6 == "") {
+		return []IntelIP{}, errors.New("exactly one of ipv4 and ipv6 must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/intel/ip", params), nil)
+	if err != nil {
+		return []IntelIP{}, err
+	}
+
+	var r IntelIPResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []IntelIP{}, fmt.Errorf("failed to unmarshal intel ip JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// WHOIS returns the WHOIS record of a domain.
+//
+// API reference: https://api.cloudflare.com/#whois-record-get-whois-record
+func (s *IntelService) WHOIS(ctx context.Context, accountID, domain string) (IntelWHOIS, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IntelWHOIS{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if domain == "" {
+		return IntelWHOIS{}, errors.New("domain must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/intel/whois", intelDomainParams{Domain: []string{domain}}), nil)
+	if err != nil {
+		return IntelWHOIS{}, err
+	}
+
+	var r IntelWHOISResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IntelWHOIS{}, fmt.Errorf("failed to unmarshal intel whois JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// PassiveDNS returns the hostnames observed resolving to an IPv4 address.
+//
+// API reference: https://api.cloudflare.com/#passive-dns-by-ip-get-passive-dns-by-ip
+func (s *IntelService) PassiveDNS(ctx context.Context, accountID string, params IntelPassiveDNSParams) (IntelPassiveDNS, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IntelPassiveDNS{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.IPv4 == "" {
+		return IntelPassiveDNS{}, errors.New("ipv4 must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/intel/dns", params), nil)
+	if err != nil {
+		return IntelPassiveDNS{}, err
+	}
+
+	var r IntelPassiveDNSResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IntelPassiveDNS{}, fmt.Errorf("failed to unmarshal intel passive dns JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ASN returns an overview of an autonomous system.
+//
+// API reference: https://api.cloudflare.com/#asn-intelligence-get-asn-overview
+func (s *IntelService) ASN(ctx context.Context, accountID string, asn int) (IntelASN, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IntelASN{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if asn <= 0 {
+		return IntelASN{}, errors.New("asn must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/intel/asn/"+strconv.Itoa(asn), nil)
+	if err != nil {
+		return IntelASN{}, err
+	}
+
+	var r IntelASNResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IntelASN{}, fmt.Errorf("failed to unmarshal intel asn JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ASNSubnets returns the subnets announced by an autonomous system.
+//
+// API reference: https://api.cloudflare.com/#asn-intelligence-get-asn-subnets
+func (s *IntelService) ASNSubnets(ctx context.Context, accountID string, asn int) (IntelASNSubnets, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IntelASNSubnets{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if asn <= 0 {
+		return IntelASNSubnets{}, errors.New("asn must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/intel/asn/"+strconv.Itoa(asn)+"/subnets", nil)
+	if err != nil {
+		return IntelASNSubnets{}, err
+	}
+
+	var r IntelASNSubnetsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IntelASNSubnets{}, fmt.Errorf("failed to unmarshal intel asn subnets JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
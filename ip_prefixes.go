@@ -0,0 +1,293 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+type IPPrefixesService service
+
+// IPPrefix is a BYOIP prefix onboarded to an account. Advertised reports
+// whether Cloudflare announces the prefix over BGP; prefixes with
+// OnDemandEnabled can be advertised and withdrawn with
+// UpdateAdvertisementStatus.
+type IPPrefix struct {
+	ID                   string     `json:"id"`
+	AccountID            string     `json:"account_id"`
+	CIDR                 string     `json:"cidr"`
+	Description          string     `json:"description"`
+	ASN                  int        `json:"asn"`
+	Approved             string     `json:"approved"`
+	Advertised           bool       `json:"advertised"`
+	AdvertisedModifiedAt *time.Time `json:"advertised_modified_at,omitempty"`
+	OnDemandEnabled      bool       `json:"on_demand_enabled"`
+	OnDemandLocked       bool       `json:"on_demand_locked"`
+	LOADocumentID        string     `json:"loa_document_id"`
+	CreatedAt            *time.Time `json:"created_at,omitempty"`
+	ModifiedAt           *time.Time `json:"modified_at,omitempty"`
+}
+
+// IPPrefixDelegation allows another account to use part of a prefix, CIDR,
+// in its own address maps.
+type IPPrefixDelegation struct {
+	ID                 string     `json:"id,omitempty"`
+	CIDR               string     `json:"cidr"`
+	DelegatedAccountID string     `json:"delegated_account_id"`
+	ParentPrefixID     string     `json:"parent_prefix_id,omitempty"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	ModifiedAt         *time.Time `json:"modified_at,omitempty"`
+}
+
+// IPPrefixAdvertisementStatus is the BGP advertisement state of a prefix.
+type IPPrefixAdvertisementStatus struct {
+	Advertised           bool       `json:"advertised"`
+	AdvertisedModifiedAt *time.Time `json:"advertised_modified_at,omitempty"`
+}
+
+// IPPrefixResponse represents the response from the prefixes endpoint
+// containing a single prefix.
+type IPPrefixResponse struct {
+	Response
+	Result IPPrefix `json:"result"`
+}
+
+// IPPrefixesResponse represents the response from the prefixes endpoint
+// containing multiple prefixes.
+type IPPrefixesResponse struct {
+	Response
+	Result []IPPrefix `json:"result"`
+}
+
+// IPPrefixDelegationResponse represents the response from the prefix
+// delegations endpoint containing a single delegation.
+type IPPrefixDelegationResponse struct {
+	Response
+	Result IPPrefixDelegation `json:"result"`
+}
+
+// IPPrefixDelegationsResponse represents the response from the prefix
+// delegations endpoint containing multiple delegations.
+type IPPrefixDelegationsResponse struct {
+	Response
+	Result []IPPrefixDelegation `json:"result"`
+}
+
+// IPPrefixAdvertisementStatusResponse represents the response from the
+// prefix BGP status endpoint.
+type IPPrefixAdvertisementStatusResponse struct {
+	Response
+	Result IPPrefixAdvertisementStatus `json:"result"`
+}
+
+// List returns the BYOIP prefixes of an account.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefixes-list-prefixes
+func (s *IPPrefixesService) List(ctx context.Context, accountID string) ([]IPPrefix, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []IPPrefix{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/addressing/prefixes", nil)
+	if err != nil {
+		return []IPPrefix{}, err
+	}
+
+	var r IPPrefixesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []IPPrefix{}, fmt.Errorf("failed to unmarshal ip prefix JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single BYOIP prefix.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefixes-prefix-details
+func (s *IPPrefixesService) Get(ctx context.Context, accountID, prefixID string) (IPPrefix, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IPPrefix{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if prefixID == "" {
+		return IPPrefix{}, fmt.Errorf(errMissingResourceID, "ip prefix")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/addressing/prefixes/"+prefixID, nil)
+	if err != nil {
+		return IPPrefix{}, err
+	}
+
+	var r IPPrefixResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IPPrefix{}, fmt.Errorf("failed to unmarshal ip prefix JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the description of a BYOIP prefix.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefixes-update-prefix-description
+func (s *IPPrefixesService) Update(ctx context.Context, accountID, prefixID, description string) (IPPrefix, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IPPrefix{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if prefixID == "" {
+		return IPPrefix{}, fmt.Errorf(errMissingResourceID, "ip prefix")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/addressing/prefixes/"+prefixID, map[string]string{"description": description})
+	if err != nil {
+		return IPPrefix{}, err
+	}
+
+	var r IPPrefixResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IPPrefix{}, fmt.Errorf("failed to unmarshal ip prefix JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListDelegations returns the delegations of a prefix.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefix-delegation-list-prefix-delegations
+func (s *IPPrefixesService) ListDelegations(ctx context.Context, accountID, prefixID string) ([]IPPrefixDelegation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []IPPrefixDelegation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if prefixID == "" {
+		return []IPPrefixDelegation{}, fmt.Errorf(errMissingResourceID, "ip prefix")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/addressing/prefixes/"+prefixID+"/delegations", nil)
+	if err != nil {
+		return []IPPrefixDelegation{}, err
+	}
+
+	var r IPPrefixDelegationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []IPPrefixDelegation{}, fmt.Errorf("failed to unmarshal ip prefix delegation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateDelegation delegates part of a prefix to another account.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefix-delegation-create-prefix-delegation
+func (s *IPPrefixesService) CreateDelegation(ctx context.Context, accountID, prefixID string, delegation IPPrefixDelegation) (IPPrefixDelegation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IPPrefixDelegation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if prefixID == "" {
+		return IPPrefixDelegation{}, fmt.Errorf(errMissingResourceID, "ip prefix")
+	}
+
+	if _, _, err := net.ParseCIDR(delegation.CIDR); err != nil {
+		return IPPrefixDelegation{}, fmt.Errorf("invalid cidr: %q", delegation.CIDR)
+	}
+
+	if !isValidAccountIdentifier(delegation.DelegatedAccountID) {
+		return IPPrefixDelegation{}, errors.New("delegated account ID must be a valid account identifier")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/addressing/prefixes/"+prefixID+"/delegations", delegation)
+	if err != nil {
+		return IPPrefixDelegation{}, err
+	}
+
+	var r IPPrefixDelegationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IPPrefixDelegation{}, fmt.Errorf("failed to unmarshal ip prefix delegation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteDelegation removes a delegation of a prefix.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefix-delegation-delete-prefix-delegation
+func (s *IPPrefixesService) DeleteDelegation(ctx context.Context, accountID, prefixID, delegationID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if prefixID == "" {
+		return fmt.Errorf(errMissingResourceID, "ip prefix")
+	}
+
+	if delegationID == "" {
+		return fmt.Errorf(errMissingResourceID, "ip prefix delegation")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/addressing/prefixes/"+prefixID+"/delegations/"+delegationID, nil)
+	return err
+}
+
+// GetAdvertisementStatus returns whether a prefix is advertised over BGP.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-dynamic-advertisement-get-advertisement-status
+func (s *IPPrefixesService) GetAdvertisementStatus(ctx context.Context, accountID, prefixID string) (IPPrefixAdvertisementStatus, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IPPrefixAdvertisementStatus{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if prefixID == "" {
+		return IPPrefixAdvertisementStatus{}, fmt.Errorf(errMissingResourceID, "ip prefix")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/addressing/prefixes/"+prefixID+"/bgp/status", nil)
+	if err != nil {
+		return IPPrefixAdvertisementStatus{}, err
+	}
+
+	var r IPPrefixAdvertisementStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IPPrefixAdvertisementStatus{}, fmt.Errorf("failed to unmarshal ip prefix advertisement status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateAdvertisementStatus announces or withdraws a prefix over BGP. The
+// change takes several minutes to propagate and is only available for
+// prefixes with on-demand advertisement enabled.
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-dynamic-advertisement-update-prefix-dynamic-advertisement-status
+func (s *IPPrefixesService) UpdateAdvertisementStatus(ctx context.Context, accountID, prefixID string, advertised bool) (IPPrefixAdvertisementStatus, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return IPPrefixAdvertisementStatus{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if prefixID == "" {
+		return IPPrefixAdvertisementStatus{}, fmt.Errorf(errMissingResourceID, "ip prefix")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/addressing/prefixes/"+prefixID+"/bgp/status", map[string]bool{"advertised": advertised})
+	if err != nil {
+		return IPPrefixAdvertisementStatus{}, err
+	}
+
+	var r IPPrefixAdvertisementStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IPPrefixAdvertisementStatus{}, fmt.Errorf("failed to unmarshal ip prefix advertisement status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,103 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+)
+
+// ipRangesPath is the path of the IP ranges endpoint, the only one that can
+// be called without credentials.
+const ipRangesPath = "/ips"
+
+// IPRanges contains the address ranges Cloudflare connects to origins from.
+// The China ranges are only populated when requested with
+// IPRangesParams.ChinaColo. ETag changes whenever the ranges do.
+type IPRanges struct {
+	IPv4CIDRs      []netip.Prefix
+	IPv6CIDRs      []netip.Prefix
+	ChinaIPv4CIDRs []netip.Prefix
+	ChinaIPv6CIDRs []netip.Prefix
+	ETag           string
+}
+
+// IPRangesParams contains the options for fetching the Cloudflare IP ranges.
+// ChinaColo includes the ranges of the China Network data centers.
+type IPRangesParams struct {
+	ChinaColo bool `url:"china_colo,int,omitempty"`
+}
+
+// IPRangesResponse represents the response from the IP ranges endpoint.
+type IPRangesResponse struct {
+	Response
+	Result struct {
+		IPv4CIDRs      []string `json:"ipv4_cidrs"`
+		IPv6CIDRs      []string `json:"ipv6_cidrs"`
+		ChinaIPv4CIDRs []string `json:"china_ipv4_cidrs"`
+		ChinaIPv6CIDRs []string `json:"china_ipv6_cidrs"`
+		ETag           string   `json:"etag"`
+	} `json:"result"`
+}
+
+// IPs returns the IP ranges used by Cloudflare, for example to allow only
+// Cloudflare to reach an origin. The endpoint does not require
+// authentication so a client without credentials may call it. params is
+// optional and only the first one is used.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-ips-cloudflare-ip-details
+func (c *Client) IPs(ctx context.Context, params ...IPRangesParams) (IPRanges, error) {
+	var p IPRangesParams
+	if len(params) > 0 {
+		p = params[0]
+	}
+
+	res, err := c.Call(ctx, http.MethodGet, buildURI(ipRangesPath, p), nil)
+	if err != nil {
+		return IPRanges{}, err
+	}
+
+	var r IPRangesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return IPRanges{}, fmt.Errorf("failed to unmarshal ip ranges JSON data: %w", err)
+	}
+
+	ranges := IPRanges{ETag: r.Result.ETag}
+	for _, p := range []struct {
+		in  []string
+		out *[]netip.Prefix
+	}{
+		{r.Result.IPv4CIDRs, &ranges.IPv4CIDRs},
+		{r.Result.IPv6CIDRs, &ranges.IPv6CIDRs},
+		{r.Result.ChinaIPv4CIDRs, &ranges.ChinaIPv4CIDRs},
+		{r.Result.ChinaIPv6CIDRs, &ranges.ChinaIPv6CIDRs},
+	} {
+		*p.out, err = parseIPPrefixes(p.in)
+		if err != nil {
+			return IPRanges{}, err
+		}
+	}
+
+	return ranges, nil
+}
+
+// parseIPPrefixes parses CIDR strings into prefixes, returning nil for an
+// empty input.
+func parseIPPrefixes(cidrs []string) ([]netip.Prefix, error) {
+	if len(cidrs) == 0 {
+		return nil, nil
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ip range %q: %w", cidr, err)
+		}
+		prefixes = append(prefixes, prefix)
+	}
+
+	return prefixes, nil
+}
@@ -0,0 +1,52 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIPs_WithoutCredentials(t *testing.T) {
+	client, mux := setup(t)
+	client.Token = ""
+
+	var queries []string
+	mux.HandleFunc("/client/v4/ips", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			t.Errorf("got Authorization %q, want none", auth)
+		}
+		fmt.Fprint(w, `{"success": true, "result": {"ipv4_cidrs": ["173.245.48.0/20"], "ipv6_cidrs": ["2400:cb00::/32"], "etag": "a8e453d9d129a3769407127936edfdb0"}}`)
+	})
+
+	ranges, err := client.IPs(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := IPRanges{
+		IPv4CIDRs: []netip.Prefix{netip.MustParsePrefix("173.245.48.0/20")},
+		IPv6CIDRs: []netip.Prefix{netip.MustParsePrefix("2400:cb00::/32")},
+		ETag:      "a8e453d9d129a3769407127936edfdb0",
+	}
+	if !reflect.DeepEqual(ranges, want) {
+		t.Errorf("got ranges %+v, want %+v", ranges, want)
+	}
+
+	if _, err := client.IPs(context.Background(), IPRangesParams{ChinaColo: true}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := []string{"", "china_colo=1"}; !reflect.DeepEqual(queries, want) {
+		t.Errorf("got queries %q, want %q", queries, want)
+	}
+
+	// Other endpoints still require credentials.
+	_, err = client.Call(context.Background(), http.MethodGet, "/ipsec", nil)
+	if err == nil || !strings.Contains(err.Error(), "no user credentials provided") {
+		t.Errorf("got error %v, want missing credentials", err)
+	}
+}
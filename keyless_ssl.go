@@ -0,0 +1,216 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+type KeylessSSLService service
+
+// KeylessSSLTunnel routes requests to a keyless server through a Cloudflare
+// Tunnel, using the server's address in a tunnel virtual network.
+type KeylessSSLTunnel struct {
+	PrivateIP string `json:"private_ip"`
+	VnetID    string `json:"vnet_id"`
+}
+
+// KeylessSSL represents a Keyless SSL configuration of a zone: a key server
+// performing private key operations for a certificate whose key is not
+// shared with Cloudflare.
+type KeylessSSL struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Host        string            `json:"host"`
+	Port        int               `json:"port"`
+	Status      string            `json:"status"`
+	Enabled     bool              `json:"enabled"`
+	Permissions []string          `json:"permissions"`
+	Tunnel      *KeylessSSLTunnel `json:"tunnel,omitempty"`
+	CreatedOn   time.Time         `json:"created_on"`
+	ModifiedOn  time.Time         `json:"modified_on"`
+}
+
+// KeylessSSLCreateParams contains the certificate and key server of a new
+// Keyless SSL configuration. Certificate is the PEM encoded certificate whose
+// private key is held by the key server at Host and Port. Port defaults to
+// 24008 when zero. When Tunnel is set Host must still be provided and is used
+// as the SNI of the key server.
+type KeylessSSLCreateParams struct {
+	Name         string                        `json:"name,omitempty"`
+	Host         string                        `json:"host"`
+	Port         int                           `json:"port,omitempty"`
+	Certificate  string                        `json:"certificate"`
+	BundleMethod CustomCertificateBundleMethod `json:"bundle_method,omitempty"`
+	Tunnel       *KeylessSSLTunnel             `json:"tunnel,omitempty"`
+}
+
+// KeylessSSLUpdateParams contains the fields of a Keyless SSL configuration
+// to change. Fields left empty are not changed.
+type KeylessSSLUpdateParams struct {
+	Name    string            `json:"name,omitempty"`
+	Host    string            `json:"host,omitempty"`
+	Port    int               `json:"port,omitempty"`
+	Enabled *bool             `json:"enabled,omitempty"`
+	Tunnel  *KeylessSSLTunnel `json:"tunnel,omitempty"`
+}
+
+// KeylessSSLResponse represents the response from the keyless certificates
+// endpoint containing a single configuration.
+type KeylessSSLResponse struct {
+	Response
+	Result KeylessSSL `json:"result"`
+}
+
+// KeylessSSLsResponse represents the response from the keyless certificates
+// endpoint containing multiple configurations.
+type KeylessSSLsResponse struct {
+	Response
+	Result []KeylessSSL `json:"result"`
+}
+
+// List returns the Keyless SSL configurations of a zone.
+//
+// API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-list-keyless-ssl-configurations
+func (s *KeylessSSLService) List(ctx context.Context, zoneID string) ([]KeylessSSL, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []KeylessSSL{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/keyless_certificates", nil)
+	if err != nil {
+		return []KeylessSSL{}, err
+	}
+
+	var r KeylessSSLsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []KeylessSSL{}, fmt.Errorf("failed to unmarshal keyless ssl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single Keyless SSL configuration.
+//
+// API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-get-keyless-ssl-configuration
+func (s *KeylessSSLService) Get(ctx context.Context, zoneID, keylessID string) (KeylessSSL, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return KeylessSSL{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if keylessID == "" {
+		return KeylessSSL{}, fmt.Errorf(errMissingResourceID, "keyless ssl")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/keyless_certificates/"+keylessID, nil)
+	if err != nil {
+		return KeylessSSL{}, err
+	}
+
+	var r KeylessSSLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return KeylessSSL{}, fmt.Errorf("failed to unmarshal keyless ssl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create adds a Keyless SSL configuration to a zone.
+//
+// API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-create-keyless-ssl-configuration
+func (s *KeylessSSLService) Create(ctx context.Context, zoneID string, params KeylessSSLCreateParams) (KeylessSSL, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return KeylessSSL{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.Host == "" || params.Certificate == "" {
+		return KeylessSSL{}, errors.New("host and certificate must be provided")
+	}
+
+	if err := validateKeylessSSLTunnel(params.Tunnel); err != nil {
+		return KeylessSSL{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/keyless_certificates", params)
+	if err != nil {
+		return KeylessSSL{}, err
+	}
+
+	var r KeylessSSLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return KeylessSSL{}, fmt.Errorf("failed to unmarshal keyless ssl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes a Keyless SSL configuration.
+//
+// API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-edit-keyless-ssl-configuration
+func (s *KeylessSSLService) Update(ctx context.Context, zoneID, keylessID string, params KeylessSSLUpdateParams) (KeylessSSL, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return KeylessSSL{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if keylessID == "" {
+		return KeylessSSL{}, fmt.Errorf(errMissingResourceID, "keyless ssl")
+	}
+
+	if err := validateKeylessSSLTunnel(params.Tunnel); err != nil {
+		return KeylessSSL{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/keyless_certificates/"+keylessID, params)
+	if err != nil {
+		return KeylessSSL{}, err
+	}
+
+	var r KeylessSSLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return KeylessSSL{}, fmt.Errorf("failed to unmarshal keyless ssl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a Keyless SSL configuration.
+//
+// API reference: https://api.cloudflare.com/#keyless-ssl-for-a-zone-delete-keyless-ssl-configuration
+func (s *KeylessSSLService) Delete(ctx context.Context, zoneID, keylessID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if keylessID == "" {
+		return fmt.Errorf(errMissingResourceID, "keyless ssl")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/keyless_certificates/"+keylessID, nil)
+	return err
+}
+
+// validateKeylessSSLTunnel checks that a tunnel, when set, names both the
+// key server's private address and its virtual network.
+func validateKeylessSSLTunnel(tunnel *KeylessSSLTunnel) error {
+	if tunnel == nil {
+		return nil
+	}
+
+	if tunnel.PrivateIP == "" || tunnel.VnetID == "" {
+		return errors.New("tunnel private IP and virtual network ID must be provided")
+	}
+
+	if net.ParseIP(tunnel.PrivateIP) == nil {
+		return fmt.Errorf("invalid tunnel private IP: %q", tunnel.PrivateIP)
+	}
+
+	return nil
+}
@@ -0,0 +1,516 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type ListsService service
+
+// defaultListBulkOperationPollInterval is how often WaitForBulkOperation
+// checks on an operation when no interval is given.
+const defaultListBulkOperationPollInterval = time.Second
+
+// ListKind is the type of item a list holds.
+type ListKind string
+
+const (
+	ListKindIP       ListKind = "ip"
+	ListKindRedirect ListKind = "redirect"
+	ListKindHostname ListKind = "hostname"
+	ListKindASN      ListKind = "asn"
+)
+
+// ListBulkOperationStatus is the state of an asynchronous list item
+// operation.
+type ListBulkOperationStatus string
+
+const (
+	ListBulkOperationStatusPending   ListBulkOperationStatus = "pending"
+	ListBulkOperationStatusRunning   ListBulkOperationStatus = "running"
+	ListBulkOperationStatusCompleted ListBulkOperationStatus = "completed"
+	ListBulkOperationStatusFailed    ListBulkOperationStatus = "failed"
+)
+
+// List is an account level list of IPs, redirects, hostnames or ASNs that
+// can be referenced from rule expressions as $name.
+type List struct {
+	ID                    string     `json:"id,omitempty"`
+	Name                  string     `json:"name"`
+	Description           string     `json:"description,omitempty"`
+	Kind                  ListKind   `json:"kind"`
+	NumItems              int        `json:"num_items,omitempty"`
+	NumReferencingFilters int        `json:"num_referencing_filters,omitempty"`
+	CreatedOn             *time.Time `json:"created_on,omitempty"`
+	ModifiedOn            *time.Time `json:"modified_on,omitempty"`
+}
+
+// ListItem is an entry of a list. Exactly one of IP, Redirect, Hostname and
+// ASN is set, matching the kind of the list.
+type ListItem struct {
+	ID         string            `json:"id,omitempty"`
+	IP         string            `json:"ip,omitempty"`
+	Redirect   *ListItemRedirect `json:"redirect,omitempty"`
+	Hostname   *ListItemHostname `json:"hostname,omitempty"`
+	ASN        *int              `json:"asn,omitempty"`
+	Comment    string            `json:"comment,omitempty"`
+	CreatedOn  *time.Time        `json:"created_on,omitempty"`
+	ModifiedOn *time.Time        `json:"modified_on,omitempty"`
+}
+
+// ListItemRedirect is a redirect from SourceURL to TargetURL. StatusCode
+// defaults to 301.
+type ListItemRedirect struct {
+	SourceURL           string `json:"source_url"`
+	TargetURL           string `json:"target_url"`
+	StatusCode          int    `json:"status_code,omitempty"`
+	IncludeSubdomains   *bool  `json:"include_subdomains,omitempty"`
+	SubpathMatching     *bool  `json:"subpath_matching,omitempty"`
+	PreserveQueryString *bool  `json:"preserve_query_string,omitempty"`
+	PreservePathSuffix  *bool  `json:"preserve_path_suffix,omitempty"`
+}
+
+// ListItemHostname is a hostname entry. A leading "*." matches subdomains.
+type ListItemHostname struct {
+	URLHostname string `json:"url_hostname"`
+}
+
+// ListUpdateParams contains the fields of a list that can be changed after it
+// is created.
+type ListUpdateParams struct {
+	Description string `json:"description"`
+}
+
+// ListItemListParams contains the options available when listing the items
+// of a list. Search filters items by a substring of their value or comment.
+type ListItemListParams struct {
+	Search  string `url:"search,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
+
+	CursorPaginationParams
+}
+
+// ListBulkOperation reports the progress of an asynchronous list item
+// operation. Error is set when Status is failed.
+type ListBulkOperation struct {
+	ID        string                  `json:"id"`
+	Status    ListBulkOperationStatus `json:"status"`
+	Error     string                  `json:"error,omitempty"`
+	Completed *time.Time              `json:"completed,omitempty"`
+}
+
+// listItemIDsParams is the request body of the delete items endpoint.
+type listItemIDsParams struct {
+	Items []listItemID `json:"items"`
+}
+
+type listItemID struct {
+	ID string `json:"id"`
+}
+
+// ListResponse represents the response from the lists endpoint containing a
+// single list.
+type ListResponse struct {
+	Response
+	Result List `json:"result"`
+}
+
+// ListsResponse represents the response from the lists endpoint containing
+// multiple lists.
+type ListsResponse struct {
+	Response
+	Result []List `json:"result"`
+}
+
+// ListItemResponse represents the response from the list items endpoint
+// containing a single item.
+type ListItemResponse struct {
+	Response
+	Result ListItem `json:"result"`
+}
+
+// ListItemsResponse represents the response from the list items endpoint
+// containing multiple items.
+type ListItemsResponse struct {
+	Response
+	Result     []ListItem `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// ListBulkOperationIDResponse represents the response from the endpoints
+// that start an asynchronous list item operation.
+type ListBulkOperationIDResponse struct {
+	Response
+	Result struct {
+		OperationID string `json:"operation_id"`
+	} `json:"result"`
+}
+
+// ListBulkOperationResponse represents the response from the bulk operation
+// status endpoint.
+type ListBulkOperationResponse struct {
+	Response
+	Result ListBulkOperation `json:"result"`
+}
+
+// List returns the lists of an account.
+//
+// API reference: https://api.cloudflare.com/#lists-get-lists
+func (s *ListsService) List(ctx context.Context, accountID string) ([]List, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []List{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/rules/lists", nil)
+	if err != nil {
+		return []List{}, err
+	}
+
+	var r ListsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []List{}, fmt.Errorf("failed to unmarshal list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single list.
+//
+// API reference: https://api.cloudflare.com/#lists-get-a-list
+func (s *ListsService) Get(ctx context.Context, accountID, listID string) (List, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return List{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return List{}, fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/rules/lists/"+listID, nil)
+	if err != nil {
+		return List{}, err
+	}
+
+	var r ListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return List{}, fmt.Errorf("failed to unmarshal list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates an empty list. The name and kind of a list cannot be
+// changed later.
+//
+// API reference: https://api.cloudflare.com/#lists-create-a-list
+func (s *ListsService) Create(ctx context.Context, accountID string, list List) (List, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return List{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if list.Name == "" || list.Kind == "" {
+		return List{}, errors.New("name and kind must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/rules/lists", list)
+	if err != nil {
+		return List{}, err
+	}
+
+	var r ListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return List{}, fmt.Errorf("failed to unmarshal list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the description of a list.
+//
+// API reference: https://api.cloudflare.com/#lists-update-a-list
+func (s *ListsService) Update(ctx context.Context, accountID, listID string, params ListUpdateParams) (List, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return List{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return List{}, fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/rules/lists/"+listID, params)
+	if err != nil {
+		return List{}, err
+	}
+
+	var r ListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return List{}, fmt.Errorf("failed to unmarshal list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a list. Lists referenced by rules cannot be deleted.
+//
+// API reference: https://api.cloudflare.com/#lists-delete-a-list
+func (s *ListsService) Delete(ctx context.Context, accountID, listID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/rules/lists/"+listID, nil)
+	return err
+}
+
+// ListItems returns the items of a list.
+//
+// Unless a cursor is provided, every item is fetched by following the cursors
+// returned by the API, PerPage items at a time. Otherwise a single page is
+// fetched and the returned ResultInfo.Cursor can be passed back in
+// `ListItemListParams` to retrieve the next page.
+//
+// API reference: https://api.cloudflare.com/#lists-get-list-items
+func (s *ListsService) ListItems(ctx context.Context, accountID, listID string, params ListItemListParams) ([]ListItem, ResultInfo, error) {
+	var items []ListItem
+	var info ResultInfo
+	err := s.ForEachItemsPage(ctx, accountID, listID, params, func(page []ListItem, pageInfo ResultInfo) bool {
+		items = append(items, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []ListItem{}, ResultInfo{}, err
+	}
+
+	return items, info, nil
+}
+
+// ForEachItemsPage is like ListItems but passes each page of results to fn as
+// it is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#lists-get-list-items
+func (s *ListsService) ForEachItemsPage(ctx context.Context, accountID, listID string, params ListItemListParams, fn func(items []ListItem, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	return s.client.listCursorPages(ctx, "/accounts/"+accountID+"/rules/lists/"+listID+"/items", "cursor", &params, &params.CursorPaginationParams, func(res []byte) (ResultInfo, error) {
+		var r ListItemsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal list item JSON data: %w", err)
+		}
+		// The endpoint returns its cursor in Cursors.After; expose it as Cursor
+		// like the other cursor paginated lists.
+		r.ResultInfo.Cursor = r.ResultInfo.Cursors.After
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetItem fetches a single list item.
+//
+// API reference: https://api.cloudflare.com/#lists-get-a-list-item
+func (s *ListsService) GetItem(ctx context.Context, accountID, listID, itemID string) (ListItem, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return ListItem{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return ListItem{}, fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	if itemID == "" {
+		return ListItem{}, fmt.Errorf(errMissingResourceID, "list item")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/rules/lists/"+listID+"/items/"+itemID, nil)
+	if err != nil {
+		return ListItem{}, err
+	}
+
+	var r ListItemResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ListItem{}, fmt.Errorf("failed to unmarshal list item JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateItems appends items to a list. The items are added asynchronously;
+// the returned operation ID can be passed to WaitForBulkOperation.
+//
+// API reference: https://api.cloudflare.com/#lists-create-list-items
+func (s *ListsService) CreateItems(ctx context.Context, accountID, listID string, items []ListItem) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	if len(items) == 0 {
+		return "", errors.New("at least one item must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/rules/lists/"+listID+"/items", items)
+	if err != nil {
+		return "", err
+	}
+
+	var r ListBulkOperationIDResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal list bulk operation JSON data: %w", err)
+	}
+
+	return r.Result.OperationID, nil
+}
+
+// ReplaceItems replaces every item of a list. The items are replaced
+// asynchronously; the returned operation ID can be passed to
+// WaitForBulkOperation.
+//
+// API reference: https://api.cloudflare.com/#lists-replace-list-items
+func (s *ListsService) ReplaceItems(ctx context.Context, accountID, listID string, items []ListItem) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	if items == nil {
+		items = []ListItem{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/rules/lists/"+listID+"/items", items)
+	if err != nil {
+		return "", err
+	}
+
+	var r ListBulkOperationIDResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal list bulk operation JSON data: %w", err)
+	}
+
+	return r.Result.OperationID, nil
+}
+
+// DeleteItems removes items from a list by ID. The items are removed
+// asynchronously; the returned operation ID can be passed to
+// WaitForBulkOperation.
+//
+// API reference: https://api.cloudflare.com/#lists-delete-list-items
+func (s *ListsService) DeleteItems(ctx context.Context, accountID, listID string, itemIDs ...string) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if listID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "list")
+	}
+
+	if len(itemIDs) == 0 {
+		return "", errors.New("at least one item ID must be provided")
+	}
+
+	params := listItemIDsParams{Items: make([]listItemID, 0, len(itemIDs))}
+	for _, id := range itemIDs {
+		params.Items = append(params.Items, listItemID{ID: id})
+	}
+
+	res, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/rules/lists/"+listID+"/items", params)
+	if err != nil {
+		return "", err
+	}
+
+	var r ListBulkOperationIDResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal list bulk operation JSON data: %w", err)
+	}
+
+	return r.Result.OperationID, nil
+}
+
+// GetBulkOperation reports the progress of an asynchronous list item
+// operation.
+//
+// API reference: https://api.cloudflare.com/#lists-get-bulk-operation-status
+func (s *ListsService) GetBulkOperation(ctx context.Context, accountID, operationID string) (ListBulkOperation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return ListBulkOperation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if operationID == "" {
+		return ListBulkOperation{}, fmt.Errorf(errMissingResourceID, "list bulk operation")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/rules/lists/bulk_operations/"+operationID, nil)
+	if err != nil {
+		return ListBulkOperation{}, err
+	}
+
+	var r ListBulkOperationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ListBulkOperation{}, fmt.Errorf("failed to unmarshal list bulk operation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// WaitForBulkOperation polls an asynchronous list item operation every
+// interval until it completes, fails or ctx is done. A failed operation is
+// returned along with an error holding its reason.
+func (s *ListsService) WaitForBulkOperation(ctx context.Context, accountID, operationID string, interval time.Duration) (ListBulkOperation, error) {
+	if interval <= 0 {
+		interval = defaultListBulkOperationPollInterval
+	}
+
+	for {
+		op, err := s.GetBulkOperation(ctx, accountID, operationID)
+		if err != nil {
+			return ListBulkOperation{}, err
+		}
+
+		switch op.Status {
+		case ListBulkOperationStatusCompleted:
+			return op, nil
+		case ListBulkOperationStatusFailed:
+			return op, fmt.Errorf("list bulk operation %s failed: %s", operationID, op.Error)
+		case ListBulkOperationStatusPending, ListBulkOperationStatusRunning:
+		default:
+			return op, fmt.Errorf("%s: %q", errOperationUnexpectedStatus, op.Status)
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return op, fmt.Errorf("%s: %w", errOperationStillRunning, ctx.Err())
+		}
+	}
+}
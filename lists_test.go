@@ -0,0 +1,67 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// bulkOperationHandler answers with each of statuses in turn, repeating the
+// last one.
+func bulkOperationHandler(statuses ...string) (http.HandlerFunc, *int) {
+	polls := 0
+	return func(w http.ResponseWriter, r *http.Request) {
+		status := statuses[len(statuses)-1]
+		if polls < len(statuses) {
+			status = statuses[polls]
+		}
+		polls++
+
+		errMsg := ""
+		if status == "failed" {
+			errMsg = "list is full"
+		}
+		fmt.Fprintf(w, `{"success": true, "result": {"id": "4da8780eeb215e6cb7f48dd981c4ea02", "status": %q, "error": %q}}`, status, errMsg)
+	}, &polls
+}
+
+func TestWaitForBulkOperation_Completed(t *testing.T) {
+	client, mux := setup(t)
+
+	handler, polls := bulkOperationHandler("pending", "running", "completed")
+	mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", handler)
+
+	op, err := client.Lists.WaitForBulkOperation(context.Background(), testAccountID, "4da8780eeb215e6cb7f48dd981c4ea02", time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if op.Status != ListBulkOperationStatusCompleted {
+		t.Errorf("got status %q, want completed", op.Status)
+	}
+	if *polls != 3 {
+		t.Errorf("got %d polls, want 3", *polls)
+	}
+}
+
+func TestWaitForBulkOperation_Failed(t *testing.T) {
+	client, mux := setup(t)
+
+	handler, polls := bulkOperationHandler("pending", "failed")
+	mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", handler)
+
+	op, err := client.Lists.WaitForBulkOperation(context.Background(), testAccountID, "4da8780eeb215e6cb7f48dd981c4ea02", time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "list is full") {
+		t.Fatalf("got error %v, want the failure reason", err)
+	}
+
+	if op.Status != ListBulkOperationStatusFailed {
+		t.Errorf("got status %q, want failed", op.Status)
+	}
+	if *polls != 2 {
+		t.Errorf("got %d polls, want 2", *polls)
+	}
+}
@@ -0,0 +1,540 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// LoadBalancerPool is a group of origins that load balancers steer traffic
+// to.
+type LoadBalancerPool struct {
+	ID                 string                          `json:"id,omitempty"`
+	CreatedOn          *time.Time                      `json:"created_on,omitempty"`
+	ModifiedOn         *time.Time                      `json:"modified_on,omitempty"`
+	Name               string                          `json:"name"`
+	Description        string                          `json:"description"`
+	Enabled            bool                            `json:"enabled"`
+	MinimumOrigins     *int                            `json:"minimum_origins,omitempty"`
+	Monitor            string                          `json:"monitor,omitempty"`
+	Origins            []LoadBalancerOrigin            `json:"origins"`
+	NotificationEmail  string                          `json:"notification_email,omitempty"`
+	NotificationFilter *LoadBalancerNotificationFilter `json:"notification_filter,omitempty"`
+	Latitude           *float32                        `json:"latitude,omitempty"`
+	Longitude          *float32                        `json:"longitude,omitempty"`
+	LoadShedding       *LoadBalancerLoadShedding       `json:"load_shedding,omitempty"`
+	OriginSteering     *LoadBalancerOriginSteering     `json:"origin_steering,omitempty"`
+	CheckRegions       []string                        `json:"check_regions"`
+	Healthy            *bool                           `json:"healthy,omitempty"`
+}
+
+// LoadBalancerOrigin is a single origin server within a pool.
+type LoadBalancerOrigin struct {
+	Name             string              `json:"name"`
+	Address          string              `json:"address"`
+	Enabled          bool                `json:"enabled"`
+	Weight           float64             `json:"weight"`
+	Header           map[string][]string `json:"header,omitempty"`
+	VirtualNetworkID string              `json:"virtual_network_id,omitempty"`
+}
+
+// LoadBalancerNotificationFilter limits health notifications to the origins
+// or pools whose health changes in the selected direction.
+type LoadBalancerNotificationFilter struct {
+	Origin *LoadBalancerNotificationFilterRule `json:"origin,omitempty"`
+	Pool   *LoadBalancerNotificationFilterRule `json:"pool,omitempty"`
+}
+
+// LoadBalancerNotificationFilterRule disables or narrows notifications.
+// Healthy set to nil notifies on every change.
+type LoadBalancerNotificationFilterRule struct {
+	Disable bool  `json:"disable,omitempty"`
+	Healthy *bool `json:"healthy"`
+}
+
+// LoadBalancerLoadShedding sheds a percentage of traffic away from a pool.
+type LoadBalancerLoadShedding struct {
+	DefaultPercent float32 `json:"default_percent,omitempty"`
+	DefaultPolicy  string  `json:"default_policy,omitempty"`
+	SessionPercent float32 `json:"session_percent,omitempty"`
+	SessionPolicy  string  `json:"session_policy,omitempty"`
+}
+
+// LoadBalancerOriginSteering controls how traffic is spread across the
+// origins of a pool.
+type LoadBalancerOriginSteering struct {
+	Policy string `json:"policy,omitempty"`
+}
+
+// LoadBalancerMonitor is a health check attached to pools.
+type LoadBalancerMonitor struct {
+	ID              string              `json:"id,omitempty"`
+	CreatedOn       *time.Time          `json:"created_on,omitempty"`
+	ModifiedOn      *time.Time          `json:"modified_on,omitempty"`
+	Type            string              `json:"type"`
+	Description     string              `json:"description"`
+	Method          string              `json:"method,omitempty"`
+	Path            string              `json:"path,omitempty"`
+	Header          map[string][]string `json:"header,omitempty"`
+	Timeout         int                 `json:"timeout"`
+	Retries         int                 `json:"retries"`
+	Interval        int                 `json:"interval"`
+	ConsecutiveUp   int                 `json:"consecutive_up"`
+	ConsecutiveDown int                 `json:"consecutive_down"`
+	Port            uint16              `json:"port,omitempty"`
+	ExpectedBody    string              `json:"expected_body"`
+	ExpectedCodes   string              `json:"expected_codes,omitempty"`
+	FollowRedirects bool                `json:"follow_redirects"`
+	AllowInsecure   bool                `json:"allow_insecure"`
+	ProbeZone       string              `json:"probe_zone"`
+}
+
+// LoadBalancerPoolHealth is the health of a pool as seen from each
+// Cloudflare PoP running health checks.
+type LoadBalancerPoolHealth struct {
+	ID        string                               `json:"pool_id,omitempty"`
+	PopHealth map[string]LoadBalancerPoolPopHealth `json:"pop_health,omitempty"`
+}
+
+// LoadBalancerPoolPopHealth is the health of a pool and its origins from a
+// single PoP.
+type LoadBalancerPoolPopHealth struct {
+	Healthy bool                                  `json:"healthy,omitempty"`
+	Origins []map[string]LoadBalancerOriginHealth `json:"origins,omitempty"`
+}
+
+// LoadBalancerOriginHealth is the result of the latest health check of an
+// origin.
+type LoadBalancerOriginHealth struct {
+	Healthy       bool   `json:"healthy,omitempty"`
+	RTT           string `json:"rtt,omitempty"`
+	FailureReason string `json:"failure_reason,omitempty"`
+	ResponseCode  int    `json:"response_code,omitempty"`
+}
+
+// LoadBalancerPreview is a pending preview of a monitor against a pool.
+type LoadBalancerPreview struct {
+	ID    string            `json:"preview_id"`
+	Pools map[string]string `json:"pools"`
+}
+
+// LoadBalancerPreviewPoolResult is the outcome of a preview for one pool,
+// keyed by origin address.
+type LoadBalancerPreviewPoolResult struct {
+	Healthy bool                                  `json:"healthy"`
+	Origins []map[string]LoadBalancerOriginHealth `json:"origins"`
+}
+
+// LoadBalancerPoolResponse represents the response from the pools endpoint
+// containing a single pool.
+type LoadBalancerPoolResponse struct {
+	Response
+	Result LoadBalancerPool `json:"result"`
+}
+
+// LoadBalancerPoolsResponse represents the response from the pools endpoint
+// containing multiple pools.
+type LoadBalancerPoolsResponse struct {
+	Response
+	Result     []LoadBalancerPool `json:"result"`
+	ResultInfo ResultInfo         `json:"result_info"`
+}
+
+// LoadBalancerMonitorResponse represents the response from the monitors
+// endpoint containing a single monitor.
+type LoadBalancerMonitorResponse struct {
+	Response
+	Result LoadBalancerMonitor `json:"result"`
+}
+
+// LoadBalancerMonitorsResponse represents the response from the monitors
+// endpoint containing multiple monitors.
+type LoadBalancerMonitorsResponse struct {
+	Response
+	Result     []LoadBalancerMonitor `json:"result"`
+	ResultInfo ResultInfo            `json:"result_info"`
+}
+
+// LoadBalancerPoolHealthResponse represents the response from the pool
+// health endpoint.
+type LoadBalancerPoolHealthResponse struct {
+	Response
+	Result LoadBalancerPoolHealth `json:"result"`
+}
+
+// LoadBalancerPreviewResponse represents the response from starting a
+// preview.
+type LoadBalancerPreviewResponse struct {
+	Response
+	Result LoadBalancerPreview `json:"result"`
+}
+
+// LoadBalancerPreviewResultResponse represents the response from the preview
+// results endpoint, keyed by pool ID.
+type LoadBalancerPreviewResultResponse struct {
+	Response
+	Result map[string]LoadBalancerPreviewPoolResult `json:"result"`
+}
+
+// LoadBalancerPoolListParams contains the filters available when listing
+// pools.
+type LoadBalancerPoolListParams struct {
+	// Monitor only returns the pools using the given monitor ID.
+	Monitor string `url:"monitor,omitempty"`
+
+	PaginationParams
+}
+
+// ListPools returns the load balancer pools of an account. Every page is
+// fetched unless params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-list-pools
+func (s *LoadBalancersService) ListPools(ctx context.Context, accountID string, params LoadBalancerPoolListParams) ([]LoadBalancerPool, ResultInfo, error) {
+	var pools []LoadBalancerPool
+	var info ResultInfo
+	err := s.ForEachPoolsPage(ctx, accountID, params, func(page []LoadBalancerPool, pageInfo ResultInfo) bool {
+		pools = append(pools, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []LoadBalancerPool{}, ResultInfo{}, err
+	}
+
+	return pools, info, nil
+}
+
+// ForEachPoolsPage is like ListPools but passes each page of results to fn as
+// it is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-list-pools
+func (s *LoadBalancersService) ForEachPoolsPage(ctx context.Context, accountID string, params LoadBalancerPoolListParams, fn func(items []LoadBalancerPool, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/load_balancers/pools", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r LoadBalancerPoolsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal load balancer pool JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetPool fetches a single load balancer pool.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-pool-details
+func (s *LoadBalancersService) GetPool(ctx context.Context, accountID, poolID string) (LoadBalancerPool, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerPool{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if poolID == "" {
+		return LoadBalancerPool{}, fmt.Errorf(errMissingResourceID, "pool")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/load_balancers/pools/"+poolID, nil)
+	if err != nil {
+		return LoadBalancerPool{}, err
+	}
+
+	var r LoadBalancerPoolResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerPool{}, fmt.Errorf("failed to unmarshal load balancer pool JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreatePool creates a new load balancer pool.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-create-pool
+func (s *LoadBalancersService) CreatePool(ctx context.Context, accountID string, pool LoadBalancerPool) (LoadBalancerPool, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerPool{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/load_balancers/pools", pool)
+	if err != nil {
+		return LoadBalancerPool{}, err
+	}
+
+	var r LoadBalancerPoolResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerPool{}, fmt.Errorf("failed to unmarshal load balancer pool JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdatePool replaces the configuration of an existing pool.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-update-pool
+func (s *LoadBalancersService) UpdatePool(ctx context.Context, accountID, poolID string, pool LoadBalancerPool) (LoadBalancerPool, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerPool{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if poolID == "" {
+		return LoadBalancerPool{}, fmt.Errorf(errMissingResourceID, "pool")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/load_balancers/pools/"+poolID, pool)
+	if err != nil {
+		return LoadBalancerPool{}, err
+	}
+
+	var r LoadBalancerPoolResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerPool{}, fmt.Errorf("failed to unmarshal load balancer pool JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeletePool removes a load balancer pool. Pools still referenced by a load
+// balancer cannot be deleted.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-delete-pool
+func (s *LoadBalancersService) DeletePool(ctx context.Context, accountID, poolID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if poolID == "" {
+		return fmt.Errorf(errMissingResourceID, "pool")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/load_balancers/pools/"+poolID, nil)
+	return err
+}
+
+// GetPoolHealth returns the latest health check results of a pool and its
+// origins from each PoP.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-pool-health-details
+func (s *LoadBalancersService) GetPoolHealth(ctx context.Context, accountID, poolID string) (LoadBalancerPoolHealth, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerPoolHealth{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if poolID == "" {
+		return LoadBalancerPoolHealth{}, fmt.Errorf(errMissingResourceID, "pool")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/load_balancers/pools/"+poolID+"/health", nil)
+	if err != nil {
+		return LoadBalancerPoolHealth{}, err
+	}
+
+	var r LoadBalancerPoolHealthResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerPoolHealth{}, fmt.Errorf("failed to unmarshal load balancer pool health JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// PreviewPool runs a monitor against a pool without saving either. The
+// results are fetched with GetPreview using the returned preview ID.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-pools-preview-pool
+func (s *LoadBalancersService) PreviewPool(ctx context.Context, accountID, poolID string, monitor LoadBalancerMonitor) (LoadBalancerPreview, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerPreview{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if poolID == "" {
+		return LoadBalancerPreview{}, fmt.Errorf(errMissingResourceID, "pool")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/load_balancers/pools/"+poolID+"/preview", monitor)
+	if err != nil {
+		return LoadBalancerPreview{}, err
+	}
+
+	var r LoadBalancerPreviewResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerPreview{}, fmt.Errorf("failed to unmarshal load balancer preview JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetPreview returns the results of a preview started with PreviewPool or
+// PreviewMonitor, keyed by pool ID.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-monitors-preview-result
+func (s *LoadBalancersService) GetPreview(ctx context.Context, accountID, previewID string) (map[string]LoadBalancerPreviewPoolResult, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if previewID == "" {
+		return nil, fmt.Errorf(errMissingResourceID, "preview")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/load_balancers/preview/"+previewID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r LoadBalancerPreviewResultResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal load balancer preview JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListMonitors returns the load balancer monitors of an account.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-monitors-list-monitors
+func (s *LoadBalancersService) ListMonitors(ctx context.Context, accountID string) ([]LoadBalancerMonitor, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []LoadBalancerMonitor{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/load_balancers/monitors", nil)
+	if err != nil {
+		return []LoadBalancerMonitor{}, err
+	}
+
+	var r LoadBalancerMonitorsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []LoadBalancerMonitor{}, fmt.Errorf("failed to unmarshal load balancer monitor JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetMonitor fetches a single load balancer monitor.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-monitors-monitor-details
+func (s *LoadBalancersService) GetMonitor(ctx context.Context, accountID, monitorID string) (LoadBalancerMonitor, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerMonitor{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if monitorID == "" {
+		return LoadBalancerMonitor{}, fmt.Errorf(errMissingResourceID, "monitor")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/load_balancers/monitors/"+monitorID, nil)
+	if err != nil {
+		return LoadBalancerMonitor{}, err
+	}
+
+	var r LoadBalancerMonitorResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerMonitor{}, fmt.Errorf("failed to unmarshal load balancer monitor JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateMonitor creates a new load balancer monitor.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-monitors-create-monitor
+func (s *LoadBalancersService) CreateMonitor(ctx context.Context, accountID string, monitor LoadBalancerMonitor) (LoadBalancerMonitor, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerMonitor{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/load_balancers/monitors", monitor)
+	if err != nil {
+		return LoadBalancerMonitor{}, err
+	}
+
+	var r LoadBalancerMonitorResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerMonitor{}, fmt.Errorf("failed to unmarshal load balancer monitor JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateMonitor replaces the configuration of an existing monitor.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-monitors-update-monitor
+func (s *LoadBalancersService) UpdateMonitor(ctx context.Context, accountID, monitorID string, monitor LoadBalancerMonitor) (LoadBalancerMonitor, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerMonitor{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if monitorID == "" {
+		return LoadBalancerMonitor{}, fmt.Errorf(errMissingResourceID, "monitor")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/load_balancers/monitors/"+monitorID, monitor)
+	if err != nil {
+		return LoadBalancerMonitor{}, err
+	}
+
+	var r LoadBalancerMonitorResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerMonitor{}, fmt.Errorf("failed to unmarshal load balancer monitor JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteMonitor removes a load balancer monitor.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-monitors-delete-monitor
+func (s *LoadBalancersService) DeleteMonitor(ctx context.Context, accountID, monitorID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if monitorID == "" {
+		return fmt.Errorf(errMissingResourceID, "monitor")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/load_balancers/monitors/"+monitorID, nil)
+	return err
+}
+
+// PreviewMonitor runs an existing monitor with the given changes applied
+// against the pools using it, without saving the changes.
+//
+// API reference: https://api.cloudflare.com/#account-load-balancer-monitors-preview-monitor
+func (s *LoadBalancersService) PreviewMonitor(ctx context.Context, accountID, monitorID string, monitor LoadBalancerMonitor) (LoadBalancerPreview, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return LoadBalancerPreview{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if monitorID == "" {
+		return LoadBalancerPreview{}, fmt.Errorf(errMissingResourceID, "monitor")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/load_balancers/monitors/"+monitorID+"/preview", monitor)
+	if err != nil {
+		return LoadBalancerPreview{}, err
+	}
+
+	var r LoadBalancerPreviewResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancerPreview{}, fmt.Errorf("failed to unmarshal load balancer preview JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,258 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type LoadBalancersService service
+
+// LoadBalancerSteeringPolicy controls how traffic is distributed across the
+// pools of a load balancer.
+type LoadBalancerSteeringPolicy string
+
+const (
+	LoadBalancerSteeringPolicyOff                      LoadBalancerSteeringPolicy = "off"
+	LoadBalancerSteeringPolicyGeo                      LoadBalancerSteeringPolicy = "geo"
+	LoadBalancerSteeringPolicyRandom                   LoadBalancerSteeringPolicy = "random"
+	LoadBalancerSteeringPolicyDynamicLatency           LoadBalancerSteeringPolicy = "dynamic_latency"
+	LoadBalancerSteeringPolicyProximity                LoadBalancerSteeringPolicy = "proximity"
+	LoadBalancerSteeringPolicyLeastOutstandingRequests LoadBalancerSteeringPolicy = "least_outstanding_requests"
+	LoadBalancerSteeringPolicyLeastConnections         LoadBalancerSteeringPolicy = "least_connections"
+)
+
+// LoadBalancerSessionAffinity controls how visitors are pinned to an origin.
+type LoadBalancerSessionAffinity string
+
+const (
+	LoadBalancerSessionAffinityNone     LoadBalancerSessionAffinity = "none"
+	LoadBalancerSessionAffinityCookie   LoadBalancerSessionAffinity = "cookie"
+	LoadBalancerSessionAffinityIPCookie LoadBalancerSessionAffinity = "ip_cookie"
+	LoadBalancerSessionAffinityHeader   LoadBalancerSessionAffinity = "header"
+)
+
+// LoadBalancer is a zone level load balancer.
+//
+// RegionPools, PopPools and CountryPools map a region code, PoP code or
+// country code to the ordered list of pool IDs used for that location.
+type LoadBalancer struct {
+	ID                        string                                 `json:"id,omitempty"`
+	CreatedOn                 *time.Time                             `json:"created_on,omitempty"`
+	ModifiedOn                *time.Time                             `json:"modified_on,omitempty"`
+	Name                      string                                 `json:"name"`
+	Description               string                                 `json:"description,omitempty"`
+	TTL                       int                                    `json:"ttl,omitempty"`
+	Enabled                   *bool                                  `json:"enabled,omitempty"`
+	Proxied                   bool                                   `json:"proxied"`
+	FallbackPool              string                                 `json:"fallback_pool"`
+	DefaultPools              []string                               `json:"default_pools"`
+	RegionPools               map[string][]string                    `json:"region_pools,omitempty"`
+	PopPools                  map[string][]string                    `json:"pop_pools,omitempty"`
+	CountryPools              map[string][]string                    `json:"country_pools,omitempty"`
+	SteeringPolicy            LoadBalancerSteeringPolicy             `json:"steering_policy,omitempty"`
+	RandomSteering            *LoadBalancerRandomSteering            `json:"random_steering,omitempty"`
+	AdaptiveRouting           *LoadBalancerAdaptiveRouting           `json:"adaptive_routing,omitempty"`
+	LocationStrategy          *LoadBalancerLocationStrategy          `json:"location_strategy,omitempty"`
+	SessionAffinity           LoadBalancerSessionAffinity            `json:"session_affinity,omitempty"`
+	SessionAffinityTTL        int                                    `json:"session_affinity_ttl,omitempty"`
+	SessionAffinityAttributes *LoadBalancerSessionAffinityAttributes `json:"session_affinity_attributes,omitempty"`
+	Rules                     []LoadBalancerRule                     `json:"rules,omitempty"`
+}
+
+// LoadBalancerRandomSteering weights pools when the random or least
+// outstanding requests steering policies are used.
+type LoadBalancerRandomSteering struct {
+	DefaultWeight float64            `json:"default_weight,omitempty"`
+	PoolWeights   map[string]float64 `json:"pool_weights,omitempty"`
+}
+
+// LoadBalancerAdaptiveRouting controls failover behaviour when all origins
+// in a pool are unhealthy.
+type LoadBalancerAdaptiveRouting struct {
+	FailoverAcrossPools *bool `json:"failover_across_pools,omitempty"`
+}
+
+// LoadBalancerLocationStrategy controls how the location of a visitor is
+// determined for proximity and geo steering.
+type LoadBalancerLocationStrategy struct {
+	PreferECS string `json:"prefer_ecs,omitempty"`
+	Mode      string `json:"mode,omitempty"`
+}
+
+// LoadBalancerSessionAffinityAttributes fine tunes session affinity.
+type LoadBalancerSessionAffinityAttributes struct {
+	SameSite             string   `json:"samesite,omitempty"`
+	Secure               string   `json:"secure,omitempty"`
+	DrainDuration        int      `json:"drain_duration,omitempty"`
+	ZeroDowntimeFailover string   `json:"zero_downtime_failover,omitempty"`
+	Headers              []string `json:"headers,omitempty"`
+	RequireAllHeaders    bool     `json:"require_all_headers,omitempty"`
+}
+
+// LoadBalancerRule overrides the behaviour of a load balancer for requests
+// matching Condition, or answers them directly with FixedResponse.
+type LoadBalancerRule struct {
+	Name          string                         `json:"name,omitempty"`
+	Condition     string                         `json:"condition,omitempty"`
+	Priority      int                            `json:"priority"`
+	Disabled      bool                           `json:"disabled"`
+	Terminates    bool                           `json:"terminates,omitempty"`
+	Overrides     LoadBalancerRuleOverrides      `json:"overrides"`
+	FixedResponse *LoadBalancerRuleFixedResponse `json:"fixed_response,omitempty"`
+}
+
+// LoadBalancerRuleOverrides are the load balancer settings replaced by a
+// matching rule.
+type LoadBalancerRuleOverrides struct {
+	TTL                       int                                    `json:"ttl,omitempty"`
+	FallbackPool              string                                 `json:"fallback_pool,omitempty"`
+	DefaultPools              []string                               `json:"default_pools,omitempty"`
+	RegionPools               map[string][]string                    `json:"region_pools,omitempty"`
+	PopPools                  map[string][]string                    `json:"pop_pools,omitempty"`
+	CountryPools              map[string][]string                    `json:"country_pools,omitempty"`
+	SteeringPolicy            LoadBalancerSteeringPolicy             `json:"steering_policy,omitempty"`
+	RandomSteering            *LoadBalancerRandomSteering            `json:"random_steering,omitempty"`
+	AdaptiveRouting           *LoadBalancerAdaptiveRouting           `json:"adaptive_routing,omitempty"`
+	LocationStrategy          *LoadBalancerLocationStrategy          `json:"location_strategy,omitempty"`
+	SessionAffinity           LoadBalancerSessionAffinity            `json:"session_affinity,omitempty"`
+	SessionAffinityTTL        int                                    `json:"session_affinity_ttl,omitempty"`
+	SessionAffinityAttributes *LoadBalancerSessionAffinityAttributes `json:"session_affinity_attributes,omitempty"`
+}
+
+// LoadBalancerRuleFixedResponse is returned instead of proxying to a pool.
+type LoadBalancerRuleFixedResponse struct {
+	MessageBody string `json:"message_body,omitempty"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Location    string `json:"location,omitempty"`
+}
+
+// LoadBalancerResponse represents the response from the load balancers
+// endpoint containing a single load balancer.
+type LoadBalancerResponse struct {
+	Response
+	Result LoadBalancer `json:"result"`
+}
+
+// LoadBalancersResponse represents the response from the load balancers
+// endpoint containing multiple load balancers.
+type LoadBalancersResponse struct {
+	Response
+	Result     []LoadBalancer `json:"result"`
+	ResultInfo ResultInfo     `json:"result_info"`
+}
+
+// List returns the load balancers of a zone.
+//
+// API reference: https://api.cloudflare.com/#load-balancers-list-load-balancers
+func (s *LoadBalancersService) List(ctx context.Context, zoneID string) ([]LoadBalancer, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []LoadBalancer{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/load_balancers", nil)
+	if err != nil {
+		return []LoadBalancer{}, err
+	}
+
+	var r LoadBalancersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []LoadBalancer{}, fmt.Errorf("failed to unmarshal load balancer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single load balancer.
+//
+// API reference: https://api.cloudflare.com/#load-balancers-load-balancer-details
+func (s *LoadBalancersService) Get(ctx context.Context, zoneID, loadBalancerID string) (LoadBalancer, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return LoadBalancer{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if loadBalancerID == "" {
+		return LoadBalancer{}, fmt.Errorf(errMissingResourceID, "load balancer")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/load_balancers/"+loadBalancerID, nil)
+	if err != nil {
+		return LoadBalancer{}, err
+	}
+
+	var r LoadBalancerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancer{}, fmt.Errorf("failed to unmarshal load balancer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new load balancer.
+//
+// API reference: https://api.cloudflare.com/#load-balancers-create-load-balancer
+func (s *LoadBalancersService) Create(ctx context.Context, zoneID string, lb LoadBalancer) (LoadBalancer, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return LoadBalancer{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/load_balancers", lb)
+	if err != nil {
+		return LoadBalancer{}, err
+	}
+
+	var r LoadBalancerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancer{}, fmt.Errorf("failed to unmarshal load balancer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces the configuration of an existing load balancer.
+//
+// API reference: https://api.cloudflare.com/#load-balancers-update-load-balancer
+func (s *LoadBalancersService) Update(ctx context.Context, zoneID, loadBalancerID string, lb LoadBalancer) (LoadBalancer, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return LoadBalancer{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if loadBalancerID == "" {
+		return LoadBalancer{}, fmt.Errorf(errMissingResourceID, "load balancer")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/load_balancers/"+loadBalancerID, lb)
+	if err != nil {
+		return LoadBalancer{}, err
+	}
+
+	var r LoadBalancerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LoadBalancer{}, fmt.Errorf("failed to unmarshal load balancer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a load balancer.
+//
+// API reference: https://api.cloudflare.com/#load-balancers-delete-load-balancer
+func (s *LoadBalancersService) Delete(ctx context.Context, zoneID, loadBalancerID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if loadBalancerID == "" {
+		return fmt.Errorf(errMissingResourceID, "load balancer")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/load_balancers/"+loadBalancerID, nil)
+	return err
+}
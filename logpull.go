@@ -0,0 +1,247 @@
+package cloudflare
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// maxLogpullLineSize is the longest log line LogpullReader can decode.
+const maxLogpullLineSize = 1 << 20
+
+type LogsService service
+
+// LogpullEntry is a single HTTP request log. Keys are the requested field
+// names; numbers are decoded as json.Number so nanosecond timestamps keep
+// their precision.
+type LogpullEntry map[string]interface{}
+
+// LogpullReceivedParams selects the logs returned by Received. Start is
+// inclusive and End exclusive; the range may be at most one hour and must end
+// at least one minute in the past.
+type LogpullReceivedParams struct {
+	Start      time.Time `url:"start"`
+	End        time.Time `url:"end"`
+	Fields     []string  `url:"fields,comma,omitempty"`
+	Sample     float64   `url:"sample,omitempty"`
+	Count      int       `url:"count,omitempty"`
+	Timestamps string    `url:"timestamps,omitempty"`
+}
+
+// LogpullRayIDParams selects the fields returned by RayID.
+type LogpullRayIDParams struct {
+	Fields     []string `url:"fields,comma,omitempty"`
+	Timestamps string   `url:"timestamps,omitempty"`
+}
+
+// LogpullFieldsResponse represents the response from the Logpull fields
+// endpoint, mapping each field name to its description.
+type LogpullFieldsResponse map[string]string
+
+// LogpullRetention reports whether logs are retained for a zone.
+type LogpullRetention struct {
+	Flag bool `json:"flag"`
+}
+
+// LogpullRetentionResponse represents the response from the Logpull
+// retention endpoint.
+type LogpullRetentionResponse struct {
+	Response
+	Result LogpullRetention `json:"result"`
+}
+
+// LogpullReader streams newline delimited JSON logs from the Logpull API.
+//
+// The raw NDJSON can be consumed with Read, or entries decoded one at a time
+// with Next and Entry. The two styles must not be mixed. Close must always be
+// called to release the connection.
+type LogpullReader struct {
+	body    io.ReadCloser
+	scanner *bufio.Scanner
+	entry   LogpullEntry
+	err     error
+}
+
+func newLogpullReader(body io.ReadCloser) *LogpullReader {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogpullLineSize)
+	return &LogpullReader{body: body, scanner: scanner}
+}
+
+// Read reads raw NDJSON from the response body.
+func (r *LogpullReader) Read(p []byte) (int, error) {
+	return r.body.Read(p)
+}
+
+// Close closes the response body.
+func (r *LogpullReader) Close() error {
+	return r.body.Close()
+}
+
+// Next decodes the next log entry, returning false once the stream ends or
+// an error occurs. Err reports which.
+func (r *LogpullReader) Next() bool {
+	for r.err == nil && r.scanner.Scan() {
+		line := bytes.TrimSpace(r.scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+
+		var entry LogpullEntry
+		if err := decoder.Decode(&entry); err != nil {
+			r.err = fmt.Errorf("failed to unmarshal logpull JSON data: %w", err)
+			return false
+		}
+
+		r.entry = entry
+		return true
+	}
+
+	if r.err == nil {
+		r.err = r.scanner.Err()
+	}
+	return false
+}
+
+// Entry returns the entry decoded by the last call to Next.
+func (r *LogpullReader) Entry() LogpullEntry {
+	return r.entry
+}
+
+// Err returns the error that stopped Next, or nil if the stream was read to
+// the end.
+func (r *LogpullReader) Err() error {
+	return r.err
+}
+
+// Received streams the HTTP request logs of a zone received within a time
+// range. The response is not buffered so arbitrarily large ranges can be
+// processed with constant memory.
+//
+// API reference: https://api.cloudflare.com/#logs-received-logs-received
+func (s *LogsService) Received(ctx context.Context, zoneID string, params LogpullReceivedParams) (*LogpullReader, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return nil, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.Start.IsZero() || params.End.IsZero() {
+		return nil, errors.New("start and end must be provided")
+	}
+
+	if !params.End.After(params.Start) {
+		return nil, errors.New("end must be after start")
+	}
+
+	body, err := s.client.streamRequest(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/logs/received", params), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return newLogpullReader(body), nil
+}
+
+// RayID returns the log of a single request.
+//
+// API reference: https://api.cloudflare.com/#logs-received-logs-rayids
+func (s *LogsService) RayID(ctx context.Context, zoneID, rayID string, params LogpullRayIDParams) (LogpullEntry, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return nil, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if rayID == "" {
+		return nil, fmt.Errorf(errMissingResourceID, "ray")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/logs/rayids/"+rayID, params), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(res))
+	decoder.UseNumber()
+
+	var entry LogpullEntry
+	err = decoder.Decode(&entry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal logpull JSON data: %w", err)
+	}
+
+	return entry, nil
+}
+
+// Fields returns the fields available to Received and RayID along with their
+// descriptions.
+//
+// API reference: https://api.cloudflare.com/#logs-received-list-fields
+func (s *LogsService) Fields(ctx context.Context, zoneID string) (map[string]string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return nil, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/logs/received/fields", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r LogpullFieldsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal logpull fields JSON data: %w", err)
+	}
+
+	return r, nil
+}
+
+// GetRetention reports whether logs are retained for a zone. Received only
+// returns logs while retention is enabled.
+//
+// API reference: https://api.cloudflare.com/#logs-received-get-log-retention-flag
+func (s *LogsService) GetRetention(ctx context.Context, zoneID string) (bool, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return false, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/logs/control/retention/flag", nil)
+	if err != nil {
+		return false, err
+	}
+
+	var r LogpullRetentionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal logpull retention JSON data: %w", err)
+	}
+
+	return r.Result.Flag, nil
+}
+
+// SetRetention enables or disables log retention for a zone.
+//
+// API reference: https://api.cloudflare.com/#logs-received-update-log-retention-flag
+func (s *LogsService) SetRetention(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return false, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/logs/control/retention/flag", LogpullRetention{Flag: enabled})
+	if err != nil {
+		return false, err
+	}
+
+	var r LogpullRetentionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal logpull retention JSON data: %w", err)
+	}
+
+	return r.Result.Flag, nil
+}
@@ -0,0 +1,426 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type LogpushService service
+
+// LogpushJob pushes the logs of a dataset to a destination.
+//
+// DestinationConf is the destination URI, such as
+// "s3://bucket/path?region=us-west-2". Filter is a JSON encoded filter
+// expression limiting the pushed records.
+type LogpushJob struct {
+	ID                       int                   `json:"id,omitempty"`
+	Dataset                  string                `json:"dataset"`
+	Enabled                  bool                  `json:"enabled"`
+	Kind                     string                `json:"kind,omitempty"`
+	Name                     string                `json:"name"`
+	LogpullOptions           string                `json:"logpull_options,omitempty"`
+	OutputOptions            *LogpushOutputOptions `json:"output_options,omitempty"`
+	DestinationConf          string                `json:"destination_conf"`
+	OwnershipChallenge       string                `json:"ownership_challenge,omitempty"`
+	Frequency                string                `json:"frequency,omitempty"`
+	Filter                   string                `json:"filter,omitempty"`
+	MaxUploadBytes           int                   `json:"max_upload_bytes,omitempty"`
+	MaxUploadRecords         int                   `json:"max_upload_records,omitempty"`
+	MaxUploadIntervalSeconds int                   `json:"max_upload_interval_seconds,omitempty"`
+	LastComplete             *time.Time            `json:"last_complete,omitempty"`
+	LastError                *time.Time            `json:"last_error,omitempty"`
+	ErrorMessage             string                `json:"error_message,omitempty"`
+}
+
+// LogpushOutputOptions controls the format of pushed log records.
+type LogpushOutputOptions struct {
+	FieldNames      []string `json:"field_names,omitempty"`
+	OutputType      string   `json:"output_type,omitempty"`
+	BatchPrefix     string   `json:"batch_prefix,omitempty"`
+	BatchSuffix     string   `json:"batch_suffix,omitempty"`
+	RecordPrefix    string   `json:"record_prefix,omitempty"`
+	RecordSuffix    string   `json:"record_suffix,omitempty"`
+	RecordTemplate  string   `json:"record_template,omitempty"`
+	RecordDelimiter string   `json:"record_delimiter,omitempty"`
+	FieldDelimiter  string   `json:"field_delimiter,omitempty"`
+	TimestampFormat string   `json:"timestamp_format,omitempty"`
+	SampleRate      float64  `json:"sample_rate,omitempty"`
+	CVE202144228    *bool    `json:"CVE-2021-44228,omitempty"`
+}
+
+// LogpushOwnershipChallenge describes where the ownership challenge for a
+// destination was written.
+type LogpushOwnershipChallenge struct {
+	Filename string `json:"filename"`
+	Message  string `json:"message"`
+	Valid    bool   `json:"valid"`
+}
+
+// LogpushValidation is the result of validating a destination, ownership
+// challenge or set of logpull options.
+type LogpushValidation struct {
+	Valid   bool   `json:"valid"`
+	Exists  bool   `json:"exists"`
+	Message string `json:"message,omitempty"`
+}
+
+// LogpushJobResponse represents the response from the Logpush jobs endpoint
+// containing a single job.
+type LogpushJobResponse struct {
+	Response
+	Result LogpushJob `json:"result"`
+}
+
+// LogpushJobsResponse represents the response from the Logpush jobs endpoint
+// containing multiple jobs.
+type LogpushJobsResponse struct {
+	Response
+	Result []LogpushJob `json:"result"`
+}
+
+// LogpushFieldsResponse represents the response from the dataset fields
+// endpoint, mapping each field name to its description.
+type LogpushFieldsResponse struct {
+	Response
+	Result map[string]string `json:"result"`
+}
+
+// LogpushOwnershipChallengeResponse represents the response from requesting
+// an ownership challenge.
+type LogpushOwnershipChallengeResponse struct {
+	Response
+	Result LogpushOwnershipChallenge `json:"result"`
+}
+
+// LogpushValidationResponse represents the response from the Logpush
+// validation endpoints.
+type LogpushValidationResponse struct {
+	Response
+	Result LogpushValidation `json:"result"`
+}
+
+// logpushDestinationParams is the request body of the ownership and
+// destination endpoints.
+type logpushDestinationParams struct {
+	DestinationConf    string `json:"destination_conf"`
+	OwnershipChallenge string `json:"ownership_challenge,omitempty"`
+}
+
+// logpushOriginParams is the request body used to validate logpull options.
+type logpushOriginParams struct {
+	LogpullOptions string `json:"logpull_options"`
+}
+
+// ListJobs returns the Logpush jobs of an account or zone.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-list-logpush-jobs
+func (s *LogpushService) ListJobs(ctx context.Context, rc *ResourceContainer) ([]LogpushJob, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return []LogpushJob{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/logpush/jobs", nil)
+	if err != nil {
+		return []LogpushJob{}, err
+	}
+
+	var r LogpushJobsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []LogpushJob{}, fmt.Errorf("failed to unmarshal logpush job JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListDatasetJobs returns the Logpush jobs pushing a single dataset.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-list-logpush-jobs-for-a-dataset
+func (s *LogpushService) ListDatasetJobs(ctx context.Context, rc *ResourceContainer, dataset string) ([]LogpushJob, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return []LogpushJob{}, err
+	}
+
+	if dataset == "" {
+		return []LogpushJob{}, errors.New("dataset must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/logpush/datasets/"+dataset+"/jobs", nil)
+	if err != nil {
+		return []LogpushJob{}, err
+	}
+
+	var r LogpushJobsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []LogpushJob{}, fmt.Errorf("failed to unmarshal logpush job JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetJob fetches a single Logpush job.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-get-logpush-job-details
+func (s *LogpushService) GetJob(ctx context.Context, rc *ResourceContainer, jobID int) (LogpushJob, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return LogpushJob{}, err
+	}
+
+	if jobID == 0 {
+		return LogpushJob{}, fmt.Errorf(errMissingResourceID, "logpush job")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/logpush/jobs/"+strconv.Itoa(jobID), nil)
+	if err != nil {
+		return LogpushJob{}, err
+	}
+
+	var r LogpushJobResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogpushJob{}, fmt.Errorf("failed to unmarshal logpush job JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateJob creates a new Logpush job. Destinations other than Cloudflare R2
+// and HTTP endpoints require an OwnershipChallenge obtained with
+// GetOwnershipChallenge.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-create-logpush-job
+func (s *LogpushService) CreateJob(ctx context.Context, rc *ResourceContainer, job LogpushJob) (LogpushJob, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return LogpushJob{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/logpush/jobs", job)
+	if err != nil {
+		return LogpushJob{}, err
+	}
+
+	var r LogpushJobResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogpushJob{}, fmt.Errorf("failed to unmarshal logpush job JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateJob replaces the configuration of a Logpush job.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-update-logpush-job
+func (s *LogpushService) UpdateJob(ctx context.Context, rc *ResourceContainer, jobID int, job LogpushJob) (LogpushJob, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return LogpushJob{}, err
+	}
+
+	if jobID == 0 {
+		return LogpushJob{}, fmt.Errorf(errMissingResourceID, "logpush job")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/logpush/jobs/"+strconv.Itoa(jobID), job)
+	if err != nil {
+		return LogpushJob{}, err
+	}
+
+	var r LogpushJobResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogpushJob{}, fmt.Errorf("failed to unmarshal logpush job JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteJob removes a Logpush job.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-delete-logpush-job
+func (s *LogpushService) DeleteJob(ctx context.Context, rc *ResourceContainer, jobID int) error {
+	if err := validateLogpushContainer(rc); err != nil {
+		return err
+	}
+
+	if jobID == 0 {
+		return fmt.Errorf(errMissingResourceID, "logpush job")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/logpush/jobs/"+strconv.Itoa(jobID), nil)
+	return err
+}
+
+// EnableJob starts pushing logs for a Logpush job.
+func (s *LogpushService) EnableJob(ctx context.Context, rc *ResourceContainer, jobID int) (LogpushJob, error) {
+	return s.setJobEnabled(ctx, rc, jobID, true)
+}
+
+// DisableJob stops pushing logs for a Logpush job without removing it.
+func (s *LogpushService) DisableJob(ctx context.Context, rc *ResourceContainer, jobID int) (LogpushJob, error) {
+	return s.setJobEnabled(ctx, rc, jobID, false)
+}
+
+func (s *LogpushService) setJobEnabled(ctx context.Context, rc *ResourceContainer, jobID int, enabled bool) (LogpushJob, error) {
+	job, err := s.GetJob(ctx, rc, jobID)
+	if err != nil {
+		return LogpushJob{}, err
+	}
+
+	if job.Enabled == enabled {
+		return job, nil
+	}
+
+	job.Enabled = enabled
+	return s.UpdateJob(ctx, rc, jobID, job)
+}
+
+// Fields returns the fields available in a dataset along with their
+// descriptions.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-list-fields
+func (s *LogpushService) Fields(ctx context.Context, rc *ResourceContainer, dataset string) (map[string]string, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return nil, err
+	}
+
+	if dataset == "" {
+		return nil, errors.New("dataset must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/logpush/datasets/"+dataset+"/fields", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r LogpushFieldsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal logpush fields JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetOwnershipChallenge writes an ownership challenge file to a destination.
+// The file contents must be passed back as the job's OwnershipChallenge.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-get-ownership-challenge
+func (s *LogpushService) GetOwnershipChallenge(ctx context.Context, rc *ResourceContainer, destinationConf string) (LogpushOwnershipChallenge, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return LogpushOwnershipChallenge{}, err
+	}
+
+	if destinationConf == "" {
+		return LogpushOwnershipChallenge{}, errors.New("destination must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/logpush/ownership", logpushDestinationParams{DestinationConf: destinationConf})
+	if err != nil {
+		return LogpushOwnershipChallenge{}, err
+	}
+
+	var r LogpushOwnershipChallengeResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogpushOwnershipChallenge{}, fmt.Errorf("failed to unmarshal logpush ownership challenge JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ValidateOwnershipChallenge checks an ownership challenge against a
+// destination before it is used to create a job.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-validate-ownership-challenge
+func (s *LogpushService) ValidateOwnershipChallenge(ctx context.Context, rc *ResourceContainer, destinationConf, ownershipChallenge string) (bool, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return false, err
+	}
+
+	if destinationConf == "" {
+		return false, errors.New("destination must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/logpush/ownership/validate", logpushDestinationParams{DestinationConf: destinationConf, OwnershipChallenge: ownershipChallenge})
+	if err != nil {
+		return false, err
+	}
+
+	var r LogpushValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal logpush validation JSON data: %w", err)
+	}
+
+	return r.Result.Valid, nil
+}
+
+// CheckDestinationExists reports whether a job already pushes to a
+// destination.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-check-destination-exists
+func (s *LogpushService) CheckDestinationExists(ctx context.Context, rc *ResourceContainer, destinationConf string) (bool, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return false, err
+	}
+
+	if destinationConf == "" {
+		return false, errors.New("destination must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/logpush/validate/destination/exists", logpushDestinationParams{DestinationConf: destinationConf})
+	if err != nil {
+		return false, err
+	}
+
+	var r LogpushValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return false, fmt.Errorf("failed to unmarshal logpush validation JSON data: %w", err)
+	}
+
+	return r.Result.Exists, nil
+}
+
+// ValidateLogpullOptions checks a logpull options string, such as
+// "fields=RayID,ClientIP&timestamps=rfc3339", before it is used in a job.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-validate-origin
+func (s *LogpushService) ValidateLogpullOptions(ctx context.Context, rc *ResourceContainer, logpullOptions string) (LogpushValidation, error) {
+	if err := validateLogpushContainer(rc); err != nil {
+		return LogpushValidation{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/logpush/validate/origin", logpushOriginParams{LogpullOptions: logpullOptions})
+	if err != nil {
+		return LogpushValidation{}, err
+	}
+
+	var r LogpushValidationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogpushValidation{}, fmt.Errorf("failed to unmarshal logpush validation JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// validateLogpushContainer ensures Logpush jobs are only requested for
+// accounts or zones.
+func validateLogpushContainer(rc *ResourceContainer) error {
+	if err := rc.validate(); err != nil {
+		return err
+	}
+
+	if rc.Level == UserRouteType {
+		return errors.New("logpush jobs must belong to an account or zone")
+	}
+
+	return nil
+}
@@ -0,0 +1,370 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// maxMagicFirewallExpressionLength is the longest expression accepted in a
+// Magic Firewall rule.
+const maxMagicFirewallExpressionLength = 4096
+
+// MagicFirewallExpression is a wirefilter expression matching packets, such
+// as `ip.src in {192.0.2.0/24} and tcp.dstport == 22`.
+type MagicFirewallExpression string
+
+// MagicFirewallAnd returns an expression matching when every expression
+// matches. Empty expressions are ignored.
+func MagicFirewallAnd(exprs ...MagicFirewallExpression) MagicFirewallExpression {
+	return joinMagicFirewallExpressions(" and ", exprs)
+}
+
+// MagicFirewallOr returns an expression matching when any expression
+// matches. Empty expressions are ignored.
+func MagicFirewallOr(exprs ...MagicFirewallExpression) MagicFirewallExpression {
+	return joinMagicFirewallExpressions(" or ", exprs)
+}
+
+// MagicFirewallNot returns an expression matching when expr does not.
+func MagicFirewallNot(expr MagicFirewallExpression) MagicFirewallExpression {
+	return MagicFirewallExpression("not (" + string(expr) + ")")
+}
+
+// MagicFirewallIPSource returns an expression matching packets sent from
+// one of the given addresses or CIDR ranges.
+func MagicFirewallIPSource(cidrs ...string) MagicFirewallExpression {
+	return MagicFirewallExpression("ip.src in {" + strings.Join(cidrs, " ") + "}")
+}
+
+// MagicFirewallIPDestination returns an expression matching packets sent to
+// one of the given addresses or CIDR ranges.
+func MagicFirewallIPDestination(cidrs ...string) MagicFirewallExpression {
+	return MagicFirewallExpression("ip.dst in {" + strings.Join(cidrs, " ") + "}")
+}
+
+// MagicFirewallProtocol returns an expression matching packets of one of the
+// given IP protocols, such as "tcp", "udp" or "icmp".
+func MagicFirewallProtocol(protocols ...string) MagicFirewallExpression {
+	quoted := make([]string, 0, len(protocols))
+	for _, p := range protocols {
+		quoted = append(quoted, strconv.Quote(p))
+	}
+	return MagicFirewallExpression("ip.proto in {" + strings.Join(quoted, " ") + "}")
+}
+
+// MagicFirewallPorts returns an expression matching when a port field, such
+// as "tcp.dstport" or "udp.srcport", is one of ports.
+func MagicFirewallPorts(field string, ports ...int) MagicFirewallExpression {
+	values := make([]string, 0, len(ports))
+	for _, p := range ports {
+		values = append(values, strconv.Itoa(p))
+	}
+	return MagicFirewallExpression(field + " in {" + strings.Join(values, " ") + "}")
+}
+
+// MagicFirewallPortRange returns an expression matching when a port field is
+// between from and to inclusive.
+func MagicFirewallPortRange(field string, from, to int) MagicFirewallExpression {
+	return MagicFirewallExpression(field + " in {" + strconv.Itoa(from) + ".." + strconv.Itoa(to) + "}")
+}
+
+// MagicFirewallInList returns an expression matching when field is in the
+// named IP list of the account.
+func MagicFirewallInList(field, listName string) MagicFirewallExpression {
+	return MagicFirewallExpression(field + " in $" + listName)
+}
+
+func joinMagicFirewallExpressions(sep string, exprs []MagicFirewallExpression) MagicFirewallExpression {
+	var nonEmpty []MagicFirewallExpression
+	for _, e := range exprs {
+		if e != "" {
+			nonEmpty = append(nonEmpty, e)
+		}
+	}
+	if len(nonEmpty) == 1 {
+		return nonEmpty[0]
+	}
+
+	parts := make([]string, 0, len(nonEmpty))
+	for _, e := range nonEmpty {
+		parts = append(parts, "("+string(e)+")")
+	}
+	return MagicFirewallExpression(strings.Join(parts, sep))
+}
+
+// MagicFirewallRule returns a ruleset rule for the Magic Firewall. Action
+// must be RulesetRuleActionBlock or RulesetRuleActionSkip; skip rules allow
+// matching packets by skipping the remaining rules.
+func MagicFirewallRule(action RulesetRuleAction, expr MagicFirewallExpression, description string) RulesetRule {
+	rule := RulesetRule{
+		Action:      action,
+		Expression:  string(expr),
+		Description: description,
+	}
+	if action == RulesetRuleActionSkip {
+		rule.ActionParameters = &RulesetRuleActionParameters{Ruleset: "current"}
+	}
+	return rule
+}
+
+// MagicNetworkMonitoringRule alerts when traffic to Prefixes exceeds a
+// threshold over Duration, such as "1m" or "5m". One of BandwidthThreshold,
+// in bits per second, and PacketThreshold, in packets per second, should be
+// set. AutomaticAdvertisement advertises the prefixes through Magic Transit
+// once the rule triggers.
+type MagicNetworkMonitoringRule struct {
+	ID                     string   `json:"id,omitempty"`
+	Name                   string   `json:"name"`
+	Prefixes               []string `json:"prefixes"`
+	Duration               string   `json:"duration,omitempty"`
+	BandwidthThreshold     float64  `json:"bandwidth_threshold,omitempty"`
+	PacketThreshold        float64  `json:"packet_threshold,omitempty"`
+	AutomaticAdvertisement *bool    `json:"automatic_advertisement,omitempty"`
+}
+
+// MagicNetworkMonitoringRuleResponse represents the response from the
+// network monitoring rules endpoint containing a single rule.
+type MagicNetworkMonitoringRuleResponse struct {
+	Response
+	Result MagicNetworkMonitoringRule `json:"result"`
+}
+
+// MagicNetworkMonitoringRulesResponse represents the response from the
+// network monitoring rules endpoint containing multiple rules.
+type MagicNetworkMonitoringRulesResponse struct {
+	Response
+	Result []MagicNetworkMonitoringRule `json:"result"`
+}
+
+// GetFirewallRules returns the Magic Firewall ruleset of an account, the
+// entry point ruleset of the magic_transit phase.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-get-an-account-entry-point-ruleset
+func (s *MagicTransitService) GetFirewallRules(ctx context.Context, accountID string) (Ruleset, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Ruleset{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.Rulesets.GetEntrypoint(ctx, AccountIdentifier(accountID), RulesetPhaseMagicTransit)
+}
+
+// UpdateFirewallRules replaces the Magic Firewall rules of an account. Each
+// rule is checked before the request is made: its action must be block or
+// skip and its expression must be non-empty with balanced parentheses and
+// quotes.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-update-an-account-entry-point-ruleset
+func (s *MagicTransitService) UpdateFirewallRules(ctx context.Context, accountID string, rules []RulesetRule) (Ruleset, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Ruleset{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	for i, rule := range rules {
+		if rule.Action != RulesetRuleActionBlock && rule.Action != RulesetRuleActionSkip {
+			return Ruleset{}, fmt.Errorf("magic firewall rule %d: action must be block or skip, got %q", i, rule.Action)
+		}
+
+		if err := validateMagicFirewallExpression(MagicFirewallExpression(rule.Expression)); err != nil {
+			return Ruleset{}, fmt.Errorf("magic firewall rule %d: %w", i, err)
+		}
+	}
+
+	if rules == nil {
+		rules = []RulesetRule{}
+	}
+
+	return s.client.Rulesets.UpdateEntrypoint(ctx, AccountIdentifier(accountID), RulesetPhaseMagicTransit, RulesetParams{Rules: rules})
+}
+
+// validateMagicFirewallExpression catches the malformed expressions that are
+// easy to produce when building them by hand.
+func validateMagicFirewallExpression(expr MagicFirewallExpression) error {
+	if strings.TrimSpace(string(expr)) == "" {
+		return errors.New("expression must be provided")
+	}
+
+	if len(expr) > maxMagicFirewallExpressionLength {
+		return fmt.Errorf("expression is longer than %d characters", maxMagicFirewallExpressionLength)
+	}
+
+	depth := 0
+	inString := false
+	for i := 0; i < len(expr); i++ {
+		switch ch := expr[i]; {
+		case inString && ch == '\\':
+			i++
+		case ch == '"':
+			inString = !inString
+		case inString:
+		case ch == '(' || ch == '{':
+			depth++
+		case ch == ')' || ch == '}':
+			depth--
+			if depth < 0 {
+				return errors.New("expression has unbalanced brackets")
+			}
+		}
+	}
+
+	if inString {
+		return errors.New("expression has an unterminated string")
+	}
+
+	if depth != 0 {
+		return errors.New("expression has unbalanced brackets")
+	}
+
+	return nil
+}
+
+// ListNetworkMonitoringRules returns the network monitoring rules of an
+// account.
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-list-rules
+func (s *MagicTransitService) ListNetworkMonitoringRules(ctx context.Context, accountID string) ([]MagicNetworkMonitoringRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []MagicNetworkMonitoringRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/mnm/rules", nil)
+	if err != nil {
+		return []MagicNetworkMonitoringRule{}, err
+	}
+
+	var r MagicNetworkMonitoringRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MagicNetworkMonitoringRule{}, fmt.Errorf("failed to unmarshal network monitoring rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetNetworkMonitoringRule fetches a single network monitoring rule.
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-get-rule
+func (s *MagicTransitService) GetNetworkMonitoringRule(ctx context.Context, accountID, ruleID string) (MagicNetworkMonitoringRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf(errMissingResourceID, "network monitoring rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/mnm/rules/"+ruleID, nil)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	var r MagicNetworkMonitoringRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf("failed to unmarshal network monitoring rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateNetworkMonitoringRule creates a network monitoring rule.
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-create-rules
+func (s *MagicTransitService) CreateNetworkMonitoringRule(ctx context.Context, accountID string, rule MagicNetworkMonitoringRule) (MagicNetworkMonitoringRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if err := validateMagicNetworkMonitoringRule(rule); err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/mnm/rules", rule)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	var r MagicNetworkMonitoringRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf("failed to unmarshal network monitoring rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateNetworkMonitoringRule replaces a network monitoring rule,
+// identified by its ID.
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-update-rules
+func (s *MagicTransitService) UpdateNetworkMonitoringRule(ctx context.Context, accountID string, rule MagicNetworkMonitoringRule) (MagicNetworkMonitoringRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if rule.ID == "" {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf(errMissingResourceID, "network monitoring rule")
+	}
+
+	if err := validateMagicNetworkMonitoringRule(rule); err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/mnm/rules", rule)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	var r MagicNetworkMonitoringRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, fmt.Errorf("failed to unmarshal network monitoring rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteNetworkMonitoringRule removes a network monitoring rule.
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-delete-rule
+func (s *MagicTransitService) DeleteNetworkMonitoringRule(ctx context.Context, accountID, ruleID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ruleID == "" {
+		return fmt.Errorf(errMissingResourceID, "network monitoring rule")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/mnm/rules/"+ruleID, nil)
+	return err
+}
+
+// validateMagicNetworkMonitoringRule checks that a rule has a name, that its
+// prefixes are CIDR ranges and that exactly one threshold is set.
+func validateMagicNetworkMonitoringRule(rule MagicNetworkMonitoringRule) error {
+	if rule.Name == "" {
+		return errors.New("name must be provided")
+	}
+
+	if len(rule.Prefixes) == 0 {
+		return errors.New("at least one prefix must be provided")
+	}
+
+	for _, prefix := range rule.Prefixes {
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			return fmt.Errorf("invalid prefix %q: must be a CIDR range", prefix)
+		}
+	}
+
+	if (rule.BandwidthThreshold > 0) == (rule.PacketThreshold > 0) {
+		return errors.New("exactly one of bandwidth threshold and packet threshold must be set")
+	}
+
+	return nil
+}
@@ -0,0 +1,310 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+type MagicTransitService service
+
+// MagicTransitStaticRoute routes traffic for Prefix to Nexthop, a tunnel
+// endpoint address. Routes with a lower Priority are preferred; Weight
+// splits traffic between routes of equal priority.
+type MagicTransitStaticRoute struct {
+	ID          string                        `json:"id,omitempty"`
+	Prefix      string                        `json:"prefix"`
+	Nexthop     string                        `json:"nexthop"`
+	Priority    int                           `json:"priority"`
+	Weight      int                           `json:"weight,omitempty"`
+	Description string                        `json:"description,omitempty"`
+	Scope       *MagicTransitStaticRouteScope `json:"scope,omitempty"`
+	CreatedOn   *time.Time                    `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time                    `json:"modified_on,omitempty"`
+}
+
+// MagicTransitStaticRouteScope limits a route to the Cloudflare data centers
+// in ColoRegions, such as "APAC", or named in ColoNames, such as "sfo".
+// Routes without a scope apply everywhere.
+type MagicTransitStaticRouteScope struct {
+	ColoRegions []string `json:"colo_regions,omitempty"`
+	ColoNames   []string `json:"colo_names,omitempty"`
+}
+
+// magicTransitStaticRoutesParams is the request body of the bulk endpoints.
+type magicTransitStaticRoutesParams struct {
+	Routes []MagicTransitStaticRoute `json:"routes"`
+}
+
+// magicTransitStaticRouteIDsParams is the request body of the bulk delete
+// endpoint.
+type magicTransitStaticRouteIDsParams struct {
+	Routes []magicTransitStaticRouteID `json:"routes"`
+}
+
+type magicTransitStaticRouteID struct {
+	ID string `json:"id"`
+}
+
+// MagicTransitStaticRoutesResponse represents the response from the static
+// routes endpoint containing multiple routes.
+type MagicTransitStaticRoutesResponse struct {
+	Response
+	Result struct {
+		Routes []MagicTransitStaticRoute `json:"routes"`
+	} `json:"result"`
+}
+
+// MagicTransitStaticRouteResponse represents the response from the static
+// routes endpoint containing a single route.
+type MagicTransitStaticRouteResponse struct {
+	Response
+	Result struct {
+		Route MagicTransitStaticRoute `json:"route"`
+	} `json:"result"`
+}
+
+// MagicTransitModifiedStaticRouteResponse represents the response from
+// updating a single route.
+type MagicTransitModifiedStaticRouteResponse struct {
+	Response
+	Result struct {
+		Modified      bool                    `json:"modified"`
+		ModifiedRoute MagicTransitStaticRoute `json:"modified_route"`
+	} `json:"result"`
+}
+
+// MagicTransitModifiedStaticRoutesResponse represents the response from
+// updating routes in bulk.
+type MagicTransitModifiedStaticRoutesResponse struct {
+	Response
+	Result struct {
+		Modified       bool                      `json:"modified"`
+		ModifiedRoutes []MagicTransitStaticRoute `json:"modified_routes"`
+	} `json:"result"`
+}
+
+// MagicTransitDeletedStaticRoutesResponse represents the response from
+// deleting routes in bulk.
+type MagicTransitDeletedStaticRoutesResponse struct {
+	Response
+	Result struct {
+		Deleted       bool                      `json:"deleted"`
+		DeletedRoutes []MagicTransitStaticRoute `json:"deleted_routes"`
+	} `json:"result"`
+}
+
+// ListStaticRoutes returns the Magic Transit static routes of an account.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-list-routes
+func (s *MagicTransitService) ListStaticRoutes(ctx context.Context, accountID string) ([]MagicTransitStaticRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []MagicTransitStaticRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/magic/routes", nil)
+	if err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	var r MagicTransitStaticRoutesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MagicTransitStaticRoute{}, fmt.Errorf("failed to unmarshal static route JSON data: %w", err)
+	}
+
+	return r.Result.Routes, nil
+}
+
+// GetStaticRoute fetches a single static route.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-route-details
+func (s *MagicTransitService) GetStaticRoute(ctx context.Context, accountID, routeID string) (MagicTransitStaticRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return MagicTransitStaticRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if routeID == "" {
+		return MagicTransitStaticRoute{}, fmt.Errorf(errMissingResourceID, "static route")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/magic/routes/"+routeID, nil)
+	if err != nil {
+		return MagicTransitStaticRoute{}, err
+	}
+
+	var r MagicTransitStaticRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MagicTransitStaticRoute{}, fmt.Errorf("failed to unmarshal static route JSON data: %w", err)
+	}
+
+	return r.Result.Route, nil
+}
+
+// CreateStaticRoutes creates one or more static routes in a single
+// request.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-create-routes
+func (s *MagicTransitService) CreateStaticRoutes(ctx context.Context, accountID string, routes ...MagicTransitStaticRoute) ([]MagicTransitStaticRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []MagicTransitStaticRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if err := validateMagicTransitStaticRoutes(routes, false); err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/magic/routes", magicTransitStaticRoutesParams{Routes: routes})
+	if err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	var r MagicTransitStaticRoutesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MagicTransitStaticRoute{}, fmt.Errorf("failed to unmarshal static route JSON data: %w", err)
+	}
+
+	return r.Result.Routes, nil
+}
+
+// UpdateStaticRoute replaces a single static route.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-update-route
+func (s *MagicTransitService) UpdateStaticRoute(ctx context.Context, accountID, routeID string, route MagicTransitStaticRoute) (MagicTransitStaticRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return MagicTransitStaticRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if routeID == "" {
+		return MagicTransitStaticRoute{}, fmt.Errorf(errMissingResourceID, "static route")
+	}
+
+	if err := validateMagicTransitStaticRoutes([]MagicTransitStaticRoute{route}, false); err != nil {
+		return MagicTransitStaticRoute{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/magic/routes/"+routeID, route)
+	if err != nil {
+		return MagicTransitStaticRoute{}, err
+	}
+
+	var r MagicTransitModifiedStaticRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MagicTransitStaticRoute{}, fmt.Errorf("failed to unmarshal static route JSON data: %w", err)
+	}
+
+	return r.Result.ModifiedRoute, nil
+}
+
+// UpdateStaticRoutes replaces several static routes, identified by their
+// IDs, in a single request.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-update-many-routes
+func (s *MagicTransitService) UpdateStaticRoutes(ctx context.Context, accountID string, routes []MagicTransitStaticRoute) ([]MagicTransitStaticRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []MagicTransitStaticRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if err := validateMagicTransitStaticRoutes(routes, true); err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/magic/routes", magicTransitStaticRoutesParams{Routes: routes})
+	if err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	var r MagicTransitModifiedStaticRoutesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MagicTransitStaticRoute{}, fmt.Errorf("failed to unmarshal static route JSON data: %w", err)
+	}
+
+	return r.Result.ModifiedRoutes, nil
+}
+
+// DeleteStaticRoute removes a single static route.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-delete-route
+func (s *MagicTransitService) DeleteStaticRoute(ctx context.Context, accountID, routeID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if routeID == "" {
+		return fmt.Errorf(errMissingResourceID, "static route")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/magic/routes/"+routeID, nil)
+	return err
+}
+
+// DeleteStaticRoutes removes several static routes in a single request and
+// returns the deleted routes.
+//
+// API reference: https://api.cloudflare.com/#magic-static-routes-delete-many-routes
+func (s *MagicTransitService) DeleteStaticRoutes(ctx context.Context, accountID string, routeIDs ...string) ([]MagicTransitStaticRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []MagicTransitStaticRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if len(routeIDs) == 0 {
+		return []MagicTransitStaticRoute{}, errors.New("at least one route ID must be provided")
+	}
+
+	routes := make([]magicTransitStaticRouteID, len(routeIDs))
+	for i, id := range routeIDs {
+		if id == "" {
+			return []MagicTransitStaticRoute{}, fmt.Errorf(errMissingResourceID, "static route")
+		}
+		routes[i].ID = id
+	}
+
+	res, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/magic/routes", magicTransitStaticRouteIDsParams{Routes: routes})
+	if err != nil {
+		return []MagicTransitStaticRoute{}, err
+	}
+
+	var r MagicTransitDeletedStaticRoutesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MagicTransitStaticRoute{}, fmt.Errorf("failed to unmarshal static route JSON data: %w", err)
+	}
+
+	return r.Result.DeletedRoutes, nil
+}
+
+// validateMagicTransitStaticRoutes checks the prefix and nexthop of each
+// route, and that each route has an ID when requireID is set.
+func validateMagicTransitStaticRoutes(routes []MagicTransitStaticRoute, requireID bool) error {
+	if len(routes) == 0 {
+		return errors.New("at least one route must be provided")
+	}
+
+	for _, route := range routes {
+		if requireID && route.ID == "" {
+			return fmt.Errorf(errMissingResourceID, "static route")
+		}
+
+		if _, _, err := net.ParseCIDR(route.Prefix); err != nil {
+			return fmt.Errorf("invalid static route prefix %q: %w", route.Prefix, err)
+		}
+
+		if net.ParseIP(route.Nexthop) == nil {
+			return fmt.Errorf("invalid static route nexthop %q", route.Nexthop)
+		}
+
+		if route.Priority < 0 {
+			return fmt.Errorf("invalid static route priority %d", route.Priority)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type ManagedHeadersService service
+
+// ManagedHeaders lists the managed request and response header transforms
+// of a zone, such as "add_visitor_location_headers" or
+// "remove_x-powered-by_header".
+type ManagedHeaders struct {
+	ManagedRequestHeaders  []ManagedHeader `json:"managed_request_headers"`
+	ManagedResponseHeaders []ManagedHeader `json:"managed_response_headers"`
+}
+
+// ManagedHeader is a managed header transform. A transform that HasConflict
+// cannot be enabled while the transforms in ConflictsWith are.
+type ManagedHeader struct {
+	ID            string   `json:"id"`
+	Enabled       bool     `json:"enabled"`
+	HasConflict   bool     `json:"has_conflict,omitempty"`
+	ConflictsWith []string `json:"conflicts_with,omitempty"`
+}
+
+// ManagedHeadersResponse represents the response from the managed headers
+// endpoint.
+type ManagedHeadersResponse struct {
+	Response
+	Result ManagedHeaders `json:"result"`
+}
+
+// Get returns the managed header transforms of a zone and whether each is
+// enabled.
+//
+// API reference: https://api.cloudflare.com/#managed-transforms-list-managed-transforms
+func (s *ManagedHeadersService) Get(ctx context.Context, zoneID string) (ManagedHeaders, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ManagedHeaders{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/managed_headers", nil)
+	if err != nil {
+		return ManagedHeaders{}, err
+	}
+
+	var r ManagedHeadersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ManagedHeaders{}, fmt.Errorf("failed to unmarshal managed headers JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update enables or disables managed header transforms. Only the transforms
+// included in params are changed; only their ID and Enabled fields are used.
+//
+// API reference: https://api.cloudflare.com/#managed-transforms-update-status-of-managed-transforms
+func (s *ManagedHeadersService) Update(ctx context.Context, zoneID string, params ManagedHeaders) (ManagedHeaders, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ManagedHeaders{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.ManagedRequestHeaders == nil {
+		params.ManagedRequestHeaders = []ManagedHeader{}
+	}
+
+	if params.ManagedResponseHeaders == nil {
+		params.ManagedResponseHeaders = []ManagedHeader{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/managed_headers", params)
+	if err != nil {
+		return ManagedHeaders{}, err
+	}
+
+	var r ManagedHeadersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ManagedHeaders{}, fmt.Errorf("failed to unmarshal managed headers JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,81 @@
+package cloudflare
+
+import (
+	"context"
+	"net/http"
+)
+
+// RequestHandler sends a request to the API and returns its response.
+type RequestHandler func(req *http.Request) (*http.Response, error)
+
+// RequestMiddleware wraps the sending of every API request made by a Client.
+// A middleware may inspect or modify req before passing it to next, observe
+// or replace the response returned by next, or return without calling next
+// at all, for example to inject faults in tests:
+//
+//	func(req *http.Request, next cloudflare.RequestHandler) (*http.Response, error) {
+//		req.Header.Set("X-Request-Signature", sign(req))
+//		resp, err := next(req)
+//		if err == nil {
+//			log.Printf("%s %s: %d", req.Method, req.URL, resp.StatusCode)
+//		}
+//		return resp, err
+//	}
+//
+// Middlewares run after the client has set its authentication and default
+// headers, and once per attempt when a request is retried.
+type RequestMiddleware func(req *http.Request, next RequestHandler) (*http.Response, error)
+
+// WithMiddleware adds middlewares to the chain run for every request made by
+// the client and returns the client. Middlewares run in the order they were
+// added, the first one seeing the request first and the response last.
+func (c *Client) WithMiddleware(middleware ...RequestMiddleware) *Client {
+	c.clientMu.Lock()
+	defer c.clientMu.Unlock()
+
+	chain := make([]RequestMiddleware, 0, len(c.Middleware)+len(middleware))
+	chain = append(chain, c.Middleware...)
+	c.Middleware = append(chain, middleware...)
+	return c
+}
+
+// roundTripperKey is the context key of the transport set by
+// WithRoundTripper.
+type roundTripperKey struct{}
+
+// WithRoundTripper returns a copy of ctx that makes requests sent with it use
+// rt instead of the transport of the client's HTTP client, for example to
+// send a single call through another proxy or with a different mTLS
+// identity. The client's timeout, redirect policy and cookie jar still
+// apply. WebSocket connections, such as those of Workers tail sessions and
+// Instant Logs, are dialled directly and bypass both rt and the client's
+// middlewares.
+func WithRoundTripper(ctx context.Context, rt http.RoundTripper) context.Context {
+	return context.WithValue(ctx, roundTripperKey{}, rt)
+}
+
+// do sends req through the client's middlewares and then its HTTP client,
+// using the transport set on the request's context by WithRoundTripper if
+// any.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	c.clientMu.Lock()
+	middleware := c.Middleware
+	httpClient := c.HTTPClient
+	c.clientMu.Unlock()
+
+	if rt, ok := req.Context().Value(roundTripperKey{}).(http.RoundTripper); ok && rt != nil {
+		callClient := *httpClient
+		callClient.Transport = rt
+		httpClient = &callClient
+	}
+
+	handler := RequestHandler(httpClient.Do)
+	for i := len(middleware) - 1; i >= 0; i-- {
+		mw, next := middleware[i], handler
+		handler = func(req *http.Request) (*http.Response, error) {
+			return mw(req, next)
+		}
+	}
+
+	return handler(req)
+}
@@ -0,0 +1,169 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestWithMiddleware_Order(t *testing.T) {
+	client, mux := setup(t)
+
+	var calls []string
+	mux.HandleFunc("/client/v4/zones", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, "server:"+r.Header.Get("X-Trace"))
+		fmt.Fprint(w, `{"success": true, "result": []}`)
+	})
+
+	trace := func(name string) RequestMiddleware {
+		return func(req *http.Request, next RequestHandler) (*http.Response, error) {
+			calls = append(calls, "before:"+name)
+			req.Header.Set("X-Trace", req.Header.Get("X-Trace")+name)
+			resp, err := next(req)
+			calls = append(calls, "after:"+name)
+			return resp, err
+		}
+	}
+
+	client.WithMiddleware(trace("a"), trace("b"))
+	client.WithMiddleware(trace("c"))
+
+	if _, err := client.Call(context.Background(), http.MethodGet, "/zones", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{"before:a", "before:b", "before:c", "server:abc", "after:c", "after:b", "after:a"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v, want %v", calls, want)
+	}
+}
+
+func TestWithMiddleware_SeesAuthentication(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/client/v4/zones", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"success": true, "result": []}`)
+	})
+
+	var auth string
+	client.WithMiddleware(func(req *http.Request, next RequestHandler) (*http.Response, error) {
+		auth = req.Header.Get("Authorization")
+		return next(req)
+	})
+
+	if _, err := client.Call(context.Background(), http.MethodGet, "/zones", nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if auth != "Bearer deadbeef" {
+		t.Errorf("got Authorization %q, want %q", auth, "Bearer deadbeef")
+	}
+}
+
+func TestWithMiddleware_ShortCircuit(t *testing.T) {
+	client, mux := setup(t)
+
+	hits := 0
+	mux.HandleFunc("/client/v4/zones", func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		fmt.Fprint(w, `{"success": true, "result": []}`)
+	})
+
+	attempts := 0
+	client.WithMiddleware(func(req *http.Request, next RequestHandler) (*http.Response, error) {
+		attempts++
+		return nil, errors.New("injected fault")
+	})
+
+	_, err := client.Call(context.Background(), http.MethodGet, "/zones", nil)
+	if err == nil || !strings.Contains(err.Error(), "injected fault") {
+		t.Fatalf("got error %v, want injected fault", err)
+	}
+
+	if hits != 0 {
+		t.Errorf("server was called %d times, want 0", hits)
+	}
+
+	// Middlewares run once per attempt, including retries.
+	if want := client.RetryPolicy.MaxRetries + 1; attempts != want {
+		t.Errorf("middleware ran %d times, want %d", attempts, want)
+	}
+}
+
+func TestWithMiddleware_ReplacesResponse(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/client/v4/zones", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	client.WithMiddleware(func(req *http.Request, next RequestHandler) (*http.Response, error) {
+		resp, err := next(req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Body.Close()
+		resp.StatusCode = http.StatusOK
+		resp.Body = http.NoBody
+		return resp, nil
+	})
+
+	res, err := client.Call(context.Background(), http.MethodGet, "/zones", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(res) != 0 {
+		t.Errorf("got body %q, want empty", res)
+	}
+}
+
+func TestWithRoundTripper_OverridesClientTransport(t *testing.T) {
+	var calls []string
+	transport := func(name string) http.RoundTripper {
+		return roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			calls = append(calls, name)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"success": true, "result": []}`)),
+				Request:    r,
+			}, nil
+		})
+	}
+
+	httpClient := &http.Client{Timeout: time.Minute}
+	client, err := New(&ClientParams{Token: "deadbeef", HTTPClient: httpClient, Transport: transport("client")})
+	if err != nil {
+		t.Fatalf("failed to create client: %s", err)
+	}
+	client.RateLimiter = rate.NewLimiter(rate.Inf, 0)
+
+	// The transport is set on a copy of the HTTP client.
+	if httpClient.Transport != nil {
+		t.Error("setting a transport modified the provided HTTP client")
+	}
+	if client.Client().Timeout != time.Minute {
+		t.Errorf("got timeout %s, want the provided HTTP client's", client.Client().Timeout)
+	}
+
+	ctx := context.Background()
+	for _, ctx := range []context.Context{ctx, WithRoundTripper(ctx, transport("context")), ctx} {
+		if _, err := client.Call(ctx, http.MethodGet, "/zones", nil); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	// The context transport is used for its call only.
+	if want := []string{"client", "context", "client"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got transports %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,286 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type MutualTLSCertificatesService service
+
+// MutualTLSCertificate is a certificate uploaded for use with mTLS. CA
+// certificates validate client certificates presented to the hostnames
+// associated with them and are referenced by mTLS rules of the WAF.
+type MutualTLSCertificate struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	CA           bool       `json:"ca"`
+	Certificates string     `json:"certificates"`
+	Issuer       string     `json:"issuer"`
+	SerialNumber string     `json:"serial_number"`
+	Signature    string     `json:"signature"`
+	UploadedOn   *time.Time `json:"uploaded_on,omitempty"`
+	ExpiresOn    *time.Time `json:"expires_on,omitempty"`
+}
+
+// MutualTLSCertificateParams contains a certificate to upload. Certificates
+// is a PEM encoded chain. PrivateKey is only needed for leaf certificates.
+type MutualTLSCertificateParams struct {
+	Name         string `json:"name,omitempty"`
+	CA           bool   `json:"ca"`
+	Certificates string `json:"certificates"`
+	PrivateKey   string `json:"private_key,omitempty"`
+}
+
+// MutualTLSCertificateAssociation is a service using a certificate, such as
+// "gateway" or "logpush". Status is "pending_deployment", "active",
+// "pending_deletion" or "deleted".
+type MutualTLSCertificateAssociation struct {
+	Service string `json:"service"`
+	Status  string `json:"status"`
+}
+
+// MutualTLSCertificateListParams contains the options available when listing
+// mTLS certificates.
+type MutualTLSCertificateListParams struct {
+	PaginationParams
+}
+
+// mutualTLSHostnameAssociations is the request and response body of the
+// hostname associations endpoint.
+type mutualTLSHostnameAssociations struct {
+	Hostnames         []string `json:"hostnames"`
+	MTLSCertificateID string   `json:"mtls_certificate_id,omitempty"`
+}
+
+// mutualTLSHostnameAssociationsParams selects the certificate whose hostname
+// associations are returned.
+type mutualTLSHostnameAssociationsParams struct {
+	MTLSCertificateID string `url:"mtls_certificate_id,omitempty"`
+}
+
+// MutualTLSCertificateResponse represents the response from the mTLS
+// certificates endpoint containing a single certificate.
+type MutualTLSCertificateResponse struct {
+	Response
+	Result MutualTLSCertificate `json:"result"`
+}
+
+// MutualTLSCertificatesResponse represents the response from the mTLS
+// certificates endpoint containing multiple certificates.
+type MutualTLSCertificatesResponse struct {
+	Response
+	Result     []MutualTLSCertificate `json:"result"`
+	ResultInfo ResultInfo             `json:"result_info"`
+}
+
+// MutualTLSCertificateAssociationsResponse represents the response from the
+// mTLS certificate associations endpoint.
+type MutualTLSCertificateAssociationsResponse struct {
+	Response
+	Result []MutualTLSCertificateAssociation `json:"result"`
+}
+
+// MutualTLSHostnameAssociationsResponse represents the response from the
+// hostname associations endpoint.
+type MutualTLSHostnameAssociationsResponse struct {
+	Response
+	Result mutualTLSHostnameAssociations `json:"result"`
+}
+
+// List returns the mTLS certificates of an account. Every page is fetched
+// unless params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-list-mtls-certificates
+func (s *MutualTLSCertificatesService) List(ctx context.Context, accountID string, params MutualTLSCertificateListParams) ([]MutualTLSCertificate, ResultInfo, error) {
+	var certificates []MutualTLSCertificate
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []MutualTLSCertificate, pageInfo ResultInfo) bool {
+		certificates = append(certificates, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []MutualTLSCertificate{}, ResultInfo{}, err
+	}
+
+	return certificates, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-list-mtls-certificates
+func (s *MutualTLSCertificatesService) ForEachPage(ctx context.Context, accountID string, params MutualTLSCertificateListParams, fn func(items []MutualTLSCertificate, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/mtls_certificates", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r MutualTLSCertificatesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal mtls certificate JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single mTLS certificate.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-get-mtls-certificate
+func (s *MutualTLSCertificatesService) Get(ctx context.Context, accountID, certificateID string) (MutualTLSCertificate, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return MutualTLSCertificate{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if certificateID == "" {
+		return MutualTLSCertificate{}, fmt.Errorf(errMissingResourceID, "mtls certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/mtls_certificates/"+certificateID, nil)
+	if err != nil {
+		return MutualTLSCertificate{}, err
+	}
+
+	var r MutualTLSCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MutualTLSCertificate{}, fmt.Errorf("failed to unmarshal mtls certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Upload adds an mTLS certificate to an account. Certificates are shared by
+// every zone of the account and attached to hostnames with
+// ReplaceHostnameAssociations.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-upload-mtls-certificate
+func (s *MutualTLSCertificatesService) Upload(ctx context.Context, accountID string, params MutualTLSCertificateParams) (MutualTLSCertificate, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return MutualTLSCertificate{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Certificates == "" {
+		return MutualTLSCertificate{}, errors.New("certificates must be provided")
+	}
+
+	if !params.CA && params.PrivateKey == "" {
+		return MutualTLSCertificate{}, errors.New("private key must be provided for leaf certificates")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/mtls_certificates", params)
+	if err != nil {
+		return MutualTLSCertificate{}, err
+	}
+
+	var r MutualTLSCertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return MutualTLSCertificate{}, fmt.Errorf("failed to unmarshal mtls certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes an mTLS certificate. Certificates still associated with a
+// service or hostname cannot be deleted.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-delete-mtls-certificate
+func (s *MutualTLSCertificatesService) Delete(ctx context.Context, accountID, certificateID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if certificateID == "" {
+		return fmt.Errorf(errMissingResourceID, "mtls certificate")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/mtls_certificates/"+certificateID, nil)
+	return err
+}
+
+// ListAssociations returns the services using an mTLS certificate.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-list-mtls-certificate-associations
+func (s *MutualTLSCertificatesService) ListAssociations(ctx context.Context, accountID, certificateID string) ([]MutualTLSCertificateAssociation, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []MutualTLSCertificateAssociation{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if certificateID == "" {
+		return []MutualTLSCertificateAssociation{}, fmt.Errorf(errMissingResourceID, "mtls certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/mtls_certificates/"+certificateID+"/associations", nil)
+	if err != nil {
+		return []MutualTLSCertificateAssociation{}, err
+	}
+
+	var r MutualTLSCertificateAssociationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []MutualTLSCertificateAssociation{}, fmt.Errorf("failed to unmarshal mtls certificate association JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetHostnameAssociations returns the hostnames of a zone that request
+// client certificates validated by a CA certificate. An empty certificate ID
+// selects the Cloudflare managed CA.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-list-hostname-associations
+func (s *MutualTLSCertificatesService) GetHostnameAssociations(ctx context.Context, zoneID, certificateID string) ([]string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []string{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/certificate_authorities/hostname_associations", mutualTLSHostnameAssociationsParams{MTLSCertificateID: certificateID}), nil)
+	if err != nil {
+		return []string{}, err
+	}
+
+	var r MutualTLSHostnameAssociationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []string{}, fmt.Errorf("failed to unmarshal mtls hostname association JSON data: %w", err)
+	}
+
+	return r.Result.Hostnames, nil
+}
+
+// ReplaceHostnameAssociations sets the hostnames of a zone that request
+// client certificates validated by a CA certificate. An empty certificate ID
+// selects the Cloudflare managed CA.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-replace-hostname-associations
+func (s *MutualTLSCertificatesService) ReplaceHostnameAssociations(ctx context.Context, zoneID, certificateID string, hostnames []string) ([]string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []string{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnames == nil {
+		hostnames = []string{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/certificate_authorities/hostname_associations", mutualTLSHostnameAssociations{Hostnames: hostnames, MTLSCertificateID: certificateID})
+	if err != nil {
+		return []string{}, err
+	}
+
+	var r MutualTLSHostnameAssociationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []string{}, fmt.Errorf("failed to unmarshal mtls hostname association JSON data: %w", err)
+	}
+
+	return r.Result.Hostnames, nil
+}
@@ -0,0 +1,139 @@
+package cloudflare
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NetworkAnalyticsDataset is a GraphQL dataset of packet level network
+// analytics for an account.
+type NetworkAnalyticsDataset string
+
+const (
+	// NetworkAnalyticsMagicTransit holds traffic of Magic Transit prefixes.
+	NetworkAnalyticsMagicTransit NetworkAnalyticsDataset = "magicTransitNetworkAnalyticsAdaptiveGroups"
+
+	// NetworkAnalyticsSpectrum holds traffic of Spectrum applications.
+	NetworkAnalyticsSpectrum NetworkAnalyticsDataset = "spectrumNetworkAnalyticsAdaptiveGroups"
+
+	// NetworkAnalyticsDDoS holds traffic seen by the DDoS protection
+	// systems, including the attack it was attributed to and how it was
+	// mitigated.
+	NetworkAnalyticsDDoS NetworkAnalyticsDataset = "dosdNetworkAnalyticsAdaptiveGroups"
+)
+
+// NetworkAnalyticsRollup is the time bucket network analytics rows are
+// grouped by.
+type NetworkAnalyticsRollup string
+
+const (
+	NetworkAnalyticsRollupMinute         NetworkAnalyticsRollup = "datetimeMinute"
+	NetworkAnalyticsRollupFiveMinutes    NetworkAnalyticsRollup = "datetimeFiveMinutes"
+	NetworkAnalyticsRollupFifteenMinutes NetworkAnalyticsRollup = "datetimeFifteenMinutes"
+	NetworkAnalyticsRollupHour           NetworkAnalyticsRollup = "datetimeHour"
+)
+
+// NetworkAnalyticsParams selects the rows returned by NetworkAnalytics.
+// Since is inclusive and Until exclusive.
+//
+// Rows are grouped by Rollup, if set, followed by Dimensions such as
+// "ipProtocolName", "destinationPort", "outcome", "mitigationSystem" or
+// "attackId". Filter holds additional filters in the dataset's filter syntax,
+// for example {"outcome": "drop"}. OrderBy defaults to the rollup ascending.
+type NetworkAnalyticsParams struct {
+	Dataset    NetworkAnalyticsDataset
+	Since      time.Time
+	Until      time.Time
+	Rollup     NetworkAnalyticsRollup
+	Dimensions []string
+	Filter     map[string]interface{}
+	OrderBy    []string
+	Limit      int
+}
+
+// NetworkAnalyticsGroup is a row of a network analytics dataset. Dimensions
+// holds the value of the rollup and of each requested dimension.
+type NetworkAnalyticsGroup struct {
+	Count      int64                  `json:"count"`
+	Dimensions map[string]interface{} `json:"dimensions"`
+	Sum        struct {
+		Bits    int64 `json:"bits"`
+		Packets int64 `json:"packets"`
+	} `json:"sum"`
+	Avg struct {
+		SampleInterval float64 `json:"sampleInterval"`
+	} `json:"avg"`
+}
+
+// NetworkAnalytics returns packet and bit totals for an account from a
+// network analytics dataset.
+func (s *AnalyticsService) NetworkAnalytics(ctx context.Context, accountID string, params NetworkAnalyticsParams) ([]NetworkAnalyticsGroup, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []NetworkAnalyticsGroup{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	switch params.Dataset {
+	case NetworkAnalyticsMagicTransit, NetworkAnalyticsSpectrum, NetworkAnalyticsDDoS:
+	case "":
+		return []NetworkAnalyticsGroup{}, errors.New("dataset must be provided")
+	default:
+		return []NetworkAnalyticsGroup{}, fmt.Errorf("invalid network analytics dataset: %q", params.Dataset)
+	}
+
+	var dimensions []string
+	if params.Rollup != "" {
+		dimensions = append(dimensions, string(params.Rollup))
+	}
+	dimensions = append(dimensions, params.Dimensions...)
+
+	orderBy := params.OrderBy
+	if len(orderBy) == 0 && params.Rollup != "" {
+		orderBy = []string{string(params.Rollup) + "_ASC"}
+	}
+
+	fields := "count sum { bits packets } avg { sampleInterval }"
+	if len(dimensions) > 0 {
+		fields = "count dimensions { " + strings.Join(dimensions, " ") + " } sum { bits packets } avg { sampleInterval }"
+	}
+
+	var groups []NetworkAnalyticsGroup
+	err := s.query(ctx, analyticsQuery{
+		scope:    "accounts",
+		tag:      accountID,
+		dataset:  string(params.Dataset),
+		since:    params.Since,
+		until:    params.Until,
+		filter:   params.Filter,
+		orderBy:  orderBy,
+		limit:    params.Limit,
+		fields:   fields,
+		validate: dimensions,
+	}, &groups)
+	if err != nil {
+		return []NetworkAnalyticsGroup{}, err
+	}
+
+	return groups, nil
+}
+
+// NetworkAttacks returns the traffic of an account attributed to DDoS
+// attacks, grouped by attack and by how it was mitigated, in addition to any
+// requested rollup and dimensions.
+func (s *AnalyticsService) NetworkAttacks(ctx context.Context, accountID string, params NetworkAnalyticsParams) ([]NetworkAnalyticsGroup, error) {
+	params.Dataset = NetworkAnalyticsDDoS
+	params.Dimensions = append([]string{"attackId", "mitigationSystem", "outcome"}, params.Dimensions...)
+
+	filter := make(map[string]interface{}, len(params.Filter)+1)
+	for k, v := range params.Filter {
+		filter[k] = v
+	}
+	if _, ok := filter["attackId_neq"]; !ok {
+		filter["attackId_neq"] = ""
+	}
+	params.Filter = filter
+
+	return s.NetworkAnalytics(ctx, accountID, params)
+}
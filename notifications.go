@@ -0,0 +1,558 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type NotificationsService service
+
+// NotificationMechanisms lists the destinations a notification policy sends
+// alerts to, keyed by mechanism type ("email", "webhooks" or "pagerduty").
+// Email destinations are identified by address and the others by the ID of
+// the configured destination.
+type NotificationMechanisms map[string][]NotificationMechanism
+
+// NotificationMechanism is a single destination of a notification policy.
+type NotificationMechanism struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// NotificationPolicy sends alerts of a single type to a set of destinations.
+//
+// Filters narrows the alerts sent, such as by zone or service, with the
+// available keys depending on AlertType. Conditions are used by alert types
+// with thresholds.
+type NotificationPolicy struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Enabled     bool                   `json:"enabled"`
+	AlertType   string                 `json:"alert_type"`
+	Mechanisms  NotificationMechanisms `json:"mechanisms"`
+	Filters     map[string][]string    `json:"filters,omitempty"`
+	Conditions  map[string]interface{} `json:"conditions,omitempty"`
+	Created     *time.Time             `json:"created,omitempty"`
+	Modified    *time.Time             `json:"modified,omitempty"`
+}
+
+// NotificationAlertType is an alert a notification policy can be created
+// for.
+type NotificationAlertType struct {
+	Type          string                   `json:"type"`
+	DisplayName   string                   `json:"display_name"`
+	Description   string                   `json:"description"`
+	FilterOptions []map[string]interface{} `json:"filter_options,omitempty"`
+}
+
+// NotificationWebhook is a webhook destination. Secret is sent to the
+// webhook in the cf-webhook-auth header and is never returned by the API.
+type NotificationWebhook struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name"`
+	URL         string     `json:"url"`
+	Secret      string     `json:"secret,omitempty"`
+	Type        string     `json:"type,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	LastSuccess *time.Time `json:"last_success,omitempty"`
+	LastFailure *time.Time `json:"last_failure,omitempty"`
+}
+
+// NotificationPagerDuty is a connected PagerDuty service.
+type NotificationPagerDuty struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// NotificationHistory is an alert that was sent.
+type NotificationHistory struct {
+	ID            string     `json:"id"`
+	Name          string     `json:"name"`
+	Description   string     `json:"description"`
+	AlertBody     string     `json:"alert_body"`
+	AlertType     string     `json:"alert_type"`
+	Mechanism     string     `json:"mechanism"`
+	MechanismType string     `json:"mechanism_type"`
+	PolicyID      string     `json:"policy_id"`
+	Sent          *time.Time `json:"sent"`
+}
+
+// NotificationHistoryListParams contains the filters available when listing
+// alert history.
+type NotificationHistoryListParams struct {
+	Since  *time.Time `url:"since,omitempty"`
+	Before *time.Time `url:"before,omitempty"`
+
+	PaginationParams
+}
+
+// NotificationResource identifies a resource created or changed by the
+// notification endpoints, which only return its ID.
+type NotificationResource struct {
+	ID string `json:"id"`
+}
+
+// NotificationResourceResponse represents the response from the
+// notification endpoints that only return an ID.
+type NotificationResourceResponse struct {
+	Response
+	Result NotificationResource `json:"result"`
+}
+
+// NotificationAlertTypesResponse represents the response from the available
+// alerts endpoint, grouping alert types by product.
+type NotificationAlertTypesResponse struct {
+	Response
+	Result map[string][]NotificationAlertType `json:"result"`
+}
+
+// NotificationPolicyResponse represents the response from the notification
+// policies endpoint containing a single policy.
+type NotificationPolicyResponse struct {
+	Response
+	Result NotificationPolicy `json:"result"`
+}
+
+// NotificationPoliciesResponse represents the response from the notification
+// policies endpoint containing multiple policies.
+type NotificationPoliciesResponse struct {
+	Response
+	Result []NotificationPolicy `json:"result"`
+}
+
+// NotificationWebhookResponse represents the response from the webhooks
+// endpoint containing a single webhook.
+type NotificationWebhookResponse struct {
+	Response
+	Result NotificationWebhook `json:"result"`
+}
+
+// NotificationWebhooksResponse represents the response from the webhooks
+// endpoint containing multiple webhooks.
+type NotificationWebhooksResponse struct {
+	Response
+	Result []NotificationWebhook `json:"result"`
+}
+
+// NotificationPagerDutyResponse represents the response from the PagerDuty
+// destinations endpoint.
+type NotificationPagerDutyResponse struct {
+	Response
+	Result []NotificationPagerDuty `json:"result"`
+}
+
+// NotificationHistoryResponse represents the response from the alert history
+// endpoint.
+type NotificationHistoryResponse struct {
+	Response
+	Result     []NotificationHistory `json:"result"`
+	ResultInfo ResultInfo            `json:"result_info"`
+}
+
+// AvailableAlerts returns the alert types notification policies can be
+// created for, grouped by product.
+//
+// API reference: https://api.cloudflare.com/#notification-alert-types-get-alert-types
+func (s *NotificationsService) AvailableAlerts(ctx context.Context, accountID string) (map[string][]NotificationAlertType, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/alerting/v3/available_alerts", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r NotificationAlertTypesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal notification alert types JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListPolicies returns the notification policies of an account.
+//
+// API reference: https://api.cloudflare.com/#notification-policies-list-notification-policies
+func (s *NotificationsService) ListPolicies(ctx context.Context, accountID string) ([]NotificationPolicy, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []NotificationPolicy{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/alerting/v3/policies", nil)
+	if err != nil {
+		return []NotificationPolicy{}, err
+	}
+
+	var r NotificationPoliciesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []NotificationPolicy{}, fmt.Errorf("failed to unmarshal notification policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetPolicy fetches a single notification policy.
+//
+// API reference: https://api.cloudflare.com/#notification-policies-get-a-notification-policy
+func (s *NotificationsService) GetPolicy(ctx context.Context, accountID, policyID string) (NotificationPolicy, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return NotificationPolicy{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if policyID == "" {
+		return NotificationPolicy{}, fmt.Errorf(errMissingResourceID, "notification policy")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/alerting/v3/policies/"+policyID, nil)
+	if err != nil {
+		return NotificationPolicy{}, err
+	}
+
+	var r NotificationPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return NotificationPolicy{}, fmt.Errorf("failed to unmarshal notification policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreatePolicy creates a notification policy, returning its ID.
+//
+// API reference: https://api.cloudflare.com/#notification-policies-create-a-notification-policy
+func (s *NotificationsService) CreatePolicy(ctx context.Context, accountID string, policy NotificationPolicy) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if policy.AlertType == "" {
+		return "", errors.New("alert type must be provided")
+	}
+
+	if len(policy.Mechanisms) == 0 {
+		return "", errors.New("at least one mechanism must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/alerting/v3/policies", policy)
+	if err != nil {
+		return "", err
+	}
+
+	var r NotificationResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal notification policy JSON data: %w", err)
+	}
+
+	return r.Result.ID, nil
+}
+
+// UpdatePolicy replaces the configuration of a notification policy,
+// returning its ID.
+//
+// API reference: https://api.cloudflare.com/#notification-policies-update-a-notification-policy
+func (s *NotificationsService) UpdatePolicy(ctx context.Context, accountID, policyID string, policy NotificationPolicy) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if policyID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "notification policy")
+	}
+
+	if policy.AlertType == "" {
+		return "", errors.New("alert type must be provided")
+	}
+
+	if len(policy.Mechanisms) == 0 {
+		return "", errors.New("at least one mechanism must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/alerting/v3/policies/"+policyID, policy)
+	if err != nil {
+		return "", err
+	}
+
+	var r NotificationResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal notification policy JSON data: %w", err)
+	}
+
+	return r.Result.ID, nil
+}
+
+// DeletePolicy removes a notification policy.
+//
+// API reference: https://api.cloudflare.com/#notification-policies-delete-a-notification-policy
+func (s *NotificationsService) DeletePolicy(ctx context.Context, accountID, policyID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if policyID == "" {
+		return fmt.Errorf(errMissingResourceID, "notification policy")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/alerting/v3/policies/"+policyID, nil)
+	return err
+}
+
+// ListWebhooks returns the webhook destinations of an account.
+//
+// API reference: https://api.cloudflare.com/#notification-webhooks-list-webhooks
+func (s *NotificationsService) ListWebhooks(ctx context.Context, accountID string) ([]NotificationWebhook, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []NotificationWebhook{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/alerting/v3/destinations/webhooks", nil)
+	if err != nil {
+		return []NotificationWebhook{}, err
+	}
+
+	var r NotificationWebhooksResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []NotificationWebhook{}, fmt.Errorf("failed to unmarshal notification webhook JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetWebhook fetches a single webhook destination.
+//
+// API reference: https://api.cloudflare.com/#notification-webhooks-get-a-webhook
+func (s *NotificationsService) GetWebhook(ctx context.Context, accountID, webhookID string) (NotificationWebhook, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return NotificationWebhook{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if webhookID == "" {
+		return NotificationWebhook{}, fmt.Errorf(errMissingResourceID, "notification webhook")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/alerting/v3/destinations/webhooks/"+webhookID, nil)
+	if err != nil {
+		return NotificationWebhook{}, err
+	}
+
+	var r NotificationWebhookResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return NotificationWebhook{}, fmt.Errorf("failed to unmarshal notification webhook JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateWebhook creates a webhook destination, returning its ID. Cloudflare
+// verifies the webhook by sending it a test notification and the webhook is
+// only created if the URL responds successfully.
+//
+// API reference: https://api.cloudflare.com/#notification-webhooks-create-a-webhook
+func (s *NotificationsService) CreateWebhook(ctx context.Context, accountID string, webhook NotificationWebhook) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if webhook.Name == "" || webhook.URL == "" {
+		return "", errors.New("name and url must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/alerting/v3/destinations/webhooks", webhook)
+	if err != nil {
+		return "", err
+	}
+
+	var r NotificationResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal notification webhook JSON data: %w", err)
+	}
+
+	return r.Result.ID, nil
+}
+
+// UpdateWebhook replaces the configuration of a webhook destination,
+// returning its ID. Changing the URL verifies it again.
+//
+// API reference: https://api.cloudflare.com/#notification-webhooks-update-a-webhook
+func (s *NotificationsService) UpdateWebhook(ctx context.Context, accountID, webhookID string, webhook NotificationWebhook) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if webhookID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "notification webhook")
+	}
+
+	if webhook.Name == "" || webhook.URL == "" {
+		return "", errors.New("name and url must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/alerting/v3/destinations/webhooks/"+webhookID, webhook)
+	if err != nil {
+		return "", err
+	}
+
+	var r NotificationResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal notification webhook JSON data: %w", err)
+	}
+
+	return r.Result.ID, nil
+}
+
+// DeleteWebhook removes a webhook destination. Policies sending to it stop
+// using it.
+//
+// API reference: https://api.cloudflare.com/#notification-webhooks-delete-a-webhook
+func (s *NotificationsService) DeleteWebhook(ctx context.Context, accountID, webhookID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if webhookID == "" {
+		return fmt.Errorf(errMissingResourceID, "notification webhook")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/alerting/v3/destinations/webhooks/"+webhookID, nil)
+	return err
+}
+
+// ListPagerDuty returns the PagerDuty services connected to an account.
+//
+// API reference: https://api.cloudflare.com/#notification-destinations-with-pagerduty-list-pagerduty-services
+func (s *NotificationsService) ListPagerDuty(ctx context.Context, accountID string) ([]NotificationPagerDuty, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []NotificationPagerDuty{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/alerting/v3/destinations/pagerduty", nil)
+	if err != nil {
+		return []NotificationPagerDuty{}, err
+	}
+
+	var r NotificationPagerDutyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []NotificationPagerDuty{}, fmt.Errorf("failed to unmarshal notification pagerduty JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ConnectPagerDuty starts connecting PagerDuty to an account, returning a
+// token. The user must authorize the connection in PagerDuty before it is
+// confirmed with VerifyPagerDuty.
+//
+// API reference: https://api.cloudflare.com/#notification-destinations-with-pagerduty-create-pagerduty-integration-token
+func (s *NotificationsService) ConnectPagerDuty(ctx context.Context, accountID string) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/alerting/v3/destinations/pagerduty/connect", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r NotificationResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal notification pagerduty JSON data: %w", err)
+	}
+
+	return r.Result.ID, nil
+}
+
+// VerifyPagerDuty confirms a PagerDuty connection started with
+// ConnectPagerDuty, returning the ID of the connected destination.
+//
+// API reference: https://api.cloudflare.com/#notification-destinations-with-pagerduty-connect-pagerduty
+func (s *NotificationsService) VerifyPagerDuty(ctx context.Context, accountID, token string) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if token == "" {
+		return "", fmt.Errorf(errMissingResourceID, "pagerduty token")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/alerting/v3/destinations/pagerduty/connect/"+token, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r NotificationResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal notification pagerduty JSON data: %w", err)
+	}
+
+	return r.Result.ID, nil
+}
+
+// DeletePagerDuty disconnects every PagerDuty service from an account.
+//
+// API reference: https://api.cloudflare.com/#notification-destinations-with-pagerduty-delete-pagerduty-services
+func (s *NotificationsService) DeletePagerDuty(ctx context.Context, accountID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/alerting/v3/destinations/pagerduty", nil)
+	return err
+}
+
+// History returns the alerts sent for an account that match the provided
+// `NotificationHistoryListParams`. Every page is fetched unless params.Page
+// is set.
+//
+// API reference: https://api.cloudflare.com/#notification-history-list-history
+func (s *NotificationsService) History(ctx context.Context, accountID string, params NotificationHistoryListParams) ([]NotificationHistory, ResultInfo, error) {
+	var history []NotificationHistory
+	var info ResultInfo
+	err := s.ForEachHistoryPage(ctx, accountID, params, func(page []NotificationHistory, pageInfo ResultInfo) bool {
+		history = append(history, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []NotificationHistory{}, ResultInfo{}, err
+	}
+
+	return history, info, nil
+}
+
+// ForEachHistoryPage is like History but passes each page of results to fn as
+// it is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#notification-history-list-history
+func (s *NotificationsService) ForEachHistoryPage(ctx context.Context, accountID string, params NotificationHistoryListParams, fn func(items []NotificationHistory, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/alerting/v3/history", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r NotificationHistoryResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal notification history JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
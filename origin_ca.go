@@ -0,0 +1,258 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type OriginCACertificatesService service
+
+const originCACertificatesPath = "/certificates"
+
+// OriginCARequestType is the type of key a certificate is issued for.
+type OriginCARequestType string
+
+// OriginCAValidity is the number of days an Origin CA certificate is valid for.
+type OriginCAValidity int
+
+const (
+	OriginCARequestTypeRSA     OriginCARequestType = "origin-rsa"
+	OriginCARequestTypeECC     OriginCARequestType = "origin-ecc"
+	OriginCARequestTypeKeyless OriginCARequestType = "keyless-certificate"
+
+	OriginCAValidity7Days   OriginCAValidity = 7
+	OriginCAValidity30Days  OriginCAValidity = 30
+	OriginCAValidity90Days  OriginCAValidity = 90
+	OriginCAValidity1Year   OriginCAValidity = 365
+	OriginCAValidity2Years  OriginCAValidity = 730
+	OriginCAValidity3Years  OriginCAValidity = 1095
+	OriginCAValidity15Years OriginCAValidity = 5475
+)
+
+// OriginCACertificate represents a Cloudflare-issued certificate.
+type OriginCACertificate struct {
+	ID              string              `json:"id"`
+	Certificate     string              `json:"certificate"`
+	Hostnames       []string            `json:"hostnames"`
+	ExpiresOn       time.Time           `json:"expires_on"`
+	RequestType     OriginCARequestType `json:"request_type"`
+	RequestValidity OriginCAValidity    `json:"requested_validity"`
+	RevokedAt       time.Time           `json:"revoked_at,omitempty"`
+	CSR             string              `json:"csr"`
+}
+
+// OriginCACertificateResponse represents the response from the Origin CA
+// endpoint containing a single certificate.
+type OriginCACertificateResponse struct {
+	Response
+	Result OriginCACertificate `json:"result"`
+}
+
+// OriginCACertificatesResponse represents the response from the Origin CA
+// endpoint containing multiple certificates.
+type OriginCACertificatesResponse struct {
+	Response
+	Result     []OriginCACertificate `json:"result"`
+	ResultInfo ResultInfo            `json:"result_info"`
+}
+
+// OriginCACertificateID represents the ID of the revoked certificate from the
+// revoke certificate endpoint.
+type OriginCACertificateID struct {
+	ID        string    `json:"id"`
+	RevokedAt time.Time `json:"revoked_at,omitempty"`
+}
+
+// OriginCACertificateIDResponse represents the response from the revoke
+// certificate endpoint.
+type OriginCACertificateIDResponse struct {
+	Response
+	Result OriginCACertificateID `json:"result"`
+}
+
+// OriginCACertificateCreateParams contains the details needed to issue a new
+// certificate. CSR must be PEM encoded; see GenerateOriginCACSR.
+type OriginCACertificateCreateParams struct {
+	CSR             string              `json:"csr"`
+	Hostnames       []string            `json:"hostnames"`
+	RequestType     OriginCARequestType `json:"request_type"`
+	RequestValidity OriginCAValidity    `json:"requested_validity,omitempty"`
+}
+
+// OriginCACertificateListParams contains the filters available when listing
+// certificates.
+type OriginCACertificateListParams struct {
+	ZoneID string `url:"zone_id,omitempty"`
+
+	PaginationParams
+}
+
+// Create issues a new Origin CA certificate for the provided CSR.
+//
+// This endpoint requires the Origin CA key (`UserServiceKey`) or an API token
+// with the appropriate permissions.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-create-certificate
+func (s *OriginCACertificatesService) Create(ctx context.Context, params OriginCACertificateCreateParams) (OriginCACertificate, error) {
+	if params.CSR == "" {
+		return OriginCACertificate{}, errors.New("a CSR is required to create an Origin CA certificate")
+	}
+
+	if len(params.Hostnames) == 0 {
+		return OriginCACertificate{}, errors.New("at least one hostname is required to create an Origin CA certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, originCACertificatesPath, params)
+	if err != nil {
+		return OriginCACertificate{}, err
+	}
+
+	var r OriginCACertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return OriginCACertificate{}, fmt.Errorf("failed to unmarshal origin CA certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// List returns all Origin CA certificates for a zone.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-list-certificates
+func (s *OriginCACertificatesService) List(ctx context.Context, params OriginCACertificateListParams) ([]OriginCACertificate, ResultInfo, error) {
+	var certs []OriginCACertificate
+	var info ResultInfo
+	err := s.ForEachPage(ctx, params, func(page []OriginCACertificate, pageInfo ResultInfo) bool {
+		certs = append(certs, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []OriginCACertificate{}, ResultInfo{}, err
+	}
+
+	return certs, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-list-certificates
+func (s *OriginCACertificatesService) ForEachPage(ctx context.Context, params OriginCACertificateListParams, fn func(items []OriginCACertificate, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(params.ZoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, params.ZoneID)
+	}
+
+	return s.client.listPages(ctx, originCACertificatesPath, &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r OriginCACertificatesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal origin CA certificate JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single Origin CA certificate.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-get-certificate
+func (s *OriginCACertificatesService) Get(ctx context.Context, certificateID string) (OriginCACertificate, error) {
+	if certificateID == "" {
+		return OriginCACertificate{}, fmt.Errorf(errMissingResourceID, "certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, originCACertificatesPath+"/"+certificateID, nil)
+	if err != nil {
+		return OriginCACertificate{}, err
+	}
+
+	var r OriginCACertificateResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return OriginCACertificate{}, fmt.Errorf("failed to unmarshal origin CA certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Revoke revokes an Origin CA certificate. Revoked certificates can no longer
+// be used to secure connections between Cloudflare and the origin.
+//
+// API reference: https://api.cloudflare.com/#origin-ca-revoke-certificate
+func (s *OriginCACertificatesService) Revoke(ctx context.Context, certificateID string) (OriginCACertificateID, error) {
+	if certificateID == "" {
+		return OriginCACertificateID{}, fmt.Errorf(errMissingResourceID, "certificate")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodDelete, originCACertificatesPath+"/"+certificateID, nil)
+	if err != nil {
+		return OriginCACertificateID{}, err
+	}
+
+	var r OriginCACertificateIDResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return OriginCACertificateID{}, fmt.Errorf("failed to unmarshal origin CA certificate JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GenerateOriginCACSR creates a new private key suitable for the request type
+// along with a PEM encoded certificate signing request covering hostnames. The
+// first hostname is used as the common name. Both values are returned PEM
+// encoded; the private key must be kept alongside the issued certificate.
+func GenerateOriginCACSR(requestType OriginCARequestType, hostnames []string) (csrPEM, keyPEM []byte, err error) {
+	if len(hostnames) == 0 {
+		return nil, nil, errors.New("at least one hostname is required to generate a CSR")
+	}
+
+	var key crypto.Signer
+	switch requestType {
+	case OriginCARequestTypeRSA, OriginCARequestTypeKeyless:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case OriginCARequestTypeECC:
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, nil, fmt.Errorf("unsupported Origin CA request type: %s", requestType)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate private key: %w", err)
+	}
+
+	template := &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: strings.TrimPrefix(hostnames[0], "*.")},
+		DNSNames: hostnames,
+	}
+
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate request: %w", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal private key: %w", err)
+	}
+
+	csrPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+
+	return csrPEM, keyPEM, nil
+}
@@ -0,0 +1,104 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+)
+
+// OriginRuleParams describes how an origin rule changes where requests are
+// sent. Fields left empty are not changed.
+//
+// HostHeader replaces the Host header sent to the origin. ResolveOverride
+// resolves the origin using another DNS record of the zone, which must be
+// proxied. Port replaces the destination port. SNI replaces the Server Name
+// Indication sent to the origin.
+type OriginRuleParams struct {
+	HostHeader      string
+	ResolveOverride string
+	Port            uint16
+	SNI             string
+}
+
+// OriginRule returns a rule of the http_request_origin phase applying params
+// to requests matching expression.
+func OriginRule(expression, description string, params OriginRuleParams) (RulesetRule, error) {
+	if expression == "" {
+		return RulesetRule{}, errors.New("expression must be provided")
+	}
+
+	action := &RulesetRuleActionParameters{HostHeader: params.HostHeader}
+	if params.ResolveOverride != "" || params.Port != 0 {
+		action.Origin = &RulesetRuleActionParametersOrigin{Host: params.ResolveOverride, Port: params.Port}
+	}
+	if params.SNI != "" {
+		action.SNI = &RulesetRuleActionParametersSNI{Value: params.SNI}
+	}
+
+	rule := RulesetRule{
+		Action:           RulesetRuleActionRoute,
+		Expression:       expression,
+		Description:      description,
+		Enabled:          Bool(true),
+		ActionParameters: action,
+	}
+
+	if err := ValidateOriginRule(rule); err != nil {
+		return RulesetRule{}, err
+	}
+
+	return rule, nil
+}
+
+// ValidateOriginRule checks a rule of the http_request_origin phase before it
+// is sent to the API. The rule must use the route action, override at least
+// one of the host header, origin and SNI, and must not carry the parameters
+// of other actions, which the API rejects alongside route parameters.
+func ValidateOriginRule(rule RulesetRule) error {
+	if rule.Action != RulesetRuleActionRoute {
+		return fmt.Errorf("origin rules must use the %s action, got %q", RulesetRuleActionRoute, rule.Action)
+	}
+
+	p := rule.ActionParameters
+	if p == nil || (p.HostHeader == "" && p.Origin == nil && p.SNI == nil) {
+		return errors.New("a host header, origin or SNI override must be provided")
+	}
+
+	others := *p
+	others.HostHeader, others.Origin, others.SNI = "", nil, nil
+	if !reflect.DeepEqual(others, RulesetRuleActionParameters{}) {
+		return errors.New("route parameters cannot be combined with the parameters of other actions")
+	}
+
+	if p.HostHeader != "" && !isValidOriginHostname(p.HostHeader) {
+		return fmt.Errorf("invalid host header override: %q", p.HostHeader)
+	}
+
+	if p.Origin != nil {
+		if p.Origin.Host == "" && p.Origin.Port == 0 {
+			return errors.New("origin override must set a host or port")
+		}
+
+		// Resolve overrides name a DNS record so addresses are not accepted.
+		if p.Origin.Host != "" && (!isValidOriginHostname(p.Origin.Host) || net.ParseIP(p.Origin.Host) != nil) {
+			return fmt.Errorf("invalid resolve override: %q must be a hostname of the zone", p.Origin.Host)
+		}
+	}
+
+	if p.SNI != nil {
+		// SNI carries a hostname; IP addresses are not permitted by RFC 6066.
+		if !isValidOriginHostname(p.SNI.Value) || net.ParseIP(p.SNI.Value) != nil {
+			return fmt.Errorf("invalid SNI override: %q must be a hostname", p.SNI.Value)
+		}
+	}
+
+	return nil
+}
+
+// isValidOriginHostname reports whether host is a bare hostname, without a
+// scheme, port or path.
+func isValidOriginHostname(host string) bool {
+	return host != "" && host == strings.TrimSpace(host) && !strings.ContainsAny(host, ":/?#@ ")
+}
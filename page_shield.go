@@ -0,0 +1,412 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type PageShieldService service
+
+// PageShieldSettings is the Page Shield configuration of a zone.
+type PageShieldSettings struct {
+	Enabled                        *bool      `json:"enabled,omitempty"`
+	UseCloudflareReportingEndpoint *bool      `json:"use_cloudflare_reporting_endpoint,omitempty"`
+	UseConnectionURLPath           *bool      `json:"use_connection_url_path,omitempty"`
+	UpdatedAt                      *time.Time `json:"updated_at,omitempty"`
+}
+
+// PageShieldResource is a script or connection seen on the pages of a zone.
+// The scores range from 1, likely malicious, to 99, likely benign.
+type PageShieldResource struct {
+	ID                        string                    `json:"id"`
+	URL                       string                    `json:"url"`
+	Host                      string                    `json:"host"`
+	AddedAt                   *time.Time                `json:"added_at,omitempty"`
+	FirstSeenAt               *time.Time                `json:"first_seen_at,omitempty"`
+	LastSeenAt                *time.Time                `json:"last_seen_at,omitempty"`
+	FirstPageURL              string                    `json:"first_page_url"`
+	PageURLs                  []string                  `json:"page_urls"`
+	URLContainsCdnCgiPath     bool                      `json:"url_contains_cdn_cgi_path"`
+	DomainReportedMalicious   bool                      `json:"domain_reported_malicious"`
+	URLReportedMalicious      bool                      `json:"url_reported_malicious"`
+	MaliciousDomainCategories []string                  `json:"malicious_domain_categories,omitempty"`
+	MaliciousURLCategories    []string                  `json:"malicious_url_categories,omitempty"`
+	JSIntegrityScore          int                       `json:"js_integrity_score,omitempty"`
+	FetchedAt                 *time.Time                `json:"fetched_at,omitempty"`
+	Hash                      string                    `json:"hash,omitempty"`
+	Versions                  []PageShieldScriptVersion `json:"versions,omitempty"`
+}
+
+// PageShieldScriptVersion is a version of a script's contents.
+type PageShieldScriptVersion struct {
+	Hash             string     `json:"hash"`
+	JSIntegrityScore int        `json:"js_integrity_score"`
+	FetchedAt        *time.Time `json:"fetched_at,omitempty"`
+}
+
+// PageShieldListParams contains the filters available when listing scripts
+// and connections. URLs, Hosts and ExcludeURLs are comma separated lists of
+// patterns; Status is a comma separated list of "active", "infrequent" and
+// "inactive".
+type PageShieldListParams struct {
+	URLs                string `url:"urls,omitempty"`
+	Hosts               string `url:"hosts,omitempty"`
+	ExcludeURLs         string `url:"exclude_urls,omitempty"`
+	Status              string `url:"status,omitempty"`
+	OrderBy             string `url:"order_by,omitempty"`
+	PrioritizeMalicious *bool  `url:"prioritize_malicious,omitempty"`
+	ExcludeCdnCgi       *bool  `url:"exclude_cdn_cgi,omitempty"`
+	ExcludeDuplicates   *bool  `url:"exclude_duplicates,omitempty"`
+
+	PaginationParams
+}
+
+// PageShieldPolicy applies a Content Security Policy to the pages matching
+// Expression. Action is "allow" to enforce the policy or "log" to only
+// report violations. Value is the CSP directive list.
+type PageShieldPolicy struct {
+	ID          string `json:"id,omitempty"`
+	Description string `json:"description"`
+	Action      string `json:"action"`
+	Expression  string `json:"expression"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+	Value       string `json:"value"`
+}
+
+// PageShieldSettingsResponse represents the response from the Page Shield
+// settings endpoint.
+type PageShieldSettingsResponse struct {
+	Response
+	Result PageShieldSettings `json:"result"`
+}
+
+// PageShieldResourceResponse represents the response from the scripts and
+// connections endpoints containing a single resource.
+type PageShieldResourceResponse struct {
+	Response
+	Result PageShieldResource `json:"result"`
+}
+
+// PageShieldResourcesResponse represents the response from the scripts and
+// connections endpoints containing multiple resources.
+type PageShieldResourcesResponse struct {
+	Response
+	Result     []PageShieldResource `json:"result"`
+	ResultInfo ResultInfo           `json:"result_info"`
+}
+
+// PageShieldPolicyResponse represents the response from the Page Shield
+// policies endpoint containing a single policy.
+type PageShieldPolicyResponse struct {
+	Response
+	Result PageShieldPolicy `json:"result"`
+}
+
+// PageShieldPoliciesResponse represents the response from the Page Shield
+// policies endpoint containing multiple policies.
+type PageShieldPoliciesResponse struct {
+	Response
+	Result []PageShieldPolicy `json:"result"`
+}
+
+// GetSettings returns the Page Shield configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#page-shield-get-page-shield-settings
+func (s *PageShieldService) GetSettings(ctx context.Context, zoneID string) (PageShieldSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return PageShieldSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/page_shield", nil)
+	if err != nil {
+		return PageShieldSettings{}, err
+	}
+
+	var r PageShieldSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldSettings{}, fmt.Errorf("failed to unmarshal page shield settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSettings changes the Page Shield configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#page-shield-update-page-shield-settings
+func (s *PageShieldService) UpdateSettings(ctx context.Context, zoneID string, settings PageShieldSettings) (PageShieldSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return PageShieldSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/page_shield", settings)
+	if err != nil {
+		return PageShieldSettings{}, err
+	}
+
+	var r PageShieldSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldSettings{}, fmt.Errorf("failed to unmarshal page shield settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListScripts returns the scripts seen on the pages of a zone that match the
+// provided `PageShieldListParams`.
+//
+// API reference: https://api.cloudflare.com/#page-shield-list-page-shield-scripts
+func (s *PageShieldService) ListScripts(ctx context.Context, zoneID string, params PageShieldListParams) ([]PageShieldResource, ResultInfo, error) {
+	var scripts []PageShieldResource
+	var info ResultInfo
+	err := s.ForEachScriptsPage(ctx, zoneID, params, func(page []PageShieldResource, pageInfo ResultInfo) bool {
+		scripts = append(scripts, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []PageShieldResource{}, ResultInfo{}, err
+	}
+
+	return scripts, info, nil
+}
+
+// ForEachScriptsPage is like ListScripts but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#page-shield-list-page-shield-scripts
+func (s *PageShieldService) ForEachScriptsPage(ctx context.Context, zoneID string, params PageShieldListParams, fn func(items []PageShieldResource, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/page_shield/scripts", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r PageShieldResourcesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal page shield script JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetScript fetches a single script, including the versions of its contents.
+//
+// API reference: https://api.cloudflare.com/#page-shield-get-a-page-shield-script
+func (s *PageShieldService) GetScript(ctx context.Context, zoneID, scriptID string) (PageShieldResource, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return PageShieldResource{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if scriptID == "" {
+		return PageShieldResource{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/page_shield/scripts/"+scriptID, nil)
+	if err != nil {
+		return PageShieldResource{}, err
+	}
+
+	var r PageShieldResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldResource{}, fmt.Errorf("failed to unmarshal page shield script JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListConnections returns the connections seen on the pages of a zone that match the
+// provided `PageShieldListParams`.
+//
+// API reference: https://api.cloudflare.com/#page-shield-list-page-shield-connections
+func (s *PageShieldService) ListConnections(ctx context.Context, zoneID string, params PageShieldListParams) ([]PageShieldResource, ResultInfo, error) {
+	var connections []PageShieldResource
+	var info ResultInfo
+	err := s.ForEachConnectionsPage(ctx, zoneID, params, func(page []PageShieldResource, pageInfo ResultInfo) bool {
+		connections = append(connections, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []PageShieldResource{}, ResultInfo{}, err
+	}
+
+	return connections, info, nil
+}
+
+// ForEachConnectionsPage is like ListConnections but passes each page of
+// results to fn as it is fetched instead of collecting them. Paging stops
+// early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#page-shield-list-page-shield-connections
+func (s *PageShieldService) ForEachConnectionsPage(ctx context.Context, zoneID string, params PageShieldListParams, fn func(items []PageShieldResource, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/page_shield/connections", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r PageShieldResourcesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal page shield connection JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetConnection fetches a single connection.
+//
+// API reference: https://api.cloudflare.com/#page-shield-get-a-page-shield-connection
+func (s *PageShieldService) GetConnection(ctx context.Context, zoneID, connectionID string) (PageShieldResource, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return PageShieldResource{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if connectionID == "" {
+		return PageShieldResource{}, fmt.Errorf(errMissingResourceID, "connection")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/page_shield/connections/"+connectionID, nil)
+	if err != nil {
+		return PageShieldResource{}, err
+	}
+
+	var r PageShieldResourceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldResource{}, fmt.Errorf("failed to unmarshal page shield connection JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListPolicies returns the Page Shield policies of a zone.
+//
+// API reference: https://api.cloudflare.com/#page-shield-list-page-shield-policies
+func (s *PageShieldService) ListPolicies(ctx context.Context, zoneID string) ([]PageShieldPolicy, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []PageShieldPolicy{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/page_shield/policies", nil)
+	if err != nil {
+		return []PageShieldPolicy{}, err
+	}
+
+	var r PageShieldPoliciesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PageShieldPolicy{}, fmt.Errorf("failed to unmarshal page shield policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetPolicy fetches a single Page Shield policy.
+//
+// API reference: https://api.cloudflare.com/#page-shield-get-a-page-shield-policy
+func (s *PageShieldService) GetPolicy(ctx context.Context, zoneID, policyID string) (PageShieldPolicy, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return PageShieldPolicy{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if policyID == "" {
+		return PageShieldPolicy{}, fmt.Errorf(errMissingResourceID, "page shield policy")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/page_shield/policies/"+policyID, nil)
+	if err != nil {
+		return PageShieldPolicy{}, err
+	}
+
+	var r PageShieldPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldPolicy{}, fmt.Errorf("failed to unmarshal page shield policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreatePolicy creates a Page Shield policy.
+//
+// API reference: https://api.cloudflare.com/#page-shield-create-a-page-shield-policy
+func (s *PageShieldService) CreatePolicy(ctx context.Context, zoneID string, policy PageShieldPolicy) (PageShieldPolicy, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return PageShieldPolicy{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if policy.Action != "allow" && policy.Action != "log" {
+		return PageShieldPolicy{}, errors.New(`policy action must be "allow" or "log"`)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/page_shield/policies", policy)
+	if err != nil {
+		return PageShieldPolicy{}, err
+	}
+
+	var r PageShieldPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldPolicy{}, fmt.Errorf("failed to unmarshal page shield policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdatePolicy replaces a Page Shield policy.
+//
+// API reference: https://api.cloudflare.com/#page-shield-update-a-page-shield-policy
+func (s *PageShieldService) UpdatePolicy(ctx context.Context, zoneID, policyID string, policy PageShieldPolicy) (PageShieldPolicy, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return PageShieldPolicy{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if policyID == "" {
+		return PageShieldPolicy{}, fmt.Errorf(errMissingResourceID, "page shield policy")
+	}
+
+	if policy.Action != "allow" && policy.Action != "log" {
+		return PageShieldPolicy{}, errors.New(`policy action must be "allow" or "log"`)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/page_shield/policies/"+policyID, policy)
+	if err != nil {
+		return PageShieldPolicy{}, err
+	}
+
+	var r PageShieldPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldPolicy{}, fmt.Errorf("failed to unmarshal page shield policy JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeletePolicy removes a Page Shield policy.
+//
+// API reference: https://api.cloudflare.com/#page-shield-delete-a-page-shield-policy
+func (s *PageShieldService) DeletePolicy(ctx context.Context, zoneID, policyID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if policyID == "" {
+		return fmt.Errorf(errMissingResourceID, "page shield policy")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/page_shield/policies/"+policyID, nil)
+	return err
+}
@@ -0,0 +1,626 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"time"
+)
+
+type PagesService service
+
+// PagesEnvVarType controls whether an environment variable is shown in plain
+// text or encrypted as a secret.
+type PagesEnvVarType string
+
+const (
+	PagesEnvVarTypePlainText  PagesEnvVarType = "plain_text"
+	PagesEnvVarTypeSecretText PagesEnvVarType = "secret_text"
+)
+
+// PagesDeploymentEnvironment is the environment a deployment belongs to.
+type PagesDeploymentEnvironment string
+
+const (
+	PagesDeploymentEnvironmentProduction PagesDeploymentEnvironment = "production"
+	PagesDeploymentEnvironmentPreview    PagesDeploymentEnvironment = "preview"
+)
+
+// PagesProject is a Cloudflare Pages project.
+type PagesProject struct {
+	ID                  string                        `json:"id,omitempty"`
+	Name                string                        `json:"name"`
+	Subdomain           string                        `json:"subdomain,omitempty"`
+	Domains             []string                      `json:"domains,omitempty"`
+	Source              *PagesProjectSource           `json:"source,omitempty"`
+	BuildConfig         PagesProjectBuildConfig       `json:"build_config"`
+	DeploymentConfigs   PagesProjectDeploymentConfigs `json:"deployment_configs"`
+	LatestDeployment    *PagesDeployment              `json:"latest_deployment,omitempty"`
+	CanonicalDeployment *PagesDeployment              `json:"canonical_deployment,omitempty"`
+	ProductionBranch    string                        `json:"production_branch,omitempty"`
+	CreatedOn           *time.Time                    `json:"created_on,omitempty"`
+}
+
+// PagesProjectSource is the git repository a project builds from.
+type PagesProjectSource struct {
+	Type   string                    `json:"type"`
+	Config *PagesProjectSourceConfig `json:"config"`
+}
+
+// PagesProjectSourceConfig configures how commits to the source repository
+// are deployed.
+type PagesProjectSourceConfig struct {
+	Owner                        string   `json:"owner"`
+	RepoName                     string   `json:"repo_name"`
+	ProductionBranch             string   `json:"production_branch"`
+	PRCommentsEnabled            bool     `json:"pr_comments_enabled"`
+	DeploymentsEnabled           bool     `json:"deployments_enabled"`
+	ProductionDeploymentsEnabled bool     `json:"production_deployments_enabled"`
+	PreviewDeploymentSetting     string   `json:"preview_deployment_setting,omitempty"`
+	PreviewBranchIncludes        []string `json:"preview_branch_includes,omitempty"`
+	PreviewBranchExcludes        []string `json:"preview_branch_excludes,omitempty"`
+}
+
+// PagesProjectBuildConfig describes how a project is built.
+type PagesProjectBuildConfig struct {
+	BuildCaching      *bool  `json:"build_caching,omitempty"`
+	BuildCommand      string `json:"build_command"`
+	DestinationDir    string `json:"destination_dir"`
+	RootDir           string `json:"root_dir"`
+	WebAnalyticsTag   string `json:"web_analytics_tag,omitempty"`
+	WebAnalyticsToken string `json:"web_analytics_token,omitempty"`
+}
+
+// PagesProjectDeploymentConfigs holds the per-environment configuration of a
+// project.
+type PagesProjectDeploymentConfigs struct {
+	Preview    PagesProjectDeploymentConfigEnvironment `json:"preview"`
+	Production PagesProjectDeploymentConfigEnvironment `json:"production"`
+}
+
+// PagesProjectDeploymentConfigEnvironment is the configuration applied to
+// deployments in a single environment.
+//
+// Setting an entry of EnvVars to nil removes that variable when updating a
+// project.
+type PagesProjectDeploymentConfigEnvironment struct {
+	EnvVars                          map[string]*PagesEnvVar              `json:"env_vars,omitempty"`
+	CompatibilityDate                string                               `json:"compatibility_date,omitempty"`
+	CompatibilityFlags               []string                             `json:"compatibility_flags,omitempty"`
+	AlwaysUseLatestCompatibilityDate bool                                 `json:"always_use_latest_compatibility_date"`
+	FailOpen                         bool                                 `json:"fail_open"`
+	UsageModel                       string                               `json:"usage_model,omitempty"`
+	KVNamespaces                     map[string]PagesKVNamespaceBinding   `json:"kv_namespaces,omitempty"`
+	DurableObjectNamespaces          map[string]PagesDurableObjectBinding `json:"durable_object_namespaces,omitempty"`
+	D1Databases                      map[string]PagesD1Binding            `json:"d1_databases,omitempty"`
+	R2Buckets                        map[string]PagesR2Binding            `json:"r2_buckets,omitempty"`
+}
+
+// PagesEnvVar is an environment variable available to builds and Functions.
+type PagesEnvVar struct {
+	Type  PagesEnvVarType `json:"type"`
+	Value string          `json:"value"`
+}
+
+// PagesKVNamespaceBinding binds a Workers KV namespace to Pages Functions.
+type PagesKVNamespaceBinding struct {
+	NamespaceID string `json:"namespace_id"`
+}
+
+// PagesDurableObjectBinding binds a Durable Object namespace to Pages
+// Functions.
+type PagesDurableObjectBinding struct {
+	NamespaceID string `json:"namespace_id"`
+}
+
+// PagesD1Binding binds a D1 database to Pages Functions.
+type PagesD1Binding struct {
+	ID string `json:"id"`
+}
+
+// PagesR2Binding binds an R2 bucket to Pages Functions.
+type PagesR2Binding struct {
+	Name string `json:"name"`
+}
+
+// PagesDeployment is a single deployment of a Pages project.
+type PagesDeployment struct {
+	ID                string                     `json:"id"`
+	ShortID           string                     `json:"short_id"`
+	ProjectID         string                     `json:"project_id"`
+	ProjectName       string                     `json:"project_name"`
+	Environment       PagesDeploymentEnvironment `json:"environment"`
+	URL               string                     `json:"url"`
+	CreatedOn         *time.Time                 `json:"created_on,omitempty"`
+	ModifiedOn        *time.Time                 `json:"modified_on,omitempty"`
+	Aliases           []string                   `json:"aliases,omitempty"`
+	LatestStage       PagesDeploymentStage       `json:"latest_stage"`
+	Stages            []PagesDeploymentStage     `json:"stages"`
+	DeploymentTrigger PagesDeploymentTrigger     `json:"deployment_trigger"`
+	BuildConfig       PagesProjectBuildConfig    `json:"build_config"`
+	Source            *PagesProjectSource        `json:"source,omitempty"`
+	EnvVars           map[string]*PagesEnvVar    `json:"env_vars,omitempty"`
+	IsSkipped         bool                       `json:"is_skipped"`
+	ProductionBranch  string                     `json:"production_branch,omitempty"`
+}
+
+// PagesDeploymentStage is one step (queued, initialize, clone_repo, build,
+// deploy) of a deployment.
+type PagesDeploymentStage struct {
+	Name      string     `json:"name"`
+	StartedOn *time.Time `json:"started_on,omitempty"`
+	EndedOn   *time.Time `json:"ended_on,omitempty"`
+	Status    string     `json:"status"`
+}
+
+// PagesDeploymentTrigger describes what caused a deployment.
+type PagesDeploymentTrigger struct {
+	Type     string                          `json:"type"`
+	Metadata *PagesDeploymentTriggerMetadata `json:"metadata,omitempty"`
+}
+
+// PagesDeploymentTriggerMetadata is the commit a deployment was built from.
+type PagesDeploymentTriggerMetadata struct {
+	Branch        string `json:"branch"`
+	CommitHash    string `json:"commit_hash"`
+	CommitMessage string `json:"commit_message"`
+}
+
+// PagesProjectResponse represents the response from the Pages projects
+// endpoint containing a single project.
+type PagesProjectResponse struct {
+	Response
+	Result PagesProject `json:"result"`
+}
+
+// PagesProjectsResponse represents the response from the Pages projects
+// endpoint containing multiple projects.
+type PagesProjectsResponse struct {
+	Response
+	Result     []PagesProject `json:"result"`
+	ResultInfo ResultInfo     `json:"result_info"`
+}
+
+// PagesDeploymentResponse represents the response from the Pages deployments
+// endpoint containing a single deployment.
+type PagesDeploymentResponse struct {
+	Response
+	Result PagesDeployment `json:"result"`
+}
+
+// PagesDeploymentsResponse represents the response from the Pages
+// deployments endpoint containing multiple deployments.
+type PagesDeploymentsResponse struct {
+	Response
+	Result     []PagesDeployment `json:"result"`
+	ResultInfo ResultInfo        `json:"result_info"`
+}
+
+// PagesProjectListParams contains the options available when listing Pages
+// projects.
+type PagesProjectListParams struct {
+	PaginationParams
+}
+
+// PagesProjectParams contains the fields used to create or update a Pages
+// project. Only non-empty fields are changed when updating.
+type PagesProjectParams struct {
+	Name              string                         `json:"name,omitempty"`
+	ProductionBranch  string                         `json:"production_branch,omitempty"`
+	Source            *PagesProjectSource            `json:"source,omitempty"`
+	BuildConfig       *PagesProjectBuildConfig       `json:"build_config,omitempty"`
+	DeploymentConfigs *PagesProjectDeploymentConfigs `json:"deployment_configs,omitempty"`
+}
+
+// PagesDeploymentListParams contains the filters available when listing
+// Pages deployments.
+type PagesDeploymentListParams struct {
+	Env PagesDeploymentEnvironment `url:"env,omitempty"`
+
+	PaginationParams
+}
+
+// PagesDeploymentCreateParams contains the details of a new deployment.
+//
+// Leaving Manifest empty triggers a build from the project's connected git
+// repository. Direct uploads set Manifest to a map of asset paths to the
+// hashes of assets already uploaded to Pages, and may include the special
+// files which are sent verbatim alongside it.
+type PagesDeploymentCreateParams struct {
+	Branch        string
+	CommitHash    string
+	CommitMessage string
+	CommitDirty   bool
+	Manifest      map[string]string
+
+	WorkerScript []byte
+	Headers      []byte
+	Redirects    []byte
+	RoutesJSON   []byte
+}
+
+// PagesDeploymentDeleteParams contains the options available when deleting a
+// deployment.
+type PagesDeploymentDeleteParams struct {
+	// Force deletes deployments that are aliased, such as the latest
+	// deployment of a branch.
+	Force bool `url:"force,omitempty"`
+}
+
+// ListProjects returns the Pages projects of an account.
+//
+// API reference: https://api.cloudflare.com/#pages-project-get-projects
+func (s *PagesService) ListProjects(ctx context.Context, accountID string, params PagesProjectListParams) ([]PagesProject, ResultInfo, error) {
+	var projects []PagesProject
+	var info ResultInfo
+	err := s.ForEachProjectsPage(ctx, accountID, params, func(page []PagesProject, pageInfo ResultInfo) bool {
+		projects = append(projects, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []PagesProject{}, ResultInfo{}, err
+	}
+
+	return projects, info, nil
+}
+
+// ForEachProjectsPage is like ListProjects but passes each page of results to
+// fn as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#pages-project-get-projects
+func (s *PagesService) ForEachProjectsPage(ctx context.Context, accountID string, params PagesProjectListParams, fn func(items []PagesProject, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/pages/projects", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r PagesProjectsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal pages project JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetProject fetches a single Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-project-get-project
+func (s *PagesService) GetProject(ctx context.Context, accountID, projectName string) (PagesProject, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesProject{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return PagesProject{}, fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/pages/projects/"+projectName, nil)
+	if err != nil {
+		return PagesProject{}, err
+	}
+
+	var r PagesProjectResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesProject{}, fmt.Errorf("failed to unmarshal pages project JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateProject creates a new Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-project-create-project
+func (s *PagesService) CreateProject(ctx context.Context, accountID string, params PagesProjectParams) (PagesProject, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesProject{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Name == "" {
+		return PagesProject{}, errors.New("name is required to create a pages project")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/pages/projects", params)
+	if err != nil {
+		return PagesProject{}, err
+	}
+
+	var r PagesProjectResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesProject{}, fmt.Errorf("failed to unmarshal pages project JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateProject modifies an existing Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-project-update-project
+func (s *PagesService) UpdateProject(ctx context.Context, accountID, projectName string, params PagesProjectParams) (PagesProject, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesProject{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return PagesProject{}, fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/pages/projects/"+projectName, params)
+	if err != nil {
+		return PagesProject{}, err
+	}
+
+	var r PagesProjectResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesProject{}, fmt.Errorf("failed to unmarshal pages project JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteProject removes a Pages project along with its deployments.
+//
+// API reference: https://api.cloudflare.com/#pages-project-delete-project
+func (s *PagesService) DeleteProject(ctx context.Context, accountID, projectName string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/pages/projects/"+projectName, nil)
+	return err
+}
+
+// ListDeployments returns the deployments of a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployments
+func (s *PagesService) ListDeployments(ctx context.Context, accountID, projectName string, params PagesDeploymentListParams) ([]PagesDeployment, ResultInfo, error) {
+	var deployments []PagesDeployment
+	var info ResultInfo
+	err := s.ForEachDeploymentsPage(ctx, accountID, projectName, params, func(page []PagesDeployment, pageInfo ResultInfo) bool {
+		deployments = append(deployments, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []PagesDeployment{}, ResultInfo{}, err
+	}
+
+	return deployments, info, nil
+}
+
+// ForEachDeploymentsPage is like ListDeployments but passes each page of
+// results to fn as it is fetched instead of collecting them. Paging stops
+// early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployments
+func (s *PagesService) ForEachDeploymentsPage(ctx context.Context, accountID, projectName string, params PagesDeploymentListParams, fn func(items []PagesDeployment, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/pages/projects/"+projectName+"/deployments", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r PagesDeploymentsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal pages deployment JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetDeployment fetches a single deployment of a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployment-info
+func (s *PagesService) GetDeployment(ctx context.Context, accountID, projectName, deploymentID string) (PagesDeployment, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesDeployment{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return PagesDeployment{}, fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	if deploymentID == "" {
+		return PagesDeployment{}, fmt.Errorf(errMissingResourceID, "deployment")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/pages/projects/"+projectName+"/deployments/"+deploymentID, nil)
+	if err != nil {
+		return PagesDeployment{}, err
+	}
+
+	var r PagesDeploymentResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesDeployment{}, fmt.Errorf("failed to unmarshal pages deployment JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateDeployment starts a new deployment of a Pages project, either by
+// building the connected git repository or from a direct upload manifest.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-create-deployment
+func (s *PagesService) CreateDeployment(ctx context.Context, accountID, projectName string, params PagesDeploymentCreateParams) (PagesDeployment, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesDeployment{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return PagesDeployment{}, fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	var manifest []byte
+	if params.Manifest != nil {
+		var err error
+		manifest, err = json.Marshal(params.Manifest)
+		if err != nil {
+			return PagesDeployment{}, fmt.Errorf("failed to marshal pages manifest: %w", err)
+		}
+	}
+
+	fields := [][2]string{
+		{"branch", params.Branch},
+		{"commit_hash", params.CommitHash},
+		{"commit_message", params.CommitMessage},
+		{"manifest", string(manifest)},
+	}
+	if params.CommitDirty {
+		fields = append(fields, [2]string{"commit_dirty", "true"})
+	}
+	for _, f := range fields {
+		if f[1] == "" {
+			continue
+		}
+		if err := w.WriteField(f[0], f[1]); err != nil {
+			return PagesDeployment{}, err
+		}
+	}
+
+	files := []struct {
+		name    string
+		content []byte
+	}{
+		{"_worker.js", params.WorkerScript},
+		{"_headers", params.Headers},
+		{"_redirects", params.Redirects},
+		{"_routes.json", params.RoutesJSON},
+	}
+	for _, f := range files {
+		if f.content == nil {
+			continue
+		}
+		part, err := w.CreateFormFile(f.name, f.name)
+		if err != nil {
+			return PagesDeployment{}, err
+		}
+		if _, err := part.Write(f.content); err != nil {
+			return PagesDeployment{}, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return PagesDeployment{}, err
+	}
+
+	uri := "/accounts/" + accountID + "/pages/projects/" + projectName + "/deployments"
+	res, err := s.client.CallWithHeaders(ctx, http.MethodPost, uri, body, http.Header{"Content-Type": []string{w.FormDataContentType()}})
+	if err != nil {
+		return PagesDeployment{}, err
+	}
+
+	var r PagesDeploymentResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesDeployment{}, fmt.Errorf("failed to unmarshal pages deployment JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// RetryDeployment starts a new deployment using the same configuration and
+// source as an existing one.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-retry-deployment
+func (s *PagesService) RetryDeployment(ctx context.Context, accountID, projectName, deploymentID string) (PagesDeployment, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesDeployment{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return PagesDeployment{}, fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	if deploymentID == "" {
+		return PagesDeployment{}, fmt.Errorf(errMissingResourceID, "deployment")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/pages/projects/"+projectName+"/deployments/"+deploymentID+"/retry", nil)
+	if err != nil {
+		return PagesDeployment{}, err
+	}
+
+	var r PagesDeploymentResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesDeployment{}, fmt.Errorf("failed to unmarshal pages deployment JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// RollbackDeployment makes a previous successful production deployment the
+// active production deployment again.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-rollback-deployment
+func (s *PagesService) RollbackDeployment(ctx context.Context, accountID, projectName, deploymentID string) (PagesDeployment, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesDeployment{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return PagesDeployment{}, fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	if deploymentID == "" {
+		return PagesDeployment{}, fmt.Errorf(errMissingResourceID, "deployment")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/pages/projects/"+projectName+"/deployments/"+deploymentID+"/rollback", nil)
+	if err != nil {
+		return PagesDeployment{}, err
+	}
+
+	var r PagesDeploymentResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesDeployment{}, fmt.Errorf("failed to unmarshal pages deployment JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteDeployment removes a deployment of a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-delete-deployment
+func (s *PagesService) DeleteDeployment(ctx context.Context, accountID, projectName, deploymentID string, params PagesDeploymentDeleteParams) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	if deploymentID == "" {
+		return fmt.Errorf(errMissingResourceID, "deployment")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, buildURI("/accounts/"+accountID+"/pages/projects/"+projectName+"/deployments/"+deploymentID, params), nil)
+	return err
+}
@@ -0,0 +1,187 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// defaultPagesLogPollInterval is how often build logs are fetched while
+// following a running deployment.
+const defaultPagesLogPollInterval = 3 * time.Second
+
+// PagesDeploymentLogs are the build logs of a Pages deployment.
+type PagesDeploymentLogs struct {
+	Total                 int                       `json:"total"`
+	IncludesContainerLogs bool                      `json:"includes_container_logs"`
+	Data                  []PagesDeploymentLogEntry `json:"data"`
+}
+
+// PagesDeploymentLogEntry is a single line of build output.
+type PagesDeploymentLogEntry struct {
+	Timestamp *time.Time `json:"ts"`
+	Line      string     `json:"line"`
+}
+
+// PagesDeploymentLogsResponse represents the response from the deployment
+// logs endpoint.
+type PagesDeploymentLogsResponse struct {
+	Response
+	Result PagesDeploymentLogs `json:"result"`
+}
+
+// PagesDeploymentLogStreamParams contains the options used when following
+// the build logs of a deployment.
+type PagesDeploymentLogStreamParams struct {
+	// PollInterval is how often new log lines are fetched. Defaults to three
+	// seconds.
+	PollInterval time.Duration
+}
+
+// PagesDeploymentLogSession delivers the build logs of a deployment.
+type PagesDeploymentLogSession struct {
+	lines chan PagesDeploymentLogEntry
+
+	mu  sync.Mutex
+	err error
+}
+
+// Lines returns the channel log lines are delivered on. It is closed once the
+// deployment has finished and every line has been delivered, after which Err
+// reports whether streaming stopped early.
+func (l *PagesDeploymentLogSession) Lines() <-chan PagesDeploymentLogEntry {
+	return l.lines
+}
+
+// Err returns the error that ended the session, or nil if the deployment
+// finished or the context passed to StreamDeploymentLogs was cancelled.
+func (l *PagesDeploymentLogSession) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// GetDeploymentLogs fetches the build logs recorded so far for a deployment.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployment-logs
+func (s *PagesService) GetDeploymentLogs(ctx context.Context, accountID, projectName, deploymentID string) (PagesDeploymentLogs, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return PagesDeploymentLogs{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if projectName == "" {
+		return PagesDeploymentLogs{}, fmt.Errorf(errMissingResourceID, "project")
+	}
+
+	if deploymentID == "" {
+		return PagesDeploymentLogs{}, fmt.Errorf(errMissingResourceID, "deployment")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/pages/projects/"+projectName+"/deployments/"+deploymentID+"/history/logs", nil)
+	if err != nil {
+		return PagesDeploymentLogs{}, err
+	}
+
+	var r PagesDeploymentLogsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesDeploymentLogs{}, fmt.Errorf("failed to unmarshal pages deployment logs JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// StreamDeploymentLogs follows the build logs of a deployment. Lines already
+// written are delivered first, then new lines are polled for until the
+// deployment finishes or ctx is cancelled. Streaming a deployment that has
+// already finished replays its full history and ends.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployment-logs
+func (s *PagesService) StreamDeploymentLogs(ctx context.Context, accountID, projectName, deploymentID string, params PagesDeploymentLogStreamParams) (*PagesDeploymentLogSession, error) {
+	// Fetch the deployment up front so invalid identifiers are returned
+	// directly rather than through the session.
+	deployment, err := s.GetDeployment(ctx, accountID, projectName, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	interval := params.PollInterval
+	if interval <= 0 {
+		interval = defaultPagesLogPollInterval
+	}
+
+	session := &PagesDeploymentLogSession{lines: make(chan PagesDeploymentLogEntry, 16)}
+
+	go func() {
+		err := s.followDeploymentLogs(ctx, accountID, projectName, deployment, interval, session.lines)
+
+		session.mu.Lock()
+		session.err = err
+		session.mu.Unlock()
+		close(session.lines)
+	}()
+
+	return session, nil
+}
+
+func (s *PagesService) followDeploymentLogs(ctx context.Context, accountID, projectName string, deployment PagesDeployment, interval time.Duration, out chan<- PagesDeploymentLogEntry) error {
+	seen := 0
+	for {
+		// Check whether the deployment had finished before fetching the logs
+		// so the final poll is guaranteed to include every line.
+		finished := pagesDeploymentFinished(deployment)
+
+		logs, err := s.GetDeploymentLogs(ctx, accountID, projectName, deployment.ID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if seen > len(logs.Data) {
+			seen = 0
+		}
+		for _, line := range logs.Data[seen:] {
+			select {
+			case out <- line:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+		seen = len(logs.Data)
+
+		if finished {
+			return nil
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return nil
+		}
+
+		deployment, err = s.GetDeployment(ctx, accountID, projectName, deployment.ID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// pagesDeploymentFinished reports whether a deployment has stopped producing
+// build output.
+func pagesDeploymentFinished(d PagesDeployment) bool {
+	switch d.LatestStage.Status {
+	case "failure", "canceled", "skipped":
+		return true
+	case "success":
+		return d.LatestStage.Name == "deploy"
+	}
+	return false
+}
@@ -0,0 +1,348 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type QueuesService service
+
+// Queue is a Cloudflare Queue along with its producers and consumers.
+type Queue struct {
+	ID                  string          `json:"queue_id,omitempty"`
+	Name                string          `json:"queue_name,omitempty"`
+	CreatedOn           *time.Time      `json:"created_on,omitempty"`
+	ModifiedOn          *time.Time      `json:"modified_on,omitempty"`
+	ProducersTotalCount int             `json:"producers_total_count,omitempty"`
+	Producers           []QueueProducer `json:"producers,omitempty"`
+	ConsumersTotalCount int             `json:"consumers_total_count,omitempty"`
+	Consumers           []QueueConsumer `json:"consumers,omitempty"`
+}
+
+// QueueProducer is a worker that sends messages to a queue.
+type QueueProducer struct {
+	Type        string `json:"type,omitempty"`
+	Script      string `json:"script,omitempty"`
+	Environment string `json:"environment,omitempty"`
+}
+
+// QueueConsumerType is the way a consumer receives messages.
+type QueueConsumerType string
+
+const (
+	QueueConsumerTypeWorker   QueueConsumerType = "worker"
+	QueueConsumerTypeHTTPPull QueueConsumerType = "http_pull"
+)
+
+// QueueConsumer is a worker, or HTTP pull client, that receives messages
+// from a queue.
+type QueueConsumer struct {
+	ID              string                `json:"consumer_id,omitempty"`
+	ScriptName      string                `json:"script_name,omitempty"`
+	Environment     string                `json:"environment,omitempty"`
+	Type            QueueConsumerType     `json:"type,omitempty"`
+	Settings        QueueConsumerSettings `json:"settings,omitempty"`
+	DeadLetterQueue string                `json:"dead_letter_queue,omitempty"`
+	CreatedOn       *time.Time            `json:"created_on,omitempty"`
+}
+
+// QueueConsumerSettings controls how messages are batched and retried.
+type QueueConsumerSettings struct {
+	BatchSize           int `json:"batch_size,omitempty"`
+	MaxRetries          int `json:"max_retries,omitempty"`
+	MaxWaitTimeMs       int `json:"max_wait_time_ms,omitempty"`
+	MaxConcurrency      int `json:"max_concurrency,omitempty"`
+	RetryDelay          int `json:"retry_delay,omitempty"`
+	VisibilityTimeoutMs int `json:"visibility_timeout_ms,omitempty"`
+}
+
+// QueueResponse represents the response from the queues endpoint containing
+// a single queue.
+type QueueResponse struct {
+	Response
+	Result Queue `json:"result"`
+}
+
+// QueuesResponse represents the response from the queues endpoint containing
+// multiple queues.
+type QueuesResponse struct {
+	Response
+	Result     []Queue    `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// QueueConsumerResponse represents the response from the queue consumers
+// endpoint containing a single consumer.
+type QueueConsumerResponse struct {
+	Response
+	Result QueueConsumer `json:"result"`
+}
+
+// QueueConsumersResponse represents the response from the queue consumers
+// endpoint containing multiple consumers.
+type QueueConsumersResponse struct {
+	Response
+	Result []QueueConsumer `json:"result"`
+}
+
+// QueueListParams contains the options available when listing queues.
+type QueueListParams struct {
+	PaginationParams
+}
+
+// queueParams is the payload used to create or rename a queue.
+type queueParams struct {
+	Name string `json:"queue_name"`
+}
+
+// List returns the queues of an account.
+//
+// API reference: https://api.cloudflare.com/#queue-list-queues
+func (s *QueuesService) List(ctx context.Context, accountID string, params QueueListParams) ([]Queue, ResultInfo, error) {
+	var queues []Queue
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []Queue, pageInfo ResultInfo) bool {
+		queues = append(queues, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []Queue{}, ResultInfo{}, err
+	}
+
+	return queues, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#queue-list-queues
+func (s *QueuesService) ForEachPage(ctx context.Context, accountID string, params QueueListParams, fn func(items []Queue, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/queues", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r QueuesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal queue JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Create creates a new queue.
+//
+// API reference: https://api.cloudflare.com/#queue-create-queue
+func (s *QueuesService) Create(ctx context.Context, accountID, name string) (Queue, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Queue{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if name == "" {
+		return Queue{}, errors.New("queue name must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/queues", queueParams{Name: name})
+	if err != nil {
+		return Queue{}, err
+	}
+
+	var r QueueResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to unmarshal queue JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single queue.
+//
+// API reference: https://api.cloudflare.com/#queue-queue-details
+func (s *QueuesService) Get(ctx context.Context, accountID, queueID string) (Queue, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Queue{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if queueID == "" {
+		return Queue{}, fmt.Errorf(errMissingResourceID, "queue")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/queues/"+queueID, nil)
+	if err != nil {
+		return Queue{}, err
+	}
+
+	var r QueueResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to unmarshal queue JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update renames a queue.
+//
+// API reference: https://api.cloudflare.com/#queue-update-queue
+func (s *QueuesService) Update(ctx context.Context, accountID, queueID, name string) (Queue, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Queue{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if queueID == "" {
+		return Queue{}, fmt.Errorf(errMissingResourceID, "queue")
+	}
+
+	if name == "" {
+		return Queue{}, errors.New("queue name must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/queues/"+queueID, queueParams{Name: name})
+	if err != nil {
+		return Queue{}, err
+	}
+
+	var r QueueResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to unmarshal queue JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a queue and any messages it holds.
+//
+// API reference: https://api.cloudflare.com/#queue-delete-queue
+func (s *QueuesService) Delete(ctx context.Context, accountID, queueID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if queueID == "" {
+		return fmt.Errorf(errMissingResourceID, "queue")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/queues/"+queueID, nil)
+	return err
+}
+
+// ListConsumers returns the consumers of a queue.
+//
+// API reference: https://api.cloudflare.com/#queue-list-queue-consumers
+func (s *QueuesService) ListConsumers(ctx context.Context, accountID, queueID string) ([]QueueConsumer, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []QueueConsumer{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if queueID == "" {
+		return []QueueConsumer{}, fmt.Errorf(errMissingResourceID, "queue")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/queues/"+queueID+"/consumers", nil)
+	if err != nil {
+		return []QueueConsumer{}, err
+	}
+
+	var r QueueConsumersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []QueueConsumer{}, fmt.Errorf("failed to unmarshal queue consumer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateConsumer attaches a consumer to a queue.
+//
+// API reference: https://api.cloudflare.com/#queue-create-queue-consumer
+func (s *QueuesService) CreateConsumer(ctx context.Context, accountID, queueID string, consumer QueueConsumer) (QueueConsumer, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return QueueConsumer{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if queueID == "" {
+		return QueueConsumer{}, fmt.Errorf(errMissingResourceID, "queue")
+	}
+
+	if consumer.Type == "" {
+		consumer.Type = QueueConsumerTypeWorker
+	}
+
+	if consumer.Type == QueueConsumerTypeWorker && consumer.ScriptName == "" {
+		return QueueConsumer{}, errors.New("script name is required for worker consumers")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/queues/"+queueID+"/consumers", consumer)
+	if err != nil {
+		return QueueConsumer{}, err
+	}
+
+	var r QueueConsumerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return QueueConsumer{}, fmt.Errorf("failed to unmarshal queue consumer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateConsumer replaces the settings of a queue consumer.
+//
+// API reference: https://api.cloudflare.com/#queue-update-queue-consumer
+func (s *QueuesService) UpdateConsumer(ctx context.Context, accountID, queueID, consumerID string, consumer QueueConsumer) (QueueConsumer, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return QueueConsumer{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if queueID == "" {
+		return QueueConsumer{}, fmt.Errorf(errMissingResourceID, "queue")
+	}
+
+	if consumerID == "" {
+		return QueueConsumer{}, fmt.Errorf(errMissingResourceID, "consumer")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/queues/"+queueID+"/consumers/"+consumerID, consumer)
+	if err != nil {
+		return QueueConsumer{}, err
+	}
+
+	var r QueueConsumerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return QueueConsumer{}, fmt.Errorf("failed to unmarshal queue consumer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteConsumer detaches a consumer from a queue.
+//
+// API reference: https://api.cloudflare.com/#queue-delete-queue-consumer
+func (s *QueuesService) DeleteConsumer(ctx context.Context, accountID, queueID, consumerID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if queueID == "" {
+		return fmt.Errorf(errMissingResourceID, "queue")
+	}
+
+	if consumerID == "" {
+		return fmt.Errorf(errMissingResourceID, "consumer")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/queues/"+queueID+"/consumers/"+consumerID, nil)
+	return err
+}
@@ -0,0 +1,355 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type R2Service service
+
+// R2LocationHint is a hint for where a bucket's data should be stored.
+type R2LocationHint string
+
+const (
+	R2LocationHintWesternNorthAmerica R2LocationHint = "wnam"
+	R2LocationHintEasternNorthAmerica R2LocationHint = "enam"
+	R2LocationHintWesternEurope       R2LocationHint = "weur"
+	R2LocationHintEasternEurope       R2LocationHint = "eeur"
+	R2LocationHintAsiaPacific         R2LocationHint = "apac"
+	R2LocationHintOceania             R2LocationHint = "oc"
+)
+
+// R2Bucket is an R2 storage bucket.
+type R2Bucket struct {
+	Name         string         `json:"name"`
+	CreationDate *time.Time     `json:"creation_date,omitempty"`
+	Location     R2LocationHint `json:"location,omitempty"`
+	StorageClass string         `json:"storage_class,omitempty"`
+}
+
+// R2BucketResponse represents the response from the R2 buckets endpoint
+// containing a single bucket.
+type R2BucketResponse struct {
+	Response
+	Result R2Bucket `json:"result"`
+}
+
+// R2BucketsResponse represents the response from the R2 buckets endpoint
+// containing multiple buckets.
+type R2BucketsResponse struct {
+	Response
+	Result struct {
+		Buckets []R2Bucket `json:"buckets"`
+	} `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// R2BucketListParams contains the filters available when listing R2
+// buckets.
+type R2BucketListParams struct {
+	NameContains string `url:"name_contains,omitempty"`
+	StartAfter   string `url:"start_after,omitempty"`
+	Order        string `url:"order,omitempty"`
+	PerPage      int    `url:"per_page,omitempty"`
+
+	CursorPaginationParams
+}
+
+// R2BucketCreateParams contains the details needed to create an R2 bucket.
+type R2BucketCreateParams struct {
+	Name         string         `json:"name"`
+	LocationHint R2LocationHint `json:"locationHint,omitempty"`
+	StorageClass string         `json:"storageClass,omitempty"`
+}
+
+// R2LifecycleRule deletes objects, aborts multipart uploads or transitions
+// storage classes once objects matching its conditions reach an age or date.
+type R2LifecycleRule struct {
+	ID                              string                       `json:"id"`
+	Enabled                         bool                         `json:"enabled"`
+	Conditions                      R2LifecycleRuleConditions    `json:"conditions"`
+	DeleteObjectsTransition         *R2LifecycleTransition       `json:"deleteObjectsTransition,omitempty"`
+	AbortMultipartUploadsTransition *R2LifecycleTransition       `json:"abortMultipartUploadsTransition,omitempty"`
+	StorageClassTransitions         []R2LifecycleClassTransition `json:"storageClassTransitions,omitempty"`
+}
+
+// R2LifecycleRuleConditions selects the objects a lifecycle rule applies to.
+type R2LifecycleRuleConditions struct {
+	Prefix string `json:"prefix"`
+}
+
+// R2LifecycleTransition describes when a lifecycle action happens.
+type R2LifecycleTransition struct {
+	Condition R2LifecycleCondition `json:"condition"`
+}
+
+// R2LifecycleClassTransition moves objects to another storage class.
+type R2LifecycleClassTransition struct {
+	Condition    R2LifecycleCondition `json:"condition"`
+	StorageClass string               `json:"storageClass"`
+}
+
+// R2LifecycleCondition is either an age in seconds (Type "Age") or an
+// absolute date (Type "Date").
+type R2LifecycleCondition struct {
+	Type   string     `json:"type"`
+	MaxAge int        `json:"maxAge,omitempty"`
+	Date   *time.Time `json:"date,omitempty"`
+}
+
+// R2LifecycleRules is the lifecycle configuration of a bucket.
+type R2LifecycleRules struct {
+	Rules []R2LifecycleRule `json:"rules"`
+}
+
+// R2LifecycleRulesResponse represents the response from the bucket lifecycle
+// endpoint.
+type R2LifecycleRulesResponse struct {
+	Response
+	Result R2LifecycleRules `json:"result"`
+}
+
+// R2CORSRule allows cross-origin requests to a bucket.
+type R2CORSRule struct {
+	ID            string          `json:"id,omitempty"`
+	Allowed       R2CORSRuleAllow `json:"allowed"`
+	ExposeHeaders []string        `json:"exposeHeaders,omitempty"`
+	MaxAgeSeconds int             `json:"maxAgeSeconds,omitempty"`
+}
+
+// R2CORSRuleAllow lists the origins, methods and headers permitted by a CORS
+// rule.
+type R2CORSRuleAllow struct {
+	Origins []string `json:"origins"`
+	Methods []string `json:"methods"`
+	Headers []string `json:"headers,omitempty"`
+}
+
+// R2CORSRules is the CORS configuration of a bucket.
+type R2CORSRules struct {
+	Rules []R2CORSRule `json:"rules"`
+}
+
+// R2CORSRulesResponse represents the response from the bucket CORS endpoint.
+type R2CORSRulesResponse struct {
+	Response
+	Result R2CORSRules `json:"result"`
+}
+
+// List returns the R2 buckets of an account.
+//
+// Unless a cursor is provided, every bucket is fetched by following the
+// cursors returned by the API, PerPage buckets at a time. Otherwise a single
+// page is
+// fetched and the returned ResultInfo.Cursor can be passed back in
+// `R2BucketListParams` to retrieve the next page.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-list-buckets
+func (s *R2Service) List(ctx context.Context, accountID string, params R2BucketListParams) ([]R2Bucket, ResultInfo, error) {
+	var buckets []R2Bucket
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []R2Bucket, pageInfo ResultInfo) bool {
+		buckets = append(buckets, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []R2Bucket{}, ResultInfo{}, err
+	}
+
+	return buckets, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-list-buckets
+func (s *R2Service) ForEachPage(ctx context.Context, accountID string, params R2BucketListParams, fn func(items []R2Bucket, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listCursorPages(ctx, "/accounts/"+accountID+"/r2/buckets", "cursor", &params, &params.CursorPaginationParams, func(res []byte) (ResultInfo, error) {
+		var r R2BucketsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal r2 bucket JSON data: %w", err)
+		}
+		if !fn(r.Result.Buckets, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single R2 bucket.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-get-bucket
+func (s *R2Service) Get(ctx context.Context, accountID, bucketName string) (R2Bucket, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return R2Bucket{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if bucketName == "" {
+		return R2Bucket{}, fmt.Errorf(errMissingResourceID, "bucket")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/r2/buckets/"+bucketName, nil)
+	if err != nil {
+		return R2Bucket{}, err
+	}
+
+	var r R2BucketResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return R2Bucket{}, fmt.Errorf("failed to unmarshal r2 bucket JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new R2 bucket.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-create-bucket
+func (s *R2Service) Create(ctx context.Context, accountID string, params R2BucketCreateParams) (R2Bucket, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return R2Bucket{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Name == "" {
+		return R2Bucket{}, errors.New("name is required to create an R2 bucket")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/r2/buckets", params)
+	if err != nil {
+		return R2Bucket{}, err
+	}
+
+	var r R2BucketResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return R2Bucket{}, fmt.Errorf("failed to unmarshal r2 bucket JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes an R2 bucket. The bucket must be empty.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-delete-bucket
+func (s *R2Service) Delete(ctx context.Context, accountID, bucketName string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if bucketName == "" {
+		return fmt.Errorf(errMissingResourceID, "bucket")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/r2/buckets/"+bucketName, nil)
+	return err
+}
+
+// GetLifecycle returns the object lifecycle rules of an R2 bucket.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-get-object-lifecycle-rules
+func (s *R2Service) GetLifecycle(ctx context.Context, accountID, bucketName string) ([]R2LifecycleRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []R2LifecycleRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if bucketName == "" {
+		return []R2LifecycleRule{}, fmt.Errorf(errMissingResourceID, "bucket")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/r2/buckets/"+bucketName+"/lifecycle", nil)
+	if err != nil {
+		return []R2LifecycleRule{}, err
+	}
+
+	var r R2LifecycleRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []R2LifecycleRule{}, fmt.Errorf("failed to unmarshal r2 lifecycle JSON data: %w", err)
+	}
+
+	return r.Result.Rules, nil
+}
+
+// UpdateLifecycle replaces the object lifecycle rules of an R2 bucket.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-put-object-lifecycle-rules
+func (s *R2Service) UpdateLifecycle(ctx context.Context, accountID, bucketName string, rules []R2LifecycleRule) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if bucketName == "" {
+		return fmt.Errorf(errMissingResourceID, "bucket")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/r2/buckets/"+bucketName+"/lifecycle", R2LifecycleRules{Rules: rules})
+	return err
+}
+
+// GetCORS returns the CORS rules of an R2 bucket.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-get-bucket-cors-policy
+func (s *R2Service) GetCORS(ctx context.Context, accountID, bucketName string) ([]R2CORSRule, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []R2CORSRule{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if bucketName == "" {
+		return []R2CORSRule{}, fmt.Errorf(errMissingResourceID, "bucket")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/r2/buckets/"+bucketName+"/cors", nil)
+	if err != nil {
+		return []R2CORSRule{}, err
+	}
+
+	var r R2CORSRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []R2CORSRule{}, fmt.Errorf("failed to unmarshal r2 cors JSON data: %w", err)
+	}
+
+	return r.Result.Rules, nil
+}
+
+// UpdateCORS replaces the CORS rules of an R2 bucket.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-put-bucket-cors-policy
+func (s *R2Service) UpdateCORS(ctx context.Context, accountID, bucketName string, rules []R2CORSRule) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if bucketName == "" {
+		return fmt.Errorf(errMissingResourceID, "bucket")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/r2/buckets/"+bucketName+"/cors", R2CORSRules{Rules: rules})
+	return err
+}
+
+// DeleteCORS removes every CORS rule from an R2 bucket.
+//
+// API reference: https://api.cloudflare.com/#r2-bucket-delete-bucket-cors-policy
+func (s *R2Service) DeleteCORS(ctx context.Context, accountID, bucketName string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if bucketName == "" {
+		return fmt.Errorf(errMissingResourceID, "bucket")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/r2/buckets/"+bucketName+"/cors", nil)
+	return err
+}
@@ -0,0 +1,392 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type RateLimitsService service
+
+// RateLimitPeriod is the length of time, in seconds, requests are counted
+// over. The legacy API accepts any value between 10 and 86400 whereas rate
+// limiting rules only accept the predefined periods below.
+type RateLimitPeriod int
+
+const (
+	RateLimitPeriod10Seconds RateLimitPeriod = 10
+	RateLimitPeriod1Minute   RateLimitPeriod = 60
+	RateLimitPeriod2Minutes  RateLimitPeriod = 120
+	RateLimitPeriod5Minutes  RateLimitPeriod = 300
+	RateLimitPeriod10Minutes RateLimitPeriod = 600
+	RateLimitPeriod1Hour     RateLimitPeriod = 3600
+)
+
+// RateLimitActionMode is the action taken by a legacy rate limit once the
+// threshold is exceeded.
+type RateLimitActionMode string
+
+const (
+	RateLimitActionModeSimulate         RateLimitActionMode = "simulate"
+	RateLimitActionModeBan              RateLimitActionMode = "ban"
+	RateLimitActionModeChallenge        RateLimitActionMode = "challenge"
+	RateLimitActionModeJSChallenge      RateLimitActionMode = "js_challenge"
+	RateLimitActionModeManagedChallenge RateLimitActionMode = "managed_challenge"
+)
+
+// RateLimit is a policy than can be applied to limit traffic within a
+// customer domain using the legacy rate limiting API.
+type RateLimit struct {
+	ID          string                  `json:"id,omitempty"`
+	Disabled    bool                    `json:"disabled,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	Match       RateLimitTrafficMatcher `json:"match"`
+	Bypass      []RateLimitKeyValue     `json:"bypass,omitempty"`
+	Threshold   int                     `json:"threshold"`
+	Period      RateLimitPeriod         `json:"period"`
+	Action      RateLimitAction         `json:"action"`
+	Correlate   *RateLimitCorrelate     `json:"correlate,omitempty"`
+}
+
+// RateLimitTrafficMatcher contains the rules that will be used to apply a
+// rate limit to traffic.
+type RateLimitTrafficMatcher struct {
+	Request  RateLimitRequestMatcher  `json:"request"`
+	Response RateLimitResponseMatcher `json:"response"`
+}
+
+// RateLimitRequestMatcher contains the matching rules pertaining to requests.
+type RateLimitRequestMatcher struct {
+	Methods    []string `json:"methods,omitempty"`
+	Schemes    []string `json:"schemes,omitempty"`
+	URLPattern string   `json:"url,omitempty"`
+}
+
+// RateLimitResponseMatcher contains the matching rules pertaining to
+// responses.
+type RateLimitResponseMatcher struct {
+	Statuses      []int                            `json:"status,omitempty"`
+	OriginTraffic *bool                            `json:"origin_traffic,omitempty"`
+	Headers       []RateLimitResponseMatcherHeader `json:"headers,omitempty"`
+}
+
+// RateLimitResponseMatcherHeader contains the structure of the origin
+// HTTP headers used in request matcher checks.
+type RateLimitResponseMatcherHeader struct {
+	Name  string `json:"name"`
+	Op    string `json:"op"`
+	Value string `json:"value"`
+}
+
+// RateLimitKeyValue is k-v formatted as expected in the rate limit
+// description.
+type RateLimitKeyValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// RateLimitAction is the action that will be taken when the rate limit
+// threshold is reached.
+type RateLimitAction struct {
+	Mode     RateLimitActionMode      `json:"mode"`
+	Timeout  int                      `json:"timeout"`
+	Response *RateLimitActionResponse `json:"response"`
+}
+
+// RateLimitActionResponse is the response that will be returned when rate
+// limit action is triggered.
+type RateLimitActionResponse struct {
+	ContentType string `json:"content_type"`
+	Body        string `json:"body"`
+}
+
+// RateLimitCorrelate pertains to NAT support.
+type RateLimitCorrelate struct {
+	By string `json:"by"`
+}
+
+// RateLimitResponse is the response for a single rate limit.
+type RateLimitResponse struct {
+	Response
+	Result RateLimit `json:"result"`
+}
+
+// RateLimitsResponse is the response for multiple rate limits.
+type RateLimitsResponse struct {
+	Response
+	Result     []RateLimit `json:"result"`
+	ResultInfo ResultInfo  `json:"result_info"`
+}
+
+// RateLimitListParams contains the options available when listing legacy rate
+// limits.
+type RateLimitListParams struct {
+	PaginationParams
+}
+
+// List returns all legacy rate limits for a zone.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-list-rate-limits
+func (s *RateLimitsService) List(ctx context.Context, zoneID string, params RateLimitListParams) ([]RateLimit, ResultInfo, error) {
+	var limits []RateLimit
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []RateLimit, pageInfo ResultInfo) bool {
+		limits = append(limits, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []RateLimit{}, ResultInfo{}, err
+	}
+
+	return limits, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-list-rate-limits
+func (s *RateLimitsService) ForEachPage(ctx context.Context, zoneID string, params RateLimitListParams, fn func(items []RateLimit, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/rate_limits", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r RateLimitsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal rate limit JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single legacy rate limit.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-rate-limit-details
+func (s *RateLimitsService) Get(ctx context.Context, zoneID, rateLimitID string) (RateLimit, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return RateLimit{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if rateLimitID == "" {
+		return RateLimit{}, fmt.Errorf(errMissingResourceID, "rate limit")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/rate_limits/"+rateLimitID, nil)
+	if err != nil {
+		return RateLimit{}, err
+	}
+
+	var r RateLimitResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("failed to unmarshal rate limit JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new legacy rate limit.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-create-a-ratelimit
+func (s *RateLimitsService) Create(ctx context.Context, zoneID string, limit RateLimit) (RateLimit, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return RateLimit{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/rate_limits", limit)
+	if err != nil {
+		return RateLimit{}, err
+	}
+
+	var r RateLimitResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("failed to unmarshal rate limit JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces an existing legacy rate limit.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-update-rate-limit
+func (s *RateLimitsService) Update(ctx context.Context, zoneID, rateLimitID string, limit RateLimit) (RateLimit, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return RateLimit{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if rateLimitID == "" {
+		return RateLimit{}, fmt.Errorf(errMissingResourceID, "rate limit")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/rate_limits/"+rateLimitID, limit)
+	if err != nil {
+		return RateLimit{}, err
+	}
+
+	var r RateLimitResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("failed to unmarshal rate limit JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a legacy rate limit.
+//
+// API reference: https://api.cloudflare.com/#rate-limits-for-a-zone-delete-rate-limit
+func (s *RateLimitsService) Delete(ctx context.Context, zoneID, rateLimitID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if rateLimitID == "" {
+		return fmt.Errorf(errMissingResourceID, "rate limit")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/rate_limits/"+rateLimitID, nil)
+	return err
+}
+
+// ListRules returns the rate limiting rules of a zone, stored in the entry
+// point ruleset of the http_ratelimit phase.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-get-a-zone-entry-point-ruleset
+func (s *RateLimitsService) ListRules(ctx context.Context, zoneID string) ([]RulesetRule, error) {
+	ruleset, err := s.client.Rulesets.GetEntrypoint(ctx, ZoneIdentifier(zoneID), RulesetPhaseHTTPRatelimit)
+	if err != nil {
+		return []RulesetRule{}, err
+	}
+
+	return ruleset.Rules, nil
+}
+
+// UpdateRules replaces the rate limiting rules of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-rulesets-update-a-zone-entry-point-ruleset
+func (s *RateLimitsService) UpdateRules(ctx context.Context, zoneID string, rules []RulesetRule) ([]RulesetRule, error) {
+	for _, rule := range rules {
+		if rule.RateLimit == nil {
+			return []RulesetRule{}, errors.New("rate limiting rules must include rate limit parameters")
+		}
+	}
+
+	ruleset, err := s.client.Rulesets.UpdateEntrypoint(ctx, ZoneIdentifier(zoneID), RulesetPhaseHTTPRatelimit, RulesetParams{Rules: rules})
+	if err != nil {
+		return []RulesetRule{}, err
+	}
+
+	return ruleset.Rules, nil
+}
+
+// rateLimitRuleActions maps legacy rate limit modes to their ruleset
+// equivalents.
+var rateLimitRuleActions = map[RateLimitActionMode]RulesetRuleAction{
+	RateLimitActionModeSimulate:         RulesetRuleActionLog,
+	RateLimitActionModeBan:              RulesetRuleActionBlock,
+	RateLimitActionModeChallenge:        RulesetRuleActionChallenge,
+	RateLimitActionModeJSChallenge:      RulesetRuleActionJSChallenge,
+	RateLimitActionModeManagedChallenge: RulesetRuleActionManagedChallenge,
+}
+
+// RulesetRule converts a legacy rate limit into an equivalent rate limiting
+// rule for the http_ratelimit phase.
+//
+// Rate limiting rules only support a fixed set of periods so the legacy period
+// must be one of the RateLimitPeriod constants. The URL pattern is matched
+// against the full URI using the `wildcard` operator which is close to, but not
+// exactly the same as, the legacy matching semantics.
+func (r RateLimit) RulesetRule() (RulesetRule, error) {
+	switch r.Period {
+	case RateLimitPeriod10Seconds, RateLimitPeriod1Minute, RateLimitPeriod2Minutes,
+		RateLimitPeriod5Minutes, RateLimitPeriod10Minutes, RateLimitPeriod1Hour:
+	default:
+		return RulesetRule{}, fmt.Errorf("period of %d seconds is not supported by rate limiting rules", r.Period)
+	}
+
+	action, ok := rateLimitRuleActions[r.Action.Mode]
+	if !ok {
+		return RulesetRule{}, fmt.Errorf("unsupported rate limit action mode: %q", r.Action.Mode)
+	}
+
+	var conditions []string
+	if len(r.Match.Request.Methods) > 0 && !(len(r.Match.Request.Methods) == 1 && r.Match.Request.Methods[0] == "_ALL_") {
+		conditions = append(conditions, "http.request.method in {"+quoteExpressionValues(r.Match.Request.Methods)+"}")
+	}
+
+	if r.Match.Request.URLPattern != "" {
+		scheme := "*"
+		if len(r.Match.Request.Schemes) == 1 && r.Match.Request.Schemes[0] != "_ALL_" {
+			scheme = strings.ToLower(r.Match.Request.Schemes[0])
+		}
+		conditions = append(conditions, "http.request.full_uri wildcard "+strconv.Quote(scheme+"://"+r.Match.Request.URLPattern))
+	}
+
+	for _, bypass := range r.Bypass {
+		if bypass.Name == "url" {
+			conditions = append(conditions, "not http.request.full_uri wildcard "+strconv.Quote("*://"+bypass.Value))
+		}
+	}
+
+	expression := "true"
+	if len(conditions) > 0 {
+		expression = "(" + strings.Join(conditions, " and ") + ")"
+	}
+
+	rule := RulesetRule{
+		Action:      action,
+		Description: r.Description,
+		Expression:  expression,
+		Enabled:     Bool(!r.Disabled),
+		RateLimit: &RulesetRuleRateLimit{
+			Characteristics:   []string{"cf.colo.id", "ip.src"},
+			RequestsPerPeriod: r.Threshold,
+			Period:            r.Period,
+			MitigationTimeout: r.Action.Timeout,
+		},
+	}
+
+	if len(r.Match.Response.Statuses) > 0 {
+		codes := make([]string, 0, len(r.Match.Response.Statuses))
+		for _, status := range r.Match.Response.Statuses {
+			codes = append(codes, strconv.Itoa(status))
+		}
+		rule.RateLimit.CountingExpression = "http.response.code in {" + strings.Join(codes, " ") + "}"
+	}
+
+	if r.Match.Response.OriginTraffic != nil {
+		rule.RateLimit.RequestsToOrigin = *r.Match.Response.OriginTraffic
+	}
+
+	if r.Action.Response != nil && action == RulesetRuleActionBlock {
+		rule.ActionParameters = &RulesetRuleActionParameters{
+			Response: &RulesetRuleActionParametersBlockResponse{
+				StatusCode:  429,
+				ContentType: r.Action.Response.ContentType,
+				Content:     r.Action.Response.Body,
+			},
+		}
+	}
+
+	return rule, nil
+}
+
+// quoteExpressionValues quotes each value for use in a rules language set,
+// for example `{"GET" "POST"}`.
+func quoteExpressionValues(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, strconv.Quote(v))
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,190 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type RegionalServicesService service
+
+// RegionalServicesRegion is a region that TLS termination and HTTP
+// processing of a hostname can be restricted to, such as "eu" or "us".
+type RegionalServicesRegion struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+// RegionalHostname restricts the processing of requests to Hostname to the
+// data centers of the region RegionKey. Hostname may be a wildcard.
+type RegionalHostname struct {
+	Hostname  string     `json:"hostname"`
+	RegionKey string     `json:"region_key"`
+	CreatedOn *time.Time `json:"created_on,omitempty"`
+}
+
+// RegionalServicesRegionsResponse represents the response from the regional
+// services regions endpoint.
+type RegionalServicesRegionsResponse struct {
+	Response
+	Result []RegionalServicesRegion `json:"result"`
+}
+
+// RegionalHostnameResponse represents the response from the regional
+// hostnames endpoint containing a single hostname.
+type RegionalHostnameResponse struct {
+	Response
+	Result RegionalHostname `json:"result"`
+}
+
+// RegionalHostnamesResponse represents the response from the regional
+// hostnames endpoint containing multiple hostnames.
+type RegionalHostnamesResponse struct {
+	Response
+	Result []RegionalHostname `json:"result"`
+}
+
+// ListRegions returns the regions available to an account.
+//
+// API reference: https://api.cloudflare.com/#dls-regional-services-list-regions
+func (s *RegionalServicesService) ListRegions(ctx context.Context, accountID string) ([]RegionalServicesRegion, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []RegionalServicesRegion{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/addressing/regional_hostnames"+"/regions", nil)
+	if err != nil {
+		return []RegionalServicesRegion{}, err
+	}
+
+	var r RegionalServicesRegionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []RegionalServicesRegion{}, fmt.Errorf("failed to unmarshal regional services region JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListHostnames returns the regional hostnames of a zone.
+//
+// API reference: https://api.cloudflare.com/#dls-regional-services-list-regional-hostnames
+func (s *RegionalServicesService) ListHostnames(ctx context.Context, zoneID string) ([]RegionalHostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []RegionalHostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/addressing/regional_hostnames", nil)
+	if err != nil {
+		return []RegionalHostname{}, err
+	}
+
+	var r RegionalHostnamesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []RegionalHostname{}, fmt.Errorf("failed to unmarshal regional hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetHostname fetches the regional configuration of a hostname.
+//
+// API reference: https://api.cloudflare.com/#dls-regional-services-fetch-regional-hostname
+func (s *RegionalServicesService) GetHostname(ctx context.Context, zoneID, hostname string) (RegionalHostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return RegionalHostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostname == "" {
+		return RegionalHostname{}, fmt.Errorf(errMissingResourceID, "regional hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/addressing/regional_hostnames/"+hostname, nil)
+	if err != nil {
+		return RegionalHostname{}, err
+	}
+
+	var r RegionalHostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegionalHostname{}, fmt.Errorf("failed to unmarshal regional hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateHostname restricts a hostname of a zone to a region.
+//
+// API reference: https://api.cloudflare.com/#dls-regional-services-create-regional-hostname
+func (s *RegionalServicesService) CreateHostname(ctx context.Context, zoneID string, hostname RegionalHostname) (RegionalHostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return RegionalHostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostname.Hostname == "" || hostname.RegionKey == "" {
+		return RegionalHostname{}, errors.New("hostname and region key must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/addressing/regional_hostnames", hostname)
+	if err != nil {
+		return RegionalHostname{}, err
+	}
+
+	var r RegionalHostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegionalHostname{}, fmt.Errorf("failed to unmarshal regional hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateHostname moves a regional hostname to another region.
+//
+// API reference: https://api.cloudflare.com/#dls-regional-services-update-regional-hostname
+func (s *RegionalServicesService) UpdateHostname(ctx context.Context, zoneID, hostname, regionKey string) (RegionalHostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return RegionalHostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostname == "" {
+		return RegionalHostname{}, fmt.Errorf(errMissingResourceID, "regional hostname")
+	}
+
+	if regionKey == "" {
+		return RegionalHostname{}, errors.New("region key must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/addressing/regional_hostnames/"+hostname, map[string]string{"region_key": regionKey})
+	if err != nil {
+		return RegionalHostname{}, err
+	}
+
+	var r RegionalHostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegionalHostname{}, fmt.Errorf("failed to unmarshal regional hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteHostname removes the regional restriction of a hostname.
+//
+// API reference: https://api.cloudflare.com/#dls-regional-services-delete-regional-hostname
+func (s *RegionalServicesService) DeleteHostname(ctx context.Context, zoneID, hostname string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostname == "" {
+		return fmt.Errorf(errMissingResourceID, "regional hostname")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/addressing/regional_hostnames/"+hostname, nil)
+	return err
+}
@@ -0,0 +1,204 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type RegistrarService service
+
+// RegistrarDomain is a domain registered with Cloudflare Registrar.
+type RegistrarDomain struct {
+	ID                string                    `json:"id"`
+	Name              string                    `json:"name"`
+	Available         bool                      `json:"available"`
+	SupportedTLD      bool                      `json:"supported_tld"`
+	CanRegister       bool                      `json:"can_register"`
+	AutoRenew         bool                      `json:"auto_renew"`
+	Locked            bool                      `json:"locked"`
+	Privacy           bool                      `json:"privacy"`
+	Permissions       []string                  `json:"permissions"`
+	CurrentRegistrar  string                    `json:"current_registrar"`
+	ExpiresAt         *time.Time                `json:"expires_at,omitempty"`
+	CreatedAt         *time.Time                `json:"created_at,omitempty"`
+	UpdatedAt         *time.Time                `json:"updated_at,omitempty"`
+	Fees              RegistrarDomainFees       `json:"fees"`
+	RegistryStatuses  string                    `json:"registry_statuses"`
+	TransferIn        *RegistrarTransferIn      `json:"transfer_in,omitempty"`
+	RegistrantContact *RegistrarDomainContact   `json:"registrant_contact,omitempty"`
+	Contacts          RegistrarDomainContactSet `json:"contacts,omitempty"`
+}
+
+// RegistrarDomainFees lists the prices of a domain in USD.
+type RegistrarDomainFees struct {
+	ICANNFee        float64 `json:"icann_fee"`
+	RedemptionFee   float64 `json:"redemption_fee"`
+	RegistrationFee float64 `json:"registration_fee"`
+	RenewalFee      float64 `json:"renewal_fee"`
+	TransferFee     float64 `json:"transfer_fee"`
+}
+
+// RegistrarTransferIn reports the progress of a domain being transferred to
+// Cloudflare Registrar.
+type RegistrarTransferIn struct {
+	UnlockDomain      string `json:"unlock_domain"`
+	DisablePrivacy    string `json:"disable_privacy"`
+	EnterAuthCode     string `json:"enter_auth_code"`
+	ApproveTransfer   string `json:"approve_transfer"`
+	AcceptFoa         string `json:"accept_foa"`
+	CanCancelTransfer bool   `json:"can_cancel_transfer"`
+}
+
+// RegistrarDomainContact is a contact of a registered domain.
+type RegistrarDomainContact struct {
+	ID           string `json:"id,omitempty"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Organization string `json:"organization"`
+	Address      string `json:"address"`
+	Address2     string `json:"address2,omitempty"`
+	City         string `json:"city"`
+	State        string `json:"state"`
+	Zip          string `json:"zip"`
+	Country      string `json:"country"`
+	Phone        string `json:"phone"`
+	Fax          string `json:"fax,omitempty"`
+	Email        string `json:"email"`
+}
+
+// RegistrarDomainContactSet holds the contacts of each role of a registered
+// domain.
+type RegistrarDomainContactSet struct {
+	Registrant    *RegistrarDomainContact `json:"registrant,omitempty"`
+	Administrator *RegistrarDomainContact `json:"administrator,omitempty"`
+	Technical     *RegistrarDomainContact `json:"technical,omitempty"`
+	Billing       *RegistrarDomainContact `json:"billing,omitempty"`
+}
+
+// RegistrarDomainUpdateParams contains the settings that can be changed on a
+// registered domain. Unset fields are left unchanged.
+type RegistrarDomainUpdateParams struct {
+	AutoRenew *bool                      `json:"auto_renew,omitempty"`
+	Locked    *bool                      `json:"locked,omitempty"`
+	Privacy   *bool                      `json:"privacy,omitempty"`
+	Contacts  *RegistrarDomainContactSet `json:"contacts,omitempty"`
+}
+
+// RegistrarDomainListParams contains the options available when listing
+// registered domains.
+type RegistrarDomainListParams struct {
+	PaginationParams
+}
+
+// RegistrarDomainResponse represents the response from the registrar domains
+// endpoint containing a single domain.
+type RegistrarDomainResponse struct {
+	Response
+	Result RegistrarDomain `json:"result"`
+}
+
+// RegistrarDomainsResponse represents the response from the registrar
+// domains endpoint containing multiple domains.
+type RegistrarDomainsResponse struct {
+	Response
+	Result     []RegistrarDomain `json:"result"`
+	ResultInfo ResultInfo        `json:"result_info"`
+}
+
+// ListDomains returns the domains registered with Cloudflare Registrar in an
+// account, including domains being transferred in. Every page is fetched
+// unless params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-list-domains
+func (s *RegistrarService) ListDomains(ctx context.Context, accountID string, params RegistrarDomainListParams) ([]RegistrarDomain, ResultInfo, error) {
+	var domains []RegistrarDomain
+	var info ResultInfo
+	err := s.ForEachDomainsPage(ctx, accountID, params, func(page []RegistrarDomain, pageInfo ResultInfo) bool {
+		domains = append(domains, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []RegistrarDomain{}, ResultInfo{}, err
+	}
+
+	return domains, info, nil
+}
+
+// ForEachDomainsPage is like ListDomains but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-list-domains
+func (s *RegistrarService) ForEachDomainsPage(ctx context.Context, accountID string, params RegistrarDomainListParams, fn func(items []RegistrarDomain, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/registrar/domains", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r RegistrarDomainsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal registrar domain JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetDomain fetches a single registered domain by name.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-get-domain
+func (s *RegistrarService) GetDomain(ctx context.Context, accountID, domainName string) (RegistrarDomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return RegistrarDomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if domainName == "" {
+		return RegistrarDomain{}, fmt.Errorf(errMissingResourceID, "domain")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/registrar/domains/"+domainName, nil)
+	if err != nil {
+		return RegistrarDomain{}, err
+	}
+
+	var r RegistrarDomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegistrarDomain{}, fmt.Errorf("failed to unmarshal registrar domain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateDomain changes the auto renewal, lock, privacy and contact settings
+// of a registered domain.
+//
+// API reference: https://api.cloudflare.com/#registrar-domains-update-domain
+func (s *RegistrarService) UpdateDomain(ctx context.Context, accountID, domainName string, params RegistrarDomainUpdateParams) (RegistrarDomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return RegistrarDomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if domainName == "" {
+		return RegistrarDomain{}, fmt.Errorf(errMissingResourceID, "domain")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/registrar/domains/"+domainName, params)
+	if err != nil {
+		return RegistrarDomain{}, err
+	}
+
+	var r RegistrarDomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegistrarDomain{}, fmt.Errorf("failed to unmarshal registrar domain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
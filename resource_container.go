@@ -0,0 +1,60 @@
+package cloudflare
+
+import "fmt"
+
+// ResourceContainer defines an API resource you wish to target. Should only be
+// used by API methods that can target multiple levels of ownership, such as
+// rulesets which exist for both accounts and zones.
+type ResourceContainer struct {
+	Level      RouteType
+	Identifier string
+}
+
+// AccountIdentifier returns an account level *ResourceContainer.
+func AccountIdentifier(id string) *ResourceContainer {
+	return &ResourceContainer{Level: AccountRouteType, Identifier: id}
+}
+
+// UserIdentifier returns a user level *ResourceContainer. User level resources
+// belong to the user the credentials are for so no identifier is needed.
+func UserIdentifier() *ResourceContainer {
+	return &ResourceContainer{Level: UserRouteType}
+}
+
+// ZoneIdentifier returns a zone level *ResourceContainer.
+func ZoneIdentifier(id string) *ResourceContainer {
+	return &ResourceContainer{Level: ZoneRouteType, Identifier: id}
+}
+
+// URLFragment returns the path prefix for the resource container, for example
+// "/zones/<id>" or "/user".
+func (rc *ResourceContainer) URLFragment() string {
+	if rc.Level == UserRouteType {
+		return "/" + string(rc.Level)
+	}
+	return "/" + string(rc.Level) + "/" + rc.Identifier
+}
+
+// validate ensures the resource container has a supported level and a well
+// formed identifier.
+func (rc *ResourceContainer) validate() error {
+	if rc == nil {
+		return fmt.Errorf("resource container must be provided")
+	}
+
+	switch rc.Level {
+	case AccountRouteType:
+		if !isValidAccountIdentifier(rc.Identifier) {
+			return fmt.Errorf(errInvalidAccountIdentifier, rc.Identifier)
+		}
+	case ZoneRouteType:
+		if !isValidZoneIdentifier(rc.Identifier) {
+			return fmt.Errorf(errInvalidZoneIdentifer, rc.Identifier)
+		}
+	case UserRouteType:
+	default:
+		return fmt.Errorf("unsupported resource container level: %q", rc.Level)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package cloudflare
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+
+// DefaultResponseCacheSize is the number of bytes of response bodies a
+// ResponseCache created with a size of zero holds.
+const DefaultResponseCacheSize = 16 * 1024 * 1024
+
+// ResponseCache stores the bodies of GET responses that carry an ETag and
+// revalidates them with If-None-Match, so polling unchanged resources such as
+// zones or DNS records is answered with a 304 by the API and served from
+// memory. Add it to a client with WithMiddleware:
+//
+//	cache := cloudflare.NewResponseCache(0)
+//	client.WithMiddleware(cache.Middleware())
+//
+// Entries are keyed on method and URL only, so a cache must not be shared by
+// clients using different credentials.
+type ResponseCache struct {
+	mu      sync.Mutex
+	maxSize int64
+	size    int64
+	order   *list.List // of *cachedResponse, most recently used first
+	entries map[string]*list.Element
+}
+
+// cachedResponse is a response stored by a ResponseCache.
+type cachedResponse struct {
+	key      string
+	resource string
+	etag     string
+	header   http.Header
+	body     []byte
+}
+
+// NewResponseCache returns an empty ResponseCache holding at most maxSize
+// bytes of response bodies, or DefaultResponseCacheSize if maxSize is zero.
+// When full, the least recently used responses are evicted, and responses
+// larger than maxSize are never stored.
+func NewResponseCache(maxSize int64) *ResponseCache {
+	if maxSize <= 0 {
+		maxSize = DefaultResponseCacheSize
+	}
+
+	return &ResponseCache{
+		maxSize: maxSize,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Middleware returns the RequestMiddleware that serves requests from the
+// cache. Requests with unsafe methods are passed through and evict the cached
+// responses of their resource, of the resources nested below it and of the
+// collection containing it, whatever their query strings, since they usually
+// modify all of them. Streamed responses, such as logs, are never cached.
+func (c *ResponseCache) Middleware() RequestMiddleware {
+	return func(req *http.Request, next RequestHandler) (*http.Response, error) {
+		key := http.MethodGet + " " + req.URL.String()
+		switch req.Method {
+		case http.MethodGet:
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			c.invalidate(cacheResource(req))
+			return next(req)
+		default:
+			return next(req)
+		}
+
+		if isStreaming(req.Context()) {
+			return next(req)
+		}
+
+		entry, cached := c.get(key)
+		if cached {
+			req = req.Clone(req.Context())
+			req.Header.Set("If-None-Match", entry.etag)
+		}
+
+		resp, err := next(req)
+		if err != nil {
+			return resp, err
+		}
+
+		if cached && resp.StatusCode == http.StatusNotModified {
+			resp.Body.Close()
+			return &http.Response{
+				Status:        "200 OK",
+				StatusCode:    http.StatusOK,
+				Proto:         resp.Proto,
+				ProtoMajor:    resp.ProtoMajor,
+				ProtoMinor:    resp.ProtoMinor,
+				Header:        entry.header.Clone(),
+				Body:          io.NopCloser(bytes.NewReader(entry.body)),
+				ContentLength: int64(len(entry.body)),
+				Request:       req,
+			}, nil
+		}
+
+		etag := resp.Header.Get("ETag")
+		if resp.StatusCode != http.StatusOK || etag == "" || resp.ContentLength > c.maxSize {
+			c.delete(key)
+			return resp, nil
+		}
+
+		// Read one byte more than fits so oversized bodies of unknown length
+		// are detected without buffering all of them.
+		body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxSize+1))
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+
+		if int64(len(body)) > c.maxSize {
+			c.delete(key)
+			resp.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+			return resp, nil
+		}
+
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		c.put(&cachedResponse{key: key, resource: cacheResource(req), etag: etag, header: resp.Header.Clone(), body: body})
+
+		return resp, nil
+	}
+}
+
+// Clear removes every cached response.
+func (c *ResponseCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.size = 0
+	c.order.Init()
+	c.entries = make(map[string]*list.Element)
+}
+
+func (c *ResponseCache) get(key string) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(e)
+	return e.Value.(*cachedResponse), true
+}
+
+func (c *ResponseCache) put(entry *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(entry.key)
+	c.entries[entry.key] = c.order.PushFront(entry)
+	c.size += int64(len(entry.body))
+
+	for c.size > c.maxSize {
+		c.remove(c.order.Back().Value.(*cachedResponse).key)
+	}
+}
+
+func (c *ResponseCache) delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(key)
+}
+
+// invalidate deletes the entries of resource, of the resources below it and
+// of its parent collection.
+func (c *ResponseCache) invalidate(resource string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	parent := resource[:strings.LastIndex(resource, "/")]
+	for key, e := range c.entries {
+		r := e.Value.(*cachedResponse).resource
+		if r == resource || r == parent || strings.HasPrefix(r, resource+"/") {
+			c.remove(key)
+		}
+	}
+}
+
+// remove deletes the entry of key. c.mu must be held.
+func (c *ResponseCache) remove(key string) {
+	e, ok := c.entries[key]
+	if !ok {
+		return
+	}
+
+	c.order.Remove(e)
+	delete(c.entries, key)
+	c.size -= int64(len(e.Value.(*cachedResponse).body))
+}
+
+// cacheResource returns the URL of req without its query string or a
+// trailing slash, identifying the resource it reads or writes.
+func cacheResource(req *http.Request) string {
+	return req.URL.Scheme + "://" + req.URL.Host + strings.TrimSuffix(path.Clean("/"+req.URL.Path), "/")
+}
@@ -0,0 +1,225 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// etagHandler serves body with an ETag, answering requests that already have
+// it with a 304. It records the If-None-Match header of every request.
+func etagHandler(body string, seen *[]string) http.HandlerFunc {
+	etag := fmt.Sprintf(`"%x"`, len(body))
+	return func(w http.ResponseWriter, r *http.Request) {
+		*seen = append(*seen, r.Method+" "+r.Header.Get("If-None-Match"))
+		if r.Method == http.MethodGet && r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", etag)
+		fmt.Fprint(w, body)
+	}
+}
+
+func TestResponseCache_Revalidates(t *testing.T) {
+	client, mux := setup(t)
+
+	body := `{"success": true, "result": {"id": "023e105f4ecef8ad9ca31a8372d0c353"}}`
+	var seen []string
+	mux.HandleFunc("/client/v4/zones/023e105f4ecef8ad9ca31a8372d0c353", etagHandler(body, &seen))
+
+	client.WithMiddleware(NewResponseCache(0).Middleware())
+
+	for i := 0; i < 3; i++ {
+		zone, err := client.Zones.Get(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353")
+		if err != nil {
+			t.Fatalf("request %d: unexpected error: %s", i, err)
+		}
+		if zone.ID != "023e105f4ecef8ad9ca31a8372d0c353" {
+			t.Errorf("request %d: got zone %q", i, zone.ID)
+		}
+	}
+
+	want := []string{"GET ", `GET "47"`, `GET "47"`}
+	if strings.Join(seen, ",") != strings.Join(want, ",") {
+		t.Errorf("got requests %q, want %q", seen, want)
+	}
+}
+
+func TestResponseCache_UnsafeMethodsEvict(t *testing.T) {
+	client, mux := setup(t)
+
+	var seen []string
+	mux.HandleFunc("/client/v4/zones", etagHandler(`{"success": true, "result": []}`, &seen))
+
+	client.WithMiddleware(NewResponseCache(0).Middleware())
+
+	ctx := context.Background()
+	for _, method := range []string{http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodGet, http.MethodPatch, http.MethodGet} {
+		if _, err := client.Call(ctx, method, "/zones", nil); err != nil {
+			t.Fatalf("%s: unexpected error: %s", method, err)
+		}
+	}
+
+	want := []string{"GET ", "HEAD ", "OPTIONS ", `GET "1f"`, "PATCH ", "GET "}
+	if strings.Join(seen, ",") != strings.Join(want, ",") {
+		t.Errorf("got requests %q, want %q", seen, want)
+	}
+}
+
+func TestResponseCache_SkipsLargeBodies(t *testing.T) {
+	client, mux := setup(t)
+
+	body := `{"success": true, "result": "` + strings.Repeat("a", 64) + `"}`
+	var seen []string
+	mux.HandleFunc("/client/v4/large", etagHandler(body, &seen))
+
+	client.WithMiddleware(NewResponseCache(32).Middleware())
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Call(context.Background(), http.MethodGet, "/large", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(res) != body {
+			t.Errorf("got body %q, want %q", res, body)
+		}
+	}
+
+	if want := "GET ,GET "; strings.Join(seen, ",") != want {
+		t.Errorf("got requests %q, want %q", seen, want)
+	}
+}
+
+func TestResponseCache_SkipsLargeBodiesOfUnknownLength(t *testing.T) {
+	client, mux := setup(t)
+
+	body := `{"success": true, "result": "` + strings.Repeat("a", 64) + `"}`
+	var seen []string
+	mux.HandleFunc("/client/v4/large", func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Method+" "+r.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", `"large"`)
+		// Flushing part of the body makes the response chunked.
+		fmt.Fprint(w, body[:10])
+		w.(http.Flusher).Flush()
+		fmt.Fprint(w, body[10:])
+	})
+
+	client.WithMiddleware(NewResponseCache(32).Middleware())
+
+	for i := 0; i < 2; i++ {
+		res, err := client.Call(context.Background(), http.MethodGet, "/large", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(res) != body {
+			t.Errorf("got body %q, want %q", res, body)
+		}
+	}
+
+	if want := "GET ,GET "; strings.Join(seen, ",") != want {
+		t.Errorf("got requests %q, want %q", seen, want)
+	}
+}
+
+func TestResponseCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	client, mux := setup(t)
+
+	var seen []string
+	for _, name := range []string{"a", "b", "c"} {
+		mux.HandleFunc("/client/v4/"+name, etagHandler(`{"success": true, "result": "`+name+`"}`, &seen))
+	}
+
+	// Room for two of the 32 byte bodies.
+	client.WithMiddleware(NewResponseCache(70).Middleware())
+
+	for _, name := range []string{"a", "b", "a", "c", "a", "b"} {
+		if _, err := client.Call(context.Background(), http.MethodGet, "/"+name, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %s", name, err)
+		}
+	}
+
+	// Storing c evicts b, the least recently used.
+	want := []string{"GET ", "GET ", `GET "20"`, "GET ", `GET "20"`, "GET "}
+	if strings.Join(seen, ",") != strings.Join(want, ",") {
+		t.Errorf("got requests %q, want %q", seen, want)
+	}
+}
+
+func TestResponseCache_SkipsStreamedResponses(t *testing.T) {
+	client, mux := setup(t)
+
+	var seen []string
+	mux.HandleFunc("/client/v4/logs", etagHandler("line 1\nline 2\n", &seen))
+
+	client.WithMiddleware(NewResponseCache(0).Middleware())
+
+	for i := 0; i < 2; i++ {
+		body, err := client.streamRequest(context.Background(), http.MethodGet, "/logs", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		data, err := io.ReadAll(body)
+		body.Close()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if string(data) != "line 1\nline 2\n" {
+			t.Errorf("got body %q", data)
+		}
+	}
+
+	if want := "GET ,GET "; strings.Join(seen, ",") != want {
+		t.Errorf("got requests %q, want %q", seen, want)
+	}
+}
+
+func TestResponseCache_UnsafeMethodsEvictRelatedResources(t *testing.T) {
+	client, mux := setup(t)
+
+	var seen []string
+	for _, p := range []string{"/zones", "/zones/abc", "/zones/abc/dns_records", "/zones/abcd", "/accounts"} {
+		p := p
+		handler := etagHandler(`{"success": true, "result": []}`, new([]string))
+		mux.HandleFunc("/client/v4"+p, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet && r.Header.Get("If-None-Match") == "" {
+				seen = append(seen, r.URL.RequestURI())
+			}
+			handler(w, r)
+		})
+	}
+
+	client.WithMiddleware(NewResponseCache(0).Middleware())
+
+	reads := []string{"/zones", "/zones?page=2", "/zones/abc", "/zones/abc?name=x", "/zones/abc/dns_records", "/zones/abcd", "/accounts"}
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		for _, uri := range reads {
+			if _, err := client.Call(ctx, http.MethodGet, uri, nil); err != nil {
+				t.Fatalf("%s: unexpected error: %s", uri, err)
+			}
+		}
+		if i == 0 {
+			seen = nil
+			if _, err := client.Call(ctx, http.MethodPatch, "/zones/abc", nil); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		}
+	}
+
+	// The zone, its query variants, its records and the zone list are fetched
+	// again while unrelated resources are revalidated.
+	want := []string{
+		"/client/v4/zones",
+		"/client/v4/zones?page=2",
+		"/client/v4/zones/abc",
+		"/client/v4/zones/abc?name=x",
+		"/client/v4/zones/abc/dns_records",
+	}
+	if strings.Join(seen, ",") != strings.Join(want, ",") {
+		t.Errorf("got uncached requests %q, want %q", seen, want)
+	}
+}
@@ -0,0 +1,754 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type RulesetsService service
+
+// RulesetKind is the kind of a ruleset.
+type RulesetKind string
+
+// RulesetPhase is the phase of the request lifecycle a ruleset runs in.
+type RulesetPhase string
+
+// RulesetRuleAction is the action taken when a rule's expression matches.
+type RulesetRuleAction string
+
+const (
+	RulesetKindCustom  RulesetKind = "custom"
+	RulesetKindManaged RulesetKind = "managed"
+	RulesetKindRoot    RulesetKind = "root"
+	RulesetKindZone    RulesetKind = "zone"
+
+	RulesetPhaseDDoSL4                       RulesetPhase = "ddos_l4"
+	RulesetPhaseDDoSL7                       RulesetPhase = "ddos_l7"
+	RulesetPhaseHTTPConfigSettings           RulesetPhase = "http_config_settings"
+	RulesetPhaseHTTPCustomErrors             RulesetPhase = "http_custom_errors"
+	RulesetPhaseHTTPLogCustomFields          RulesetPhase = "http_log_custom_fields"
+	RulesetPhaseHTTPRatelimit                RulesetPhase = "http_ratelimit"
+	RulesetPhaseHTTPRequestCacheSettings     RulesetPhase = "http_request_cache_settings"
+	RulesetPhaseHTTPRequestDynamicRedirect   RulesetPhase = "http_request_dynamic_redirect"
+	RulesetPhaseHTTPRequestFirewallCustom    RulesetPhase = "http_request_firewall_custom"
+	RulesetPhaseHTTPRequestFirewallManaged   RulesetPhase = "http_request_firewall_managed"
+	RulesetPhaseHTTPRequestLateTransform     RulesetPhase = "http_request_late_transform"
+	RulesetPhaseHTTPRequestOrigin            RulesetPhase = "http_request_origin"
+	RulesetPhaseHTTPRequestRedirect          RulesetPhase = "http_request_redirect"
+	RulesetPhaseHTTPRequestSanitize          RulesetPhase = "http_request_sanitize"
+	RulesetPhaseHTTPRequestSBFM              RulesetPhase = "http_request_sbfm"
+	RulesetPhaseHTTPRequestTransform         RulesetPhase = "http_request_transform"
+	RulesetPhaseHTTPResponseCompression      RulesetPhase = "http_response_compression"
+	RulesetPhaseHTTPResponseFirewallManaged  RulesetPhase = "http_response_firewall_managed"
+	RulesetPhaseHTTPResponseHeadersTransform RulesetPhase = "http_response_headers_transform"
+	RulesetPhaseMagicTransit                 RulesetPhase = "magic_transit"
+	RulesetPhaseMagicTransitIDsManaged       RulesetPhase = "magic_transit_ids_managed"
+	RulesetPhaseMagicTransitManaged          RulesetPhase = "magic_transit_managed"
+
+	RulesetRuleActionBlock                RulesetRuleAction = "block"
+	RulesetRuleActionChallenge            RulesetRuleAction = "challenge"
+	RulesetRuleActionCompressResponse     RulesetRuleAction = "compress_response"
+	RulesetRuleActionDDoSDynamic          RulesetRuleAction = "ddos_dynamic"
+	RulesetRuleActionExecute              RulesetRuleAction = "execute"
+	RulesetRuleActionForceConnectionClose RulesetRuleAction = "force_connection_close"
+	RulesetRuleActionJSChallenge          RulesetRuleAction = "js_challenge"
+	RulesetRuleActionLog                  RulesetRuleAction = "log"
+	RulesetRuleActionLogCustomField       RulesetRuleAction = "log_custom_field"
+	RulesetRuleActionManagedChallenge     RulesetRuleAction = "managed_challenge"
+	RulesetRuleActionRedirect             RulesetRuleAction = "redirect"
+	RulesetRuleActionRewrite              RulesetRuleAction = "rewrite"
+	RulesetRuleActionRoute                RulesetRuleAction = "route"
+	RulesetRuleActionScore                RulesetRuleAction = "score"
+	RulesetRuleActionServeError           RulesetRuleAction = "serve_error"
+	RulesetRuleActionSetCacheSettings     RulesetRuleAction = "set_cache_settings"
+	RulesetRuleActionSetConfig            RulesetRuleAction = "set_config"
+	RulesetRuleActionSkip                 RulesetRuleAction = "skip"
+)
+
+// Ruleset contains the structure of a ruleset.
+type Ruleset struct {
+	ID                       string        `json:"id,omitempty"`
+	Name                     string        `json:"name,omitempty"`
+	Description              string        `json:"description,omitempty"`
+	Kind                     RulesetKind   `json:"kind,omitempty"`
+	Version                  *string       `json:"version,omitempty"`
+	LastUpdated              *time.Time    `json:"last_updated,omitempty"`
+	Phase                    RulesetPhase  `json:"phase,omitempty"`
+	Rules                    []RulesetRule `json:"rules"`
+	ShareableEntitlementName string        `json:"shareable_entitlement_name,omitempty"`
+}
+
+// RulesetRule contains information about a single ruleset rule.
+type RulesetRule struct {
+	ID               string                       `json:"id,omitempty"`
+	Version          *string                      `json:"version,omitempty"`
+	Action           RulesetRuleAction            `json:"action"`
+	ActionParameters *RulesetRuleActionParameters `json:"action_parameters,omitempty"`
+	Expression       string                       `json:"expression"`
+	Description      string                       `json:"description,omitempty"`
+	LastUpdated      *time.Time                   `json:"last_updated,omitempty"`
+	Ref              string                       `json:"ref,omitempty"`
+	Enabled          *bool                        `json:"enabled,omitempty"`
+	ScoreThreshold   int                          `json:"score_threshold,omitempty"`
+	RateLimit        *RulesetRuleRateLimit        `json:"ratelimit,omitempty"`
+	Logging          *RulesetRuleLogging          `json:"logging,omitempty"`
+}
+
+// RulesetRuleRateLimit contains the rate limiting configuration of a rule in
+// the http_ratelimit phase. Either RequestsPerPeriod or ScorePerPeriod should
+// be set.
+type RulesetRuleRateLimit struct {
+	Characteristics         []string        `json:"characteristics,omitempty"`
+	RequestsPerPeriod       int             `json:"requests_per_period,omitempty"`
+	ScorePerPeriod          int             `json:"score_per_period,omitempty"`
+	ScoreResponseHeaderName string          `json:"score_response_header_name,omitempty"`
+	Period                  RateLimitPeriod `json:"period,omitempty"`
+	MitigationTimeout       int             `json:"mitigation_timeout,omitempty"`
+	CountingExpression      string          `json:"counting_expression,omitempty"`
+	RequestsToOrigin        bool            `json:"requests_to_origin,omitempty"`
+}
+
+// RulesetRuleLogging contains the logging configuration for a rule.
+type RulesetRuleLogging struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// RulesetRuleActionParameters specifies the action parameters for a rule.
+// Which fields apply depends on the rule's action.
+type RulesetRuleActionParameters struct {
+	// execute
+	ID          string                                  `json:"id,omitempty"`
+	Overrides   *RulesetRuleActionParametersOverrides   `json:"overrides,omitempty"`
+	MatchedData *RulesetRuleActionParametersMatchedData `json:"matched_data,omitempty"`
+
+	// skip
+	Ruleset  string              `json:"ruleset,omitempty"`
+	Rulesets []string            `json:"rulesets,omitempty"`
+	Rules    map[string][]string `json:"rules,omitempty"`
+	Phases   []string            `json:"phases,omitempty"`
+	Products []string            `json:"products,omitempty"`
+
+	// rewrite
+	URI     *RulesetRuleActionParametersURI                  `json:"uri,omitempty"`
+	Headers map[string]RulesetRuleActionParametersHTTPHeader `json:"headers,omitempty"`
+
+	// block
+	Response *RulesetRuleActionParametersBlockResponse `json:"response,omitempty"`
+
+	// redirect
+	FromValue *RulesetRuleActionParametersFromValue `json:"from_value,omitempty"`
+	FromList  *RulesetRuleActionParametersFromList  `json:"from_list,omitempty"`
+
+	// set_cache_settings
+	Cache                   *bool                                  `json:"cache,omitempty"`
+	EdgeTTL                 *RulesetRuleActionParametersEdgeTTL    `json:"edge_ttl,omitempty"`
+	BrowserTTL              *RulesetRuleActionParametersBrowserTTL `json:"browser_ttl,omitempty"`
+	ServeStale              *RulesetRuleActionParametersServeStale `json:"serve_stale,omitempty"`
+	CacheKey                *RulesetRuleActionParametersCacheKey   `json:"cache_key,omitempty"`
+	RespectStrongETags      *bool                                  `json:"respect_strong_etags,omitempty"`
+	OriginErrorPagePassthru *bool                                  `json:"origin_error_page_passthru,omitempty"`
+
+	// compress_response
+	Algorithms []RulesetRuleActionParametersCompressionAlgorithm `json:"algorithms,omitempty"`
+
+	// route
+	HostHeader string                             `json:"host_header,omitempty"`
+	Origin     *RulesetRuleActionParametersOrigin `json:"origin,omitempty"`
+	SNI        *RulesetRuleActionParametersSNI    `json:"sni,omitempty"`
+
+	// set_config
+	AutomaticHTTPSRewrites  *bool                                  `json:"automatic_https_rewrites,omitempty"`
+	AutoMinify              *RulesetRuleActionParametersAutoMinify `json:"autominify,omitempty"`
+	BrowserIntegrityCheck   *bool                                  `json:"bic,omitempty"`
+	DisableApps             *bool                                  `json:"disable_apps,omitempty"`
+	DisableZaraz            *bool                                  `json:"disable_zaraz,omitempty"`
+	EmailObfuscation        *bool                                  `json:"email_obfuscation,omitempty"`
+	Fonts                   *bool                                  `json:"fonts,omitempty"`
+	HotlinkProtection       *bool                                  `json:"hotlink_protection,omitempty"`
+	Mirage                  *bool                                  `json:"mirage,omitempty"`
+	OpportunisticEncryption *bool                                  `json:"opportunistic_encryption,omitempty"`
+	Polish                  string                                 `json:"polish,omitempty"`
+	RocketLoader            *bool                                  `json:"rocket_loader,omitempty"`
+	SecurityLevel           string                                 `json:"security_level,omitempty"`
+	ServerSideExcludes      *bool                                  `json:"server_side_excludes,omitempty"`
+	SSL                     string                                 `json:"ssl,omitempty"`
+	SXG                     *bool                                  `json:"sxg,omitempty"`
+}
+
+// RulesetRuleActionParametersOverrides contains the overrides applied to a
+// ruleset being executed.
+type RulesetRuleActionParametersOverrides struct {
+	Enabled          *bool                                   `json:"enabled,omitempty"`
+	Action           RulesetRuleAction                       `json:"action,omitempty"`
+	SensitivityLevel string                                  `json:"sensitivity_level,omitempty"`
+	Categories       []RulesetRuleActionParametersCategories `json:"categories,omitempty"`
+	Rules            []RulesetRuleActionParametersRules      `json:"rules,omitempty"`
+}
+
+// RulesetRuleActionParametersCategories overrides every rule with a given tag.
+type RulesetRuleActionParametersCategories struct {
+	Category         string            `json:"category"`
+	Action           RulesetRuleAction `json:"action,omitempty"`
+	Enabled          *bool             `json:"enabled,omitempty"`
+	SensitivityLevel string            `json:"sensitivity_level,omitempty"`
+}
+
+// RulesetRuleActionParametersRules overrides a single rule by ID.
+type RulesetRuleActionParametersRules struct {
+	ID               string            `json:"id"`
+	Action           RulesetRuleAction `json:"action,omitempty"`
+	Enabled          *bool             `json:"enabled,omitempty"`
+	ScoreThreshold   int               `json:"score_threshold,omitempty"`
+	SensitivityLevel string            `json:"sensitivity_level,omitempty"`
+}
+
+// RulesetRuleActionParametersMatchedData holds the public key used to encrypt
+// the payload of matched requests.
+type RulesetRuleActionParametersMatchedData struct {
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+// RulesetRuleActionParametersURI holds the URI rewrite configuration.
+type RulesetRuleActionParametersURI struct {
+	Path   *RulesetRuleActionParametersURIPath  `json:"path,omitempty"`
+	Query  *RulesetRuleActionParametersURIQuery `json:"query,omitempty"`
+	Origin *bool                                `json:"origin,omitempty"`
+}
+
+// RulesetRuleActionParametersURIPath holds the path rewrite, either as a
+// static value or a dynamic expression.
+type RulesetRuleActionParametersURIPath struct {
+	Value      string `json:"value,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// RulesetRuleActionParametersURIQuery holds the query string rewrite. Value is
+// a pointer so an empty query string can be used to strip the query.
+type RulesetRuleActionParametersURIQuery struct {
+	Value      *string `json:"value,omitempty"`
+	Expression string  `json:"expression,omitempty"`
+}
+
+// RulesetRuleActionParametersHTTPHeader is a header modification. Operation is
+// one of "set", "add" or "remove".
+type RulesetRuleActionParametersHTTPHeader struct {
+	Operation  string `json:"operation,omitempty"`
+	Value      string `json:"value,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// RulesetRuleActionParametersBlockResponse is the custom response served by a
+// block action.
+type RulesetRuleActionParametersBlockResponse struct {
+	StatusCode  uint16 `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Content     string `json:"content,omitempty"`
+}
+
+// RulesetRuleActionParametersFromValue holds the target of a single redirect.
+type RulesetRuleActionParametersFromValue struct {
+	StatusCode          uint16                               `json:"status_code,omitempty"`
+	TargetURL           RulesetRuleActionParametersTargetURL `json:"target_url"`
+	PreserveQueryString *bool                                `json:"preserve_query_string,omitempty"`
+}
+
+// RulesetRuleActionParametersFromList looks up the redirect of a request in a
+// redirect list. Key is the field matched against the source URLs of the
+// list, typically "http.request.full_uri".
+type RulesetRuleActionParametersFromList struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// RulesetRuleActionParametersTargetURL is the URL a redirect points at, either
+// as a static value or a dynamic expression.
+type RulesetRuleActionParametersTargetURL struct {
+	Value      string `json:"value,omitempty"`
+	Expression string `json:"expression,omitempty"`
+}
+
+// RulesetRuleActionParametersEdgeTTL controls how long content is cached at
+// the edge. Mode is one of "respect_origin", "bypass_by_default" or
+// "override_origin".
+type RulesetRuleActionParametersEdgeTTL struct {
+	Mode          string                                     `json:"mode,omitempty"`
+	Default       *uint                                      `json:"default,omitempty"`
+	StatusCodeTTL []RulesetRuleActionParametersStatusCodeTTL `json:"status_code_ttl,omitempty"`
+}
+
+// RulesetRuleActionParametersStatusCodeTTL sets the edge TTL for a single
+// status code or a range of them.
+type RulesetRuleActionParametersStatusCodeTTL struct {
+	StatusCodeRange *RulesetRuleActionParametersStatusCodeRange `json:"status_code_range,omitempty"`
+	StatusCode      *uint                                       `json:"status_code,omitempty"`
+	Value           *int                                        `json:"value,omitempty"`
+}
+
+// RulesetRuleActionParametersStatusCodeRange is an inclusive range of status
+// codes.
+type RulesetRuleActionParametersStatusCodeRange struct {
+	From *uint `json:"from,omitempty"`
+	To   *uint `json:"to,omitempty"`
+}
+
+// RulesetRuleActionParametersBrowserTTL controls how long content is cached
+// by browsers.
+type RulesetRuleActionParametersBrowserTTL struct {
+	Mode    string `json:"mode"`
+	Default *uint  `json:"default,omitempty"`
+}
+
+// RulesetRuleActionParametersServeStale controls whether stale content is
+// served while revalidating.
+type RulesetRuleActionParametersServeStale struct {
+	DisableStaleWhileUpdating *bool `json:"disable_stale_while_updating,omitempty"`
+}
+
+// RulesetRuleActionParametersCacheKey customises the cache key.
+type RulesetRuleActionParametersCacheKey struct {
+	CacheByDeviceType       *bool                                 `json:"cache_by_device_type,omitempty"`
+	IgnoreQueryStringsOrder *bool                                 `json:"ignore_query_strings_order,omitempty"`
+	CacheDeceptionArmor     *bool                                 `json:"cache_deception_armor,omitempty"`
+	CustomKey               *RulesetRuleActionParametersCustomKey `json:"custom_key,omitempty"`
+}
+
+// RulesetRuleActionParametersCustomKey lists the request components used to
+// build the cache key.
+type RulesetRuleActionParametersCustomKey struct {
+	Query  *RulesetRuleActionParametersCustomKeyList   `json:"query_string,omitempty"`
+	Header *RulesetRuleActionParametersCustomKeyHeader `json:"header,omitempty"`
+	Cookie *RulesetRuleActionParametersCustomKeyList   `json:"cookie,omitempty"`
+	Host   *RulesetRuleActionParametersCustomKeyHost   `json:"host,omitempty"`
+}
+
+// RulesetRuleActionParametersCustomKeyList includes or excludes named values
+// from the cache key.
+type RulesetRuleActionParametersCustomKeyList struct {
+	Include []string `json:"include,omitempty"`
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// RulesetRuleActionParametersCustomKeyHeader includes headers in the cache
+// key.
+type RulesetRuleActionParametersCustomKeyHeader struct {
+	RulesetRuleActionParametersCustomKeyList
+	CheckPresence []string `json:"check_presence,omitempty"`
+	ExcludeOrigin *bool    `json:"exclude_origin,omitempty"`
+}
+
+// RulesetRuleActionParametersCustomKeyHost controls whether the resolved
+// hostname is used in the cache key.
+type RulesetRuleActionParametersCustomKeyHost struct {
+	Resolved *bool `json:"resolved,omitempty"`
+}
+
+// RulesetRuleActionParametersCompressionAlgorithm is a compression algorithm
+// used by the compress_response action.
+type RulesetRuleActionParametersCompressionAlgorithm struct {
+	Name string `json:"name"`
+}
+
+// RulesetRuleActionParametersOrigin overrides the origin a request is sent
+// to. Host resolves the request using a DNS record of the zone instead of
+// the requested hostname.
+type RulesetRuleActionParametersOrigin struct {
+	Host string `json:"host,omitempty"`
+	Port uint16 `json:"port,omitempty"`
+}
+
+// RulesetRuleActionParametersSNI overrides the Server Name Indication sent
+// to the origin.
+type RulesetRuleActionParametersSNI struct {
+	Value string `json:"value"`
+}
+
+// RulesetRuleActionParametersAutoMinify selects the content types minified
+// by a configuration rule.
+type RulesetRuleActionParametersAutoMinify struct {
+	HTML bool `json:"html"`
+	CSS  bool `json:"css"`
+	JS   bool `json:"js"`
+}
+
+// RulesetParams contains the fields used to create or update a ruleset. Kind,
+// Name and Phase can only be set on creation.
+type RulesetParams struct {
+	Name        string        `json:"name,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Kind        RulesetKind   `json:"kind,omitempty"`
+	Phase       RulesetPhase  `json:"phase,omitempty"`
+	Rules       []RulesetRule `json:"rules"`
+}
+
+// RulesetListParams contains the options available when listing rulesets.
+type RulesetListParams struct {
+	PerPage int `url:"per_page,omitempty"`
+
+	CursorPaginationParams
+}
+
+// RulesetResponse contains a single Ruleset.
+type RulesetResponse struct {
+	Response
+	Result Ruleset `json:"result"`
+}
+
+// RulesetsResponse contains multiple Rulesets.
+type RulesetsResponse struct {
+	Response
+	Result     []Ruleset  `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// List returns all rulesets for the account or zone. The rules of each ruleset
+// are not included. Unless params.Cursor is set, every page is fetched by
+// following the cursors returned by the API.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-list-account-rulesets
+func (s *RulesetsService) List(ctx context.Context, rc *ResourceContainer, params RulesetListParams) ([]Ruleset, ResultInfo, error) {
+	var rulesets []Ruleset
+	var info ResultInfo
+	err := s.ForEachPage(ctx, rc, params, func(page []Ruleset, pageInfo ResultInfo) bool {
+		rulesets = append(rulesets, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []Ruleset{}, ResultInfo{}, err
+	}
+
+	return rulesets, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-list-account-rulesets
+func (s *RulesetsService) ForEachPage(ctx context.Context, rc *ResourceContainer, params RulesetListParams, fn func(items []Ruleset, info ResultInfo) bool) error {
+	if err := validateRulesetContainer(rc); err != nil {
+		return err
+	}
+
+	return s.client.listCursorPages(ctx, rc.URLFragment()+"/rulesets", "cursor", &params, &params.CursorPaginationParams, func(res []byte) (ResultInfo, error) {
+		var r RulesetsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single ruleset including its rules.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-get-an-account-ruleset
+func (s *RulesetsService) Get(ctx context.Context, rc *ResourceContainer, rulesetID string) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if rulesetID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/rulesets/"+rulesetID, nil)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-create-an-account-ruleset
+func (s *RulesetsService) Create(ctx context.Context, rc *ResourceContainer, params RulesetParams) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if params.Name == "" || params.Kind == "" || params.Phase == "" {
+		return Ruleset{}, errors.New("name, kind and phase are required to create a ruleset")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/rulesets", params)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces the description and rules of an existing ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-update-an-account-ruleset
+func (s *RulesetsService) Update(ctx context.Context, rc *ResourceContainer, rulesetID string, params RulesetParams) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if rulesetID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/rulesets/"+rulesetID, params)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a ruleset and all of its versions.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-delete-an-account-ruleset
+func (s *RulesetsService) Delete(ctx context.Context, rc *ResourceContainer, rulesetID string) error {
+	if err := validateRulesetContainer(rc); err != nil {
+		return err
+	}
+
+	if rulesetID == "" {
+		return fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/rulesets/"+rulesetID, nil)
+	return err
+}
+
+// GetEntrypoint fetches the entry point ruleset of a phase. Every phase has at
+// most one entry point ruleset per account or zone.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-get-an-account-entry-point-ruleset
+func (s *RulesetsService) GetEntrypoint(ctx context.Context, rc *ResourceContainer, phase RulesetPhase) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if phase == "" {
+		return Ruleset{}, errors.New("ruleset phase must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/rulesets/phases/"+string(phase)+"/entrypoint", nil)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateEntrypoint replaces the rules of the entry point ruleset of a phase,
+// creating it if it does not exist yet.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-update-an-account-entry-point-ruleset
+func (s *RulesetsService) UpdateEntrypoint(ctx context.Context, rc *ResourceContainer, phase RulesetPhase, params RulesetParams) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if phase == "" {
+		return Ruleset{}, errors.New("ruleset phase must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, rc.URLFragment()+"/rulesets/phases/"+string(phase)+"/entrypoint", params)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateRule appends a rule to an existing ruleset and returns the updated
+// ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-create-an-account-ruleset-rule
+func (s *RulesetsService) CreateRule(ctx context.Context, rc *ResourceContainer, rulesetID string, rule RulesetRule) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if rulesetID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, rc.URLFragment()+"/rulesets/"+rulesetID+"/rules", rule)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateRule modifies a single rule within a ruleset and returns the updated
+// ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-update-an-account-ruleset-rule
+func (s *RulesetsService) UpdateRule(ctx context.Context, rc *ResourceContainer, rulesetID, ruleID string, rule RulesetRule) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if rulesetID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	if ruleID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, rc.URLFragment()+"/rulesets/"+rulesetID+"/rules/"+ruleID, rule)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteRule removes a single rule from a ruleset and returns the updated
+// ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-delete-an-account-ruleset-rule
+func (s *RulesetsService) DeleteRule(ctx context.Context, rc *ResourceContainer, rulesetID, ruleID string) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if rulesetID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	if ruleID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodDelete, rc.URLFragment()+"/rulesets/"+rulesetID+"/rules/"+ruleID, nil)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListVersions returns the versions of a ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-list-an-account-ruleset-s-versions
+func (s *RulesetsService) ListVersions(ctx context.Context, rc *ResourceContainer, rulesetID string) ([]Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return []Ruleset{}, err
+	}
+
+	if rulesetID == "" {
+		return []Ruleset{}, fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/rulesets/"+rulesetID+"/versions", nil)
+	if err != nil {
+		return []Ruleset{}, err
+	}
+
+	var r RulesetsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetVersion fetches a specific version of a ruleset.
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-get-an-account-ruleset-version
+func (s *RulesetsService) GetVersion(ctx context.Context, rc *ResourceContainer, rulesetID, version string) (Ruleset, error) {
+	if err := validateRulesetContainer(rc); err != nil {
+		return Ruleset{}, err
+	}
+
+	if rulesetID == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "ruleset")
+	}
+
+	if version == "" {
+		return Ruleset{}, fmt.Errorf(errMissingResourceID, "version")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, rc.URLFragment()+"/rulesets/"+rulesetID+"/versions/"+version, nil)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	var r RulesetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Ruleset{}, fmt.Errorf("failed to unmarshal ruleset JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// validateRulesetContainer ensures rulesets are only requested for accounts
+// or zones.
+func validateRulesetContainer(rc *ResourceContainer) error {
+	if err := rc.validate(); err != nil {
+		return err
+	}
+
+	if rc.Level == UserRouteType {
+		return errors.New("rulesets must belong to an account or zone")
+	}
+
+	return nil
+}
@@ -0,0 +1,807 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+type SecondaryDNSService service
+
+// SecondaryDNSPeer is a nameserver exchanging zone transfers with
+// Cloudflare. Incoming transfers are pulled from peers and outgoing
+// transfers pushed to them. TSIGID names the TSIG key signing transfers
+// with the peer.
+type SecondaryDNSPeer struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name"`
+	IP         string `json:"ip,omitempty"`
+	Port       int    `json:"port,omitempty"`
+	IxfrEnable bool   `json:"ixfr_enable"`
+	TSIGID     string `json:"tsig_id,omitempty"`
+}
+
+// SecondaryDNSTSIG is a TSIG key used to authenticate zone transfers. Algo
+// is the algorithm, such as "hmac-sha512.".
+type SecondaryDNSTSIG struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+	Algo   string `json:"algo"`
+}
+
+// SecondaryDNSACL allows the addresses of IPRange to request outgoing zone
+// transfers.
+type SecondaryDNSACL struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	IPRange string `json:"ip_range"`
+}
+
+// SecondaryDNSIncoming is the configuration of a secondary zone, whose
+// records are transferred from Peers every AutoRefreshSeconds.
+type SecondaryDNSIncoming struct {
+	ID                 string     `json:"id,omitempty"`
+	Name               string     `json:"name"`
+	Peers              []string   `json:"peers"`
+	AutoRefreshSeconds int        `json:"auto_refresh_seconds,omitempty"`
+	SOASerial          int        `json:"soa_serial,omitempty"`
+	CreatedTime        *time.Time `json:"created_time,omitempty"`
+	CheckedTime        *time.Time `json:"checked_time,omitempty"`
+	ModifiedTime       *time.Time `json:"modified_time,omitempty"`
+}
+
+// SecondaryDNSOutgoing is the configuration of a primary zone, whose records
+// are transferred to Peers.
+type SecondaryDNSOutgoing struct {
+	ID                  string     `json:"id,omitempty"`
+	Name                string     `json:"name"`
+	Peers               []string   `json:"peers"`
+	SOASerial           int        `json:"soa_serial,omitempty"`
+	CreatedTime         *time.Time `json:"created_time,omitempty"`
+	CheckedTime         *time.Time `json:"checked_time,omitempty"`
+	LastTransferredTime *time.Time `json:"last_transferred_time,omitempty"`
+}
+
+// SecondaryDNSPeerResponse represents the response from the secondary DNS
+// peers endpoint containing a single peer.
+type SecondaryDNSPeerResponse struct {
+	Response
+	Result SecondaryDNSPeer `json:"result"`
+}
+
+// SecondaryDNSPeersResponse represents the response from the secondary DNS
+// peers endpoint containing multiple peers.
+type SecondaryDNSPeersResponse struct {
+	Response
+	Result []SecondaryDNSPeer `json:"result"`
+}
+
+// SecondaryDNSTSIGResponse represents the response from the secondary DNS
+// TSIGs endpoint containing a single TSIG key.
+type SecondaryDNSTSIGResponse struct {
+	Response
+	Result SecondaryDNSTSIG `json:"result"`
+}
+
+// SecondaryDNSTSIGsResponse represents the response from the secondary DNS
+// TSIGs endpoint containing multiple TSIG keys.
+type SecondaryDNSTSIGsResponse struct {
+	Response
+	Result []SecondaryDNSTSIG `json:"result"`
+}
+
+// SecondaryDNSACLResponse represents the response from the secondary DNS
+// ACLs endpoint containing a single ACL.
+type SecondaryDNSACLResponse struct {
+	Response
+	Result SecondaryDNSACL `json:"result"`
+}
+
+// SecondaryDNSACLsResponse represents the response from the secondary DNS
+// ACLs endpoint containing multiple ACLs.
+type SecondaryDNSACLsResponse struct {
+	Response
+	Result []SecondaryDNSACL `json:"result"`
+}
+
+// SecondaryDNSIncomingResponse represents the response from the incoming
+// zone transfer endpoint.
+type SecondaryDNSIncomingResponse struct {
+	Response
+	Result SecondaryDNSIncoming `json:"result"`
+}
+
+// SecondaryDNSOutgoingResponse represents the response from the outgoing
+// zone transfer endpoint.
+type SecondaryDNSOutgoingResponse struct {
+	Response
+	Result SecondaryDNSOutgoing `json:"result"`
+}
+
+// SecondaryDNSStatusResponse represents the response from the secondary DNS
+// endpoints returning a status message.
+type SecondaryDNSStatusResponse struct {
+	Response
+	Result string `json:"result"`
+}
+
+// ListPeers returns the peers of an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer-list-peers
+func (s *SecondaryDNSService) ListPeers(ctx context.Context, accountID string) ([]SecondaryDNSPeer, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []SecondaryDNSPeer{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/secondary_dns/peers", nil)
+	if err != nil {
+		return []SecondaryDNSPeer{}, err
+	}
+
+	var r SecondaryDNSPeersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SecondaryDNSPeer{}, fmt.Errorf("failed to unmarshal secondary dns peer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetPeer fetches a single peer.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer-peer-details
+func (s *SecondaryDNSService) GetPeer(ctx context.Context, accountID, peerID string) (SecondaryDNSPeer, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSPeer{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if peerID == "" {
+		return SecondaryDNSPeer{}, fmt.Errorf(errMissingResourceID, "peer")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/secondary_dns/peers/"+peerID, nil)
+	if err != nil {
+		return SecondaryDNSPeer{}, err
+	}
+
+	var r SecondaryDNSPeerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSPeer{}, fmt.Errorf("failed to unmarshal secondary dns peer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreatePeer adds a peer to an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer-create-peer
+func (s *SecondaryDNSService) CreatePeer(ctx context.Context, accountID string, peer SecondaryDNSPeer) (SecondaryDNSPeer, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSPeer{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if err := validateSecondaryDNSPeer(peer); err != nil {
+		return SecondaryDNSPeer{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/secondary_dns/peers", peer)
+	if err != nil {
+		return SecondaryDNSPeer{}, err
+	}
+
+	var r SecondaryDNSPeerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSPeer{}, fmt.Errorf("failed to unmarshal secondary dns peer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdatePeer replaces a peer.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer-update-peer
+func (s *SecondaryDNSService) UpdatePeer(ctx context.Context, accountID, peerID string, peer SecondaryDNSPeer) (SecondaryDNSPeer, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSPeer{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if peerID == "" {
+		return SecondaryDNSPeer{}, fmt.Errorf(errMissingResourceID, "peer")
+	}
+
+	if err := validateSecondaryDNSPeer(peer); err != nil {
+		return SecondaryDNSPeer{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/secondary_dns/peers/"+peerID, peer)
+	if err != nil {
+		return SecondaryDNSPeer{}, err
+	}
+
+	var r SecondaryDNSPeerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSPeer{}, fmt.Errorf("failed to unmarshal secondary dns peer JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeletePeer removes a peer.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-peer-delete-peer
+func (s *SecondaryDNSService) DeletePeer(ctx context.Context, accountID, peerID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if peerID == "" {
+		return fmt.Errorf(errMissingResourceID, "peer")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/secondary_dns/peers/"+peerID, nil)
+	return err
+}
+
+// ListTSIGs returns the TSIG keys of an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-list-tsigs
+func (s *SecondaryDNSService) ListTSIGs(ctx context.Context, accountID string) ([]SecondaryDNSTSIG, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []SecondaryDNSTSIG{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/secondary_dns/tsigs", nil)
+	if err != nil {
+		return []SecondaryDNSTSIG{}, err
+	}
+
+	var r SecondaryDNSTSIGsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SecondaryDNSTSIG{}, fmt.Errorf("failed to unmarshal secondary dns tsig JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetTSIG fetches a single TSIG key.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-tsig-details
+func (s *SecondaryDNSService) GetTSIG(ctx context.Context, accountID, tsigID string) (SecondaryDNSTSIG, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSTSIG{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tsigID == "" {
+		return SecondaryDNSTSIG{}, fmt.Errorf(errMissingResourceID, "tsig")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/secondary_dns/tsigs/"+tsigID, nil)
+	if err != nil {
+		return SecondaryDNSTSIG{}, err
+	}
+
+	var r SecondaryDNSTSIGResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSTSIG{}, fmt.Errorf("failed to unmarshal secondary dns tsig JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateTSIG adds a TSIG key to an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-create-tsig
+func (s *SecondaryDNSService) CreateTSIG(ctx context.Context, accountID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSTSIG{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tsig.Name == "" || tsig.Secret == "" || tsig.Algo == "" {
+		return SecondaryDNSTSIG{}, errors.New("name, secret and algorithm must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/secondary_dns/tsigs", tsig)
+	if err != nil {
+		return SecondaryDNSTSIG{}, err
+	}
+
+	var r SecondaryDNSTSIGResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSTSIG{}, fmt.Errorf("failed to unmarshal secondary dns tsig JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateTSIG replaces a TSIG key.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-update-tsig
+func (s *SecondaryDNSService) UpdateTSIG(ctx context.Context, accountID, tsigID string, tsig SecondaryDNSTSIG) (SecondaryDNSTSIG, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSTSIG{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tsigID == "" {
+		return SecondaryDNSTSIG{}, fmt.Errorf(errMissingResourceID, "tsig")
+	}
+
+	if tsig.Name == "" || tsig.Secret == "" || tsig.Algo == "" {
+		return SecondaryDNSTSIG{}, errors.New("name, secret and algorithm must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/secondary_dns/tsigs/"+tsigID, tsig)
+	if err != nil {
+		return SecondaryDNSTSIG{}, err
+	}
+
+	var r SecondaryDNSTSIGResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSTSIG{}, fmt.Errorf("failed to unmarshal secondary dns tsig JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteTSIG removes a TSIG key.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-tsig-delete-tsig
+func (s *SecondaryDNSService) DeleteTSIG(ctx context.Context, accountID, tsigID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tsigID == "" {
+		return fmt.Errorf(errMissingResourceID, "tsig")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/secondary_dns/tsigs/"+tsigID, nil)
+	return err
+}
+
+// ListACLs returns the ACLs of an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-acl-list-acls
+func (s *SecondaryDNSService) ListACLs(ctx context.Context, accountID string) ([]SecondaryDNSACL, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []SecondaryDNSACL{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/secondary_dns/acls", nil)
+	if err != nil {
+		return []SecondaryDNSACL{}, err
+	}
+
+	var r SecondaryDNSACLsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SecondaryDNSACL{}, fmt.Errorf("failed to unmarshal secondary dns acl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetACL fetches a single ACL.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-acl-acl-details
+func (s *SecondaryDNSService) GetACL(ctx context.Context, accountID, aclID string) (SecondaryDNSACL, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSACL{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if aclID == "" {
+		return SecondaryDNSACL{}, fmt.Errorf(errMissingResourceID, "acl")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/secondary_dns/acls/"+aclID, nil)
+	if err != nil {
+		return SecondaryDNSACL{}, err
+	}
+
+	var r SecondaryDNSACLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSACL{}, fmt.Errorf("failed to unmarshal secondary dns acl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateACL adds an ACL to an account.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-acl-create-acl
+func (s *SecondaryDNSService) CreateACL(ctx context.Context, accountID string, acl SecondaryDNSACL) (SecondaryDNSACL, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSACL{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if acl.Name == "" {
+		return SecondaryDNSACL{}, errors.New("name must be provided")
+	}
+
+	if _, _, err := net.ParseCIDR(acl.IPRange); err != nil {
+		return SecondaryDNSACL{}, fmt.Errorf("invalid ip range: %q", acl.IPRange)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/secondary_dns/acls", acl)
+	if err != nil {
+		return SecondaryDNSACL{}, err
+	}
+
+	var r SecondaryDNSACLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSACL{}, fmt.Errorf("failed to unmarshal secondary dns acl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateACL replaces an ACL.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-acl-update-acl
+func (s *SecondaryDNSService) UpdateACL(ctx context.Context, accountID, aclID string, acl SecondaryDNSACL) (SecondaryDNSACL, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return SecondaryDNSACL{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if aclID == "" {
+		return SecondaryDNSACL{}, fmt.Errorf(errMissingResourceID, "acl")
+	}
+
+	if acl.Name == "" {
+		return SecondaryDNSACL{}, errors.New("name must be provided")
+	}
+
+	if _, _, err := net.ParseCIDR(acl.IPRange); err != nil {
+		return SecondaryDNSACL{}, fmt.Errorf("invalid ip range: %q", acl.IPRange)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/secondary_dns/acls/"+aclID, acl)
+	if err != nil {
+		return SecondaryDNSACL{}, err
+	}
+
+	var r SecondaryDNSACLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSACL{}, fmt.Errorf("failed to unmarshal secondary dns acl JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteACL removes an ACL.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-acl-delete-acl
+func (s *SecondaryDNSService) DeleteACL(ctx context.Context, accountID, aclID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if aclID == "" {
+		return fmt.Errorf(errMissingResourceID, "acl")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/secondary_dns/acls/"+aclID, nil)
+	return err
+}
+
+// GetIncoming returns the incoming zone transfer configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone-secondary-zone-configuration-details
+func (s *SecondaryDNSService) GetIncoming(ctx context.Context, zoneID string) (SecondaryDNSIncoming, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SecondaryDNSIncoming{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/secondary_dns/incoming", nil)
+	if err != nil {
+		return SecondaryDNSIncoming{}, err
+	}
+
+	var r SecondaryDNSIncomingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSIncoming{}, fmt.Errorf("failed to unmarshal secondary dns incoming JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateIncoming configures a zone as a secondary zone, transferred into
+// Cloudflare from its peers.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone-create-secondary-zone-configuration
+func (s *SecondaryDNSService) CreateIncoming(ctx context.Context, zoneID string, config SecondaryDNSIncoming) (SecondaryDNSIncoming, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SecondaryDNSIncoming{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(config.Peers) == 0 {
+		return SecondaryDNSIncoming{}, errors.New("at least one peer must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/secondary_dns/incoming", config)
+	if err != nil {
+		return SecondaryDNSIncoming{}, err
+	}
+
+	var r SecondaryDNSIncomingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSIncoming{}, fmt.Errorf("failed to unmarshal secondary dns incoming JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateIncoming replaces the incoming zone transfer configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone-update-secondary-zone-configuration
+func (s *SecondaryDNSService) UpdateIncoming(ctx context.Context, zoneID string, config SecondaryDNSIncoming) (SecondaryDNSIncoming, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SecondaryDNSIncoming{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(config.Peers) == 0 {
+		return SecondaryDNSIncoming{}, errors.New("at least one peer must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/secondary_dns/incoming", config)
+	if err != nil {
+		return SecondaryDNSIncoming{}, err
+	}
+
+	var r SecondaryDNSIncomingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSIncoming{}, fmt.Errorf("failed to unmarshal secondary dns incoming JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteIncoming removes the incoming zone transfer configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone-delete-secondary-zone-configuration
+func (s *SecondaryDNSService) DeleteIncoming(ctx context.Context, zoneID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/secondary_dns/incoming", nil)
+	return err
+}
+
+// GetOutgoing returns the outgoing zone transfer configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-primary-zone-configuration-details
+func (s *SecondaryDNSService) GetOutgoing(ctx context.Context, zoneID string) (SecondaryDNSOutgoing, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SecondaryDNSOutgoing{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/secondary_dns/outgoing", nil)
+	if err != nil {
+		return SecondaryDNSOutgoing{}, err
+	}
+
+	var r SecondaryDNSOutgoingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSOutgoing{}, fmt.Errorf("failed to unmarshal secondary dns outgoing JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateOutgoing configures a zone as a primary zone, transferred from
+// Cloudflare to its peers.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-create-primary-zone-configuration
+func (s *SecondaryDNSService) CreateOutgoing(ctx context.Context, zoneID string, config SecondaryDNSOutgoing) (SecondaryDNSOutgoing, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SecondaryDNSOutgoing{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(config.Peers) == 0 {
+		return SecondaryDNSOutgoing{}, errors.New("at least one peer must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/secondary_dns/outgoing", config)
+	if err != nil {
+		return SecondaryDNSOutgoing{}, err
+	}
+
+	var r SecondaryDNSOutgoingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSOutgoing{}, fmt.Errorf("failed to unmarshal secondary dns outgoing JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateOutgoing replaces the outgoing zone transfer configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-update-primary-zone-configuration
+func (s *SecondaryDNSService) UpdateOutgoing(ctx context.Context, zoneID string, config SecondaryDNSOutgoing) (SecondaryDNSOutgoing, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SecondaryDNSOutgoing{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(config.Peers) == 0 {
+		return SecondaryDNSOutgoing{}, errors.New("at least one peer must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/secondary_dns/outgoing", config)
+	if err != nil {
+		return SecondaryDNSOutgoing{}, err
+	}
+
+	var r SecondaryDNSOutgoingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SecondaryDNSOutgoing{}, fmt.Errorf("failed to unmarshal secondary dns outgoing JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteOutgoing removes the outgoing zone transfer configuration of a zone.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-delete-primary-zone-configuration
+func (s *SecondaryDNSService) DeleteOutgoing(ctx context.Context, zoneID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/secondary_dns/outgoing", nil)
+	return err
+}
+
+// ForceAXFR immediately transfers a secondary zone from its primary
+// nameservers. It returns a status message.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-secondary-zone-force-axfr
+func (s *SecondaryDNSService) ForceAXFR(ctx context.Context, zoneID string) (string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return "", fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/secondary_dns/force_axfr", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r SecondaryDNSStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal secondary dns status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// EnableOutgoing enables outgoing zone transfers of a zone. It returns a
+// status message.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-enable-outgoing-zone-transfers
+func (s *SecondaryDNSService) EnableOutgoing(ctx context.Context, zoneID string) (string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return "", fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/secondary_dns/outgoing/enable", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r SecondaryDNSStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal secondary dns status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DisableOutgoing disables outgoing zone transfers of a zone. It returns a
+// status message.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-disable-outgoing-zone-transfers
+func (s *SecondaryDNSService) DisableOutgoing(ctx context.Context, zoneID string) (string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return "", fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/secondary_dns/outgoing/disable", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r SecondaryDNSStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal secondary dns status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ForceNotify sends a NOTIFY to the peers of a primary zone so they
+// transfer it immediately.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-force-dns-notify
+func (s *SecondaryDNSService) ForceNotify(ctx context.Context, zoneID string) (string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return "", fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/secondary_dns/outgoing/force_notify", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r SecondaryDNSStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal secondary dns status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// OutgoingStatus returns whether outgoing zone transfers of a zone are
+// enabled.
+//
+// API reference: https://api.cloudflare.com/#secondary-dns-primary-zone-get-outgoing-zone-transfer-status
+func (s *SecondaryDNSService) OutgoingStatus(ctx context.Context, zoneID string) (string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return "", fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/secondary_dns/outgoing/status", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r SecondaryDNSStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal secondary dns status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// validateSecondaryDNSPeer checks the name and address of a peer. The
+// address is optional as peers used only for outgoing transfers are
+// identified by their ACL.
+func validateSecondaryDNSPeer(peer SecondaryDNSPeer) error {
+	if peer.Name == "" {
+		return errors.New("name must be provided")
+	}
+
+	if peer.IP != "" && net.ParseIP(peer.IP) == nil {
+		return fmt.Errorf("invalid peer ip: %q", peer.IP)
+	}
+
+	if peer.Port < 0 || peer.Port > 65535 {
+		return fmt.Errorf("invalid peer port: %d", peer.Port)
+	}
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"regexp"
+	"time"
+)
+
+type SnippetsService service
+
+// snippetNamePattern matches the names snippets can be given.
+var snippetNamePattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// Snippet is a JavaScript module run on requests of a zone that match a
+// snippet rule.
+type Snippet struct {
+	SnippetName string     `json:"snippet_name"`
+	CreatedOn   *time.Time `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time `json:"modified_on,omitempty"`
+}
+
+// SnippetFile is a module uploaded as part of a snippet.
+type SnippetFile struct {
+	Name    string
+	Content io.Reader
+}
+
+// SnippetUploadParams contains the code of a snippet. MainModule names the
+// file that is run and defaults to the first file.
+type SnippetUploadParams struct {
+	MainModule string
+	Files      []SnippetFile
+}
+
+// SnippetRule runs the snippet named SnippetName on requests matching
+// Expression.
+type SnippetRule struct {
+	SnippetName string `json:"snippet_name"`
+	Expression  string `json:"expression"`
+	Description string `json:"description,omitempty"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// snippetMetadata is the metadata part of a snippet upload.
+type snippetMetadata struct {
+	MainModule string `json:"main_module"`
+}
+
+// snippetRulesParams is the request body of the snippet rules endpoint.
+type snippetRulesParams struct {
+	Rules []SnippetRule `json:"rules"`
+}
+
+// SnippetResponse represents the response from the snippets endpoint
+// containing a single snippet.
+type SnippetResponse struct {
+	Response
+	Result Snippet `json:"result"`
+}
+
+// SnippetsResponse represents the response from the snippets endpoint
+// containing multiple snippets.
+type SnippetsResponse struct {
+	Response
+	Result []Snippet `json:"result"`
+}
+
+// SnippetRulesResponse represents the response from the snippet rules
+// endpoint.
+type SnippetRulesResponse struct {
+	Response
+	Result []SnippetRule `json:"result"`
+}
+
+// List returns the snippets of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-all-snippets
+func (s *SnippetsService) List(ctx context.Context, zoneID string) ([]Snippet, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []Snippet{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/snippets", nil)
+	if err != nil {
+		return []Snippet{}, err
+	}
+
+	var r SnippetsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Snippet{}, fmt.Errorf("failed to unmarshal snippet JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches the metadata of a single snippet.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-snippet
+func (s *SnippetsService) Get(ctx context.Context, zoneID, snippetName string) (Snippet, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Snippet{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if snippetName == "" {
+		return Snippet{}, fmt.Errorf(errMissingResourceID, "snippet")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/snippets/"+snippetName, nil)
+	if err != nil {
+		return Snippet{}, err
+	}
+
+	var r SnippetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Snippet{}, fmt.Errorf("failed to unmarshal snippet JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Upload creates a snippet or replaces its code. Snippet names may only
+// contain letters, numbers and underscores.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-put-snippet
+func (s *SnippetsService) Upload(ctx context.Context, zoneID, snippetName string, params SnippetUploadParams) (Snippet, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Snippet{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if !snippetNamePattern.MatchString(snippetName) {
+		return Snippet{}, fmt.Errorf("invalid snippet name: %q", snippetName)
+	}
+
+	if len(params.Files) == 0 {
+		return Snippet{}, errors.New("at least one file must be provided")
+	}
+
+	mainModule := params.MainModule
+	if mainModule == "" {
+		mainModule = params.Files[0].Name
+	}
+
+	metadata, err := json.Marshal(snippetMetadata{MainModule: mainModule})
+	if err != nil {
+		return Snippet{}, fmt.Errorf("failed to marshal snippet metadata: %w", err)
+	}
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	if err := w.WriteField("metadata", string(metadata)); err != nil {
+		return Snippet{}, err
+	}
+
+	found := false
+	for _, f := range params.Files {
+		if f.Name == "" || f.Content == nil {
+			return Snippet{}, errors.New("snippet files must have a name and content")
+		}
+		found = found || f.Name == mainModule
+
+		h := make(textproto.MIMEHeader)
+		h.Set("Content-Disposition", fmt.Sprintf(`form-data; name=%q; filename=%q`, f.Name, f.Name))
+		h.Set("Content-Type", "application/javascript+module")
+		part, err := w.CreatePart(h)
+		if err != nil {
+			return Snippet{}, err
+		}
+		if _, err := io.Copy(part, f.Content); err != nil {
+			return Snippet{}, fmt.Errorf("failed to read snippet file %s: %w", f.Name, err)
+		}
+	}
+
+	if !found {
+		return Snippet{}, fmt.Errorf("main module %s is not one of the files", mainModule)
+	}
+
+	if err := w.Close(); err != nil {
+		return Snippet{}, err
+	}
+
+	res, err := s.client.CallWithHeaders(ctx, http.MethodPut, "/zones/"+zoneID+"/snippets/"+snippetName, body, http.Header{"Content-Type": []string{w.FormDataContentType()}})
+	if err != nil {
+		return Snippet{}, err
+	}
+
+	var r SnippetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Snippet{}, fmt.Errorf("failed to unmarshal snippet JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Content returns the files of a snippet, keyed by name.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-snippet-content
+func (s *SnippetsService) Content(ctx context.Context, zoneID, snippetName string) (map[string][]byte, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return nil, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if snippetName == "" {
+		return nil, fmt.Errorf(errMissingResourceID, "snippet")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/snippets/"+snippetName+"/content", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The content is returned as a multipart form; its boundary is taken
+	// from the first delimiter line as the response headers are not
+	// available here.
+	line := res
+	if i := bytes.IndexByte(res, '\n'); i >= 0 {
+		line = res[:i]
+	}
+	boundary := string(bytes.TrimPrefix(bytes.TrimSpace(line), []byte("--")))
+	if boundary == "" {
+		return nil, errors.New("failed to read snippet content: missing multipart boundary")
+	}
+
+	files := make(map[string][]byte)
+	mr := multipart.NewReader(bytes.NewReader(res), boundary)
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snippet content: %w", err)
+		}
+
+		name := part.FileName()
+		if name == "" {
+			name = part.FormName()
+		}
+
+		content, err := ioutil.ReadAll(part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snippet content: %w", err)
+		}
+		files[name] = content
+	}
+
+	return files, nil
+}
+
+// Delete removes a snippet.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-delete-snippet
+func (s *SnippetsService) Delete(ctx context.Context, zoneID, snippetName string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if snippetName == "" {
+		return fmt.Errorf(errMissingResourceID, "snippet")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/snippets/"+snippetName, nil)
+	return err
+}
+
+// ListRules returns the rules selecting the requests each snippet runs on.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-rules
+func (s *SnippetsService) ListRules(ctx context.Context, zoneID string) ([]SnippetRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []SnippetRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/snippets"+"/snippet_rules", nil)
+	if err != nil {
+		return []SnippetRule{}, err
+	}
+
+	var r SnippetRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SnippetRule{}, fmt.Errorf("failed to unmarshal snippet rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateRules replaces the snippet rules of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-put-rules
+func (s *SnippetsService) UpdateRules(ctx context.Context, zoneID string, rules []SnippetRule) ([]SnippetRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []SnippetRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	for i, rule := range rules {
+		if rule.SnippetName == "" || rule.Expression == "" {
+			return []SnippetRule{}, fmt.Errorf("snippet rule %d: snippet name and expression must be provided", i)
+		}
+	}
+
+	if rules == nil {
+		rules = []SnippetRule{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/snippets"+"/snippet_rules", snippetRulesParams{Rules: rules})
+	if err != nil {
+		return []SnippetRule{}, err
+	}
+
+	var r SnippetRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SnippetRule{}, fmt.Errorf("failed to unmarshal snippet rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteRules removes every snippet rule of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-snippets-delete-rules
+func (s *SnippetsService) DeleteRules(ctx context.Context, zoneID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/snippets"+"/snippet_rules", nil)
+	return err
+}
@@ -0,0 +1,403 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SpectrumService service
+
+// SpectrumProxyProtocol controls whether and how the client IP is passed to
+// the origin.
+type SpectrumProxyProtocol string
+
+const (
+	SpectrumProxyProtocolOff    SpectrumProxyProtocol = "off"
+	SpectrumProxyProtocolV1     SpectrumProxyProtocol = "v1"
+	SpectrumProxyProtocolV2     SpectrumProxyProtocol = "v2"
+	SpectrumProxyProtocolSimple SpectrumProxyProtocol = "simple"
+)
+
+// SpectrumTLS is the TLS termination mode of an application.
+type SpectrumTLS string
+
+const (
+	SpectrumTLSOff      SpectrumTLS = "off"
+	SpectrumTLSFlexible SpectrumTLS = "flexible"
+	SpectrumTLSFull     SpectrumTLS = "full"
+	SpectrumTLSStrict   SpectrumTLS = "strict"
+)
+
+// SpectrumApplication is a TCP or UDP application proxied by Spectrum.
+//
+// Traffic is sent either to the addresses in OriginDirect (for example
+// "tcp://192.0.2.1:22") or to the hostname in OriginDNS on OriginPort. Only
+// one of the two should be set.
+type SpectrumApplication struct {
+	ID               string                         `json:"id,omitempty"`
+	CreatedOn        *time.Time                     `json:"created_on,omitempty"`
+	ModifiedOn       *time.Time                     `json:"modified_on,omitempty"`
+	Protocol         string                         `json:"protocol"`
+	DNS              SpectrumApplicationDNS         `json:"dns"`
+	OriginDirect     []string                       `json:"origin_direct,omitempty"`
+	OriginDNS        *SpectrumApplicationOriginDNS  `json:"origin_dns,omitempty"`
+	OriginPort       *SpectrumApplicationOriginPort `json:"origin_port,omitempty"`
+	IPFirewall       bool                           `json:"ip_firewall"`
+	ProxyProtocol    SpectrumProxyProtocol          `json:"proxy_protocol,omitempty"`
+	TLS              SpectrumTLS                    `json:"tls,omitempty"`
+	TrafficType      string                         `json:"traffic_type,omitempty"`
+	EdgeIPs          *SpectrumApplicationEdgeIPs    `json:"edge_ips,omitempty"`
+	ArgoSmartRouting bool                           `json:"argo_smart_routing,omitempty"`
+}
+
+// SpectrumApplicationDNS is the hostname clients connect to.
+type SpectrumApplicationDNS struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// SpectrumApplicationOriginDNS is a hostname resolved to find the origin.
+type SpectrumApplicationOriginDNS struct {
+	Name string `json:"name"`
+	TTL  int    `json:"ttl,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// SpectrumApplicationEdgeIPs selects the Cloudflare addresses an application
+// is reachable on.
+type SpectrumApplicationEdgeIPs struct {
+	Type         string   `json:"type"`
+	Connectivity string   `json:"connectivity,omitempty"`
+	IPs          []string `json:"ips,omitempty"`
+}
+
+// SpectrumApplicationOriginPort is either a single origin port or, when End
+// is set, a range of ports matching the range in Protocol.
+type SpectrumApplicationOriginPort struct {
+	Port       uint16
+	Start, End uint16
+}
+
+// MarshalJSON encodes a single port as a number and a range as "start-end".
+func (p SpectrumApplicationOriginPort) MarshalJSON() ([]byte, error) {
+	if p.End == 0 {
+		return json.Marshal(p.Port)
+	}
+	return json.Marshal(fmt.Sprintf("%d-%d", p.Start, p.End))
+}
+
+// UnmarshalJSON decodes either form of an origin port.
+func (p *SpectrumApplicationOriginPort) UnmarshalJSON(data []byte) error {
+	var port uint16
+	if err := json.Unmarshal(data, &port); err == nil {
+		*p = SpectrumApplicationOriginPort{Port: port}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	start, end, found := strings.Cut(s, "-")
+	if !found {
+		return fmt.Errorf("invalid spectrum origin port range: %q", s)
+	}
+	from, err := strconv.ParseUint(start, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid spectrum origin port range: %q", s)
+	}
+	to, err := strconv.ParseUint(end, 10, 16)
+	if err != nil {
+		return fmt.Errorf("invalid spectrum origin port range: %q", s)
+	}
+
+	*p = SpectrumApplicationOriginPort{Start: uint16(from), End: uint16(to)}
+	return nil
+}
+
+// SpectrumApplicationResponse represents the response from the Spectrum
+// applications endpoint containing a single application.
+type SpectrumApplicationResponse struct {
+	Response
+	Result SpectrumApplication `json:"result"`
+}
+
+// SpectrumApplicationsResponse represents the response from the Spectrum
+// applications endpoint containing multiple applications.
+type SpectrumApplicationsResponse struct {
+	Response
+	Result     []SpectrumApplication `json:"result"`
+	ResultInfo ResultInfo            `json:"result_info"`
+}
+
+// SpectrumApplicationListParams contains the options available when listing
+// Spectrum applications.
+type SpectrumApplicationListParams struct {
+	Order string `url:"order,omitempty"`
+
+	PaginationParams
+}
+
+// SpectrumAnalyticsCurrent is the live traffic of a Spectrum application.
+type SpectrumAnalyticsCurrent struct {
+	AppID        string  `json:"appID"`
+	BytesIngress int64   `json:"bytesIngress"`
+	BytesEgress  int64   `json:"bytesEgress"`
+	Connections  int64   `json:"connections"`
+	DurationAvg  float64 `json:"durationAvg"`
+}
+
+// SpectrumAnalyticsCurrentParams contains the filters available when
+// fetching live Spectrum traffic.
+type SpectrumAnalyticsCurrentParams struct {
+	AppIDs   []string `url:"appID,comma,omitempty"`
+	ColoName string   `url:"colo_name,omitempty"`
+}
+
+// SpectrumAnalyticsSummaryParams describes a Spectrum analytics summary
+// query. Metrics include count, bytesIngress, bytesEgress, durationAvg,
+// durationMedian, duration90th and duration99th; dimensions include event,
+// appID, coloName and ipVersion.
+type SpectrumAnalyticsSummaryParams struct {
+	Metrics    []string   `url:"metrics,comma,omitempty"`
+	Dimensions []string   `url:"dimensions,comma,omitempty"`
+	Since      *time.Time `url:"since,omitempty"`
+	Until      *time.Time `url:"until,omitempty"`
+	Filters    string     `url:"filters,omitempty"`
+	Sort       []string   `url:"sort,comma,omitempty"`
+}
+
+// SpectrumAnalyticsSummary is the result of a Spectrum analytics summary
+// query.
+type SpectrumAnalyticsSummary struct {
+	Rows    int                    `json:"rows"`
+	Data    []SpectrumAnalyticsRow `json:"data"`
+	DataLag float64                `json:"data_lag"`
+	Min     map[string]float64     `json:"min"`
+	Max     map[string]float64     `json:"max"`
+	Totals  map[string]float64     `json:"totals"`
+	Query   SpectrumAnalyticsQuery `json:"query"`
+}
+
+// SpectrumAnalyticsRow holds the metrics of one combination of dimension
+// values, in the order they were requested.
+type SpectrumAnalyticsRow struct {
+	Dimensions []string  `json:"dimensions"`
+	Metrics    []float64 `json:"metrics"`
+}
+
+// SpectrumAnalyticsQuery echoes the query that produced a summary.
+type SpectrumAnalyticsQuery struct {
+	Dimensions []string   `json:"dimensions"`
+	Metrics    []string   `json:"metrics"`
+	Since      *time.Time `json:"since"`
+	Until      *time.Time `json:"until"`
+	Filters    string     `json:"filters"`
+	Sort       []string   `json:"sort"`
+	Limit      int        `json:"limit"`
+}
+
+// SpectrumAnalyticsCurrentResponse represents the response from the current
+// Spectrum analytics endpoint.
+type SpectrumAnalyticsCurrentResponse struct {
+	Response
+	Result []SpectrumAnalyticsCurrent `json:"result"`
+}
+
+// SpectrumAnalyticsSummaryResponse represents the response from the
+// Spectrum analytics summary endpoint.
+type SpectrumAnalyticsSummaryResponse struct {
+	Response
+	Result SpectrumAnalyticsSummary `json:"result"`
+}
+
+// List returns the Spectrum applications of a zone.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-list-spectrum-applications
+func (s *SpectrumService) List(ctx context.Context, zoneID string, params SpectrumApplicationListParams) ([]SpectrumApplication, ResultInfo, error) {
+	var applications []SpectrumApplication
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []SpectrumApplication, pageInfo ResultInfo) bool {
+		applications = append(applications, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []SpectrumApplication{}, ResultInfo{}, err
+	}
+
+	return applications, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-list-spectrum-applications
+func (s *SpectrumService) ForEachPage(ctx context.Context, zoneID string, params SpectrumApplicationListParams, fn func(items []SpectrumApplication, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/spectrum/apps", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r SpectrumApplicationsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal spectrum application JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single Spectrum application.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-get-spectrum-application-configuration
+func (s *SpectrumService) Get(ctx context.Context, zoneID, applicationID string) (SpectrumApplication, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SpectrumApplication{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if applicationID == "" {
+		return SpectrumApplication{}, fmt.Errorf(errMissingResourceID, "spectrum application")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/spectrum/apps/"+applicationID, nil)
+	if err != nil {
+		return SpectrumApplication{}, err
+	}
+
+	var r SpectrumApplicationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SpectrumApplication{}, fmt.Errorf("failed to unmarshal spectrum application JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new Spectrum application.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-create-spectrum-application-using-a-name-for-the-origin
+func (s *SpectrumService) Create(ctx context.Context, zoneID string, app SpectrumApplication) (SpectrumApplication, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SpectrumApplication{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if app.OriginDirect != nil && app.OriginDNS != nil {
+		return SpectrumApplication{}, errors.New("only one of origin direct or origin DNS may be set")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/spectrum/apps", app)
+	if err != nil {
+		return SpectrumApplication{}, err
+	}
+
+	var r SpectrumApplicationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SpectrumApplication{}, fmt.Errorf("failed to unmarshal spectrum application JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces the configuration of an existing Spectrum application.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-update-spectrum-application-configuration-using-a-name-for-the-origin
+func (s *SpectrumService) Update(ctx context.Context, zoneID, applicationID string, app SpectrumApplication) (SpectrumApplication, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SpectrumApplication{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if applicationID == "" {
+		return SpectrumApplication{}, fmt.Errorf(errMissingResourceID, "spectrum application")
+	}
+
+	if app.OriginDirect != nil && app.OriginDNS != nil {
+		return SpectrumApplication{}, errors.New("only one of origin direct or origin DNS may be set")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/spectrum/apps/"+applicationID, app)
+	if err != nil {
+		return SpectrumApplication{}, err
+	}
+
+	var r SpectrumApplicationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SpectrumApplication{}, fmt.Errorf("failed to unmarshal spectrum application JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a Spectrum application.
+//
+// API reference: https://api.cloudflare.com/#spectrum-applications-delete-spectrum-application
+func (s *SpectrumService) Delete(ctx context.Context, zoneID, applicationID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if applicationID == "" {
+		return fmt.Errorf(errMissingResourceID, "spectrum application")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/spectrum/apps/"+applicationID, nil)
+	return err
+}
+
+// CurrentAnalytics returns the live traffic of the Spectrum applications in
+// a zone.
+//
+// API reference: https://api.cloudflare.com/#spectrum-aggregate-analytics-get-current-aggregated-analytics
+func (s *SpectrumService) CurrentAnalytics(ctx context.Context, zoneID string, params SpectrumAnalyticsCurrentParams) ([]SpectrumAnalyticsCurrent, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []SpectrumAnalyticsCurrent{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/spectrum/analytics/aggregate/current", params), nil)
+	if err != nil {
+		return []SpectrumAnalyticsCurrent{}, err
+	}
+
+	var r SpectrumAnalyticsCurrentResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SpectrumAnalyticsCurrent{}, fmt.Errorf("failed to unmarshal spectrum analytics JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// AnalyticsSummary returns Spectrum event metrics for a zone grouped by the
+// requested dimensions.
+//
+// API reference: https://api.cloudflare.com/#spectrum-analytics-summary-get-analytics-summary
+func (s *SpectrumService) AnalyticsSummary(ctx context.Context, zoneID string, params SpectrumAnalyticsSummaryParams) (SpectrumAnalyticsSummary, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return SpectrumAnalyticsSummary{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/spectrum/analytics/events/summary", params), nil)
+	if err != nil {
+		return SpectrumAnalyticsSummary{}, err
+	}
+
+	var r SpectrumAnalyticsSummaryResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return SpectrumAnalyticsSummary{}, fmt.Errorf("failed to unmarshal spectrum analytics JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,231 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type SSLService service
+
+// TotalTLS represents the Total TLS settings of a zone. When enabled,
+// certificates are issued by CertificateAuthority for every proxied hostname
+// not covered by Universal SSL. ValidityDays is the lifetime of the issued
+// certificates.
+type TotalTLS struct {
+	Enabled              *bool                               `json:"enabled,omitempty"`
+	CertificateAuthority CertificatePackCertificateAuthority `json:"certificate_authority,omitempty"`
+	ValidityDays         int                                 `json:"validity_days,omitempty"`
+}
+
+// TotalTLSResponse represents the response from the Total TLS endpoint.
+type TotalTLSResponse struct {
+	Response
+	Result TotalTLS `json:"result"`
+}
+
+// UniversalSSLSettings represents the Universal SSL settings of a zone.
+type UniversalSSLSettings struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// UniversalSSLSettingsResponse represents the response from the Universal
+// SSL settings endpoint.
+type UniversalSSLSettingsResponse struct {
+	Response
+	Result UniversalSSLSettings `json:"result"`
+}
+
+// SSLVerificationInfo describes how domain control is proven for a
+// certificate. TXT validation sets the record fields and HTTP validation the
+// HTTP fields.
+type SSLVerificationInfo struct {
+	RecordName   string `json:"record_name,omitempty"`
+	RecordTarget string `json:"record_target,omitempty"`
+	HTTPURL      string `json:"http_url,omitempty"`
+	HTTPBody     string `json:"http_body,omitempty"`
+}
+
+// SSLVerification is the verification state of a certificate pack of a
+// zone. CertificateStatus is "active" once the certificate is issued and
+// deployed.
+type SSLVerification struct {
+	CertificateStatus  string                          `json:"certificate_status"`
+	BrandCheck         bool                            `json:"brand_check"`
+	CertPackUUID       string                          `json:"cert_pack_uuid"`
+	Signature          string                          `json:"signature"`
+	ValidationMethod   CertificatePackValidationMethod `json:"validation_method"`
+	VerificationInfo   *SSLVerificationInfo            `json:"verification_info,omitempty"`
+	VerificationStatus bool                            `json:"verification_status"`
+	VerificationType   string                          `json:"verification_type"`
+}
+
+// SSLVerificationResponse represents the response from the SSL verification
+// endpoint.
+type SSLVerificationResponse struct {
+	Response
+	Result []SSLVerification `json:"result"`
+}
+
+// SSLVerificationParams contains the options for fetching the SSL
+// verification status. Retry immediately retries domain validation.
+type SSLVerificationParams struct {
+	Retry bool `url:"retry,omitempty"`
+}
+
+// SSLValidationMethodResponse represents the response from changing the
+// validation method of a certificate pack.
+type SSLValidationMethodResponse struct {
+	Response
+	Result struct {
+		Status           string                          `json:"status"`
+		ValidationMethod CertificatePackValidationMethod `json:"validation_method"`
+	} `json:"result"`
+}
+
+// GetTotalTLS returns the Total TLS settings of a zone.
+//
+// API reference: https://api.cloudflare.com/#total-tls-total-tls-settings-details
+func (s *SSLService) GetTotalTLS(ctx context.Context, zoneID string) (TotalTLS, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return TotalTLS{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/acm/total_tls", nil)
+	if err != nil {
+		return TotalTLS{}, err
+	}
+
+	var r TotalTLSResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TotalTLS{}, fmt.Errorf("failed to unmarshal total tls JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateTotalTLS changes the Total TLS settings of a zone. Enabled must be
+// set.
+//
+// API reference: https://api.cloudflare.com/#total-tls-enable-or-disable-total-tls
+func (s *SSLService) UpdateTotalTLS(ctx context.Context, zoneID string, settings TotalTLS) (TotalTLS, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return TotalTLS{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if settings.Enabled == nil {
+		return TotalTLS{}, errors.New("enabled must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/acm/total_tls", settings)
+	if err != nil {
+		return TotalTLS{}, err
+	}
+
+	var r TotalTLSResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TotalTLS{}, fmt.Errorf("failed to unmarshal total tls JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetUniversalSSLSettings returns the Universal SSL settings of a zone.
+//
+// API reference: https://api.cloudflare.com/#universal-ssl-settings-for-a-zone-universal-ssl-settings-details
+func (s *SSLService) GetUniversalSSLSettings(ctx context.Context, zoneID string) (UniversalSSLSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return UniversalSSLSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/ssl/universal/settings", nil)
+	if err != nil {
+		return UniversalSSLSettings{}, err
+	}
+
+	var r UniversalSSLSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UniversalSSLSettings{}, fmt.Errorf("failed to unmarshal universal ssl settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateUniversalSSLSettings changes the Universal SSL settings of a zone.
+// Disabling Universal SSL removes the certificates it issued.
+//
+// API reference: https://api.cloudflare.com/#universal-ssl-settings-for-a-zone-edit-universal-ssl-settings
+func (s *SSLService) UpdateUniversalSSLSettings(ctx context.Context, zoneID string, settings UniversalSSLSettings) (UniversalSSLSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return UniversalSSLSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/ssl/universal/settings", settings)
+	if err != nil {
+		return UniversalSSLSettings{}, err
+	}
+
+	var r UniversalSSLSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UniversalSSLSettings{}, fmt.Errorf("failed to unmarshal universal ssl settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Verification returns the verification status of the certificate packs of
+// a zone, including the records needed to complete validation.
+//
+// API reference: https://api.cloudflare.com/#ssl-verification-ssl-verification-details
+func (s *SSLService) Verification(ctx context.Context, zoneID string, params SSLVerificationParams) ([]SSLVerification, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []SSLVerification{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/zones/"+zoneID+"/ssl/verification", params), nil)
+	if err != nil {
+		return []SSLVerification{}, err
+	}
+
+	var r SSLVerificationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []SSLVerification{}, fmt.Errorf("failed to unmarshal ssl verification JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateValidationMethod changes how domain control is validated for a
+// certificate pack still pending validation. It returns the new validation
+// status.
+//
+// API reference: https://api.cloudflare.com/#ssl-verification-edit-ssl-certificate-pack-validation-method
+func (s *SSLService) UpdateValidationMethod(ctx context.Context, zoneID, certificatePackID string, method CertificatePackValidationMethod) (string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return "", fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if certificatePackID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "certificate pack")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/ssl/verification/"+certificatePackID, map[string]CertificatePackValidationMethod{"validation_method": method})
+	if err != nil {
+		return "", err
+	}
+
+	var r SSLValidationMethodResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal ssl validation method JSON data: %w", err)
+	}
+
+	return r.Result.Status, nil
+}
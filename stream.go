@@ -0,0 +1,121 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// dialWebSocket opens a WebSocket connection to url, negotiating one of the
+// provided subprotocols. The proxy configuration of the client's HTTP
+// transport is reused when available.
+func (c *Client) dialWebSocket(ctx context.Context, url string, subprotocols []string) (*websocket.Conn, error) {
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 30 * time.Second,
+		Subprotocols:     subprotocols,
+	}
+
+	if t, ok := c.Client().Transport.(*http.Transport); ok {
+		dialer.Proxy = t.Proxy
+		dialer.TLSClientConfig = t.TLSClientConfig
+	}
+
+	headers := make(http.Header)
+	if c.UserAgent != "" {
+		headers.Set("User-Agent", c.UserAgent)
+	}
+
+	conn, resp, err := dialer.DialContext(ctx, url, headers)
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("websocket handshake failed with HTTP status %d: %w", resp.StatusCode, err)
+		}
+		return nil, fmt.Errorf("websocket connection failed: %w", err)
+	}
+
+	return conn, nil
+}
+
+// streamWebSocket reads messages from the connection returned by connect and
+// passes each one to handle until ctx is cancelled. Dropped connections are
+// re-established by calling connect again, backing off according to the
+// client's RetryPolicy; the retry count is reset whenever a connection is
+// successfully established. An error is only returned once the retries are
+// exhausted or handle fails.
+func (c *Client) streamWebSocket(ctx context.Context, connect func(ctx context.Context) (*websocket.Conn, error), handle func(msg []byte) error) error {
+	attempt := 0
+	for {
+		conn, err := connect(ctx)
+		if err == nil {
+			attempt = 0
+			err = c.readWebSocket(ctx, conn, handle)
+			if _, ok := err.(webSocketHandlerError); ok {
+				return err
+			}
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if attempt >= c.RetryPolicy.MaxRetries {
+			return fmt.Errorf("websocket stream failed after %d retries: %w", attempt, err)
+		}
+		attempt++
+
+		sleepDuration := time.Duration(math.Pow(2, float64(attempt-1)) * float64(c.RetryPolicy.MinRetryDelay))
+		if sleepDuration > c.RetryPolicy.MaxRetryDelay {
+			sleepDuration = c.RetryPolicy.MaxRetryDelay
+		}
+		c.Logger.Printf("websocket stream interrupted (%s), reconnecting in %s", err, sleepDuration)
+
+		select {
+		case <-time.After(sleepDuration):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// webSocketHandlerError wraps errors returned by message handlers so they
+// can be told apart from connection errors, which are retried.
+type webSocketHandlerError struct {
+	error
+}
+
+func (e webSocketHandlerError) Unwrap() error {
+	return e.error
+}
+
+// readWebSocket reads messages until the connection fails or ctx is
+// cancelled. The connection is always closed before returning.
+func (c *Client) readWebSocket(ctx context.Context, conn *websocket.Conn, handle func(msg []byte) error) error {
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+			conn.Close()
+		case <-done:
+			conn.Close()
+		}
+	}()
+
+	for {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		if err := handle(msg); err != nil {
+			return webSocketHandlerError{err}
+		}
+	}
+}
@@ -0,0 +1,313 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// StreamSigningKey is a key used to sign playback tokens locally. PEM and
+// JWK hold the base64 encoded private key and are only returned when the key
+// is created.
+type StreamSigningKey struct {
+	ID      string     `json:"id"`
+	PEM     string     `json:"pem,omitempty"`
+	JWK     string     `json:"jwk,omitempty"`
+	Created *time.Time `json:"created,omitempty"`
+}
+
+// StreamAccessRule allows or blocks playback of a signed token. Rules are
+// evaluated in order and the first match wins.
+//
+// Type is "ip.src" to match IP ranges, "ip.geoip.country" to match ISO 3166
+// country codes, or "any" to match every viewer. Action is "allow" or
+// "block".
+type StreamAccessRule struct {
+	Type    string   `json:"type"`
+	Action  string   `json:"action"`
+	Country []string `json:"country,omitempty"`
+	IP      []string `json:"ip,omitempty"`
+}
+
+// StreamSignedTokenParams contains the restrictions encoded into a signed
+// playback token. Expiry defaults to one hour from now.
+type StreamSignedTokenParams struct {
+	Expiry       *time.Time         `json:"-"`
+	NotBefore    *time.Time         `json:"-"`
+	Downloadable bool               `json:"downloadable,omitempty"`
+	AccessRules  []StreamAccessRule `json:"accessRules,omitempty"`
+}
+
+// StreamVideoUpdateParams contains the playback restrictions that can be
+// changed on a video. Unset fields are left unchanged.
+type StreamVideoUpdateParams struct {
+	RequireSignedURLs     *bool                  `json:"requireSignedURLs,omitempty"`
+	AllowedOrigins        *[]string              `json:"allowedOrigins,omitempty"`
+	ThumbnailTimestampPct *float64               `json:"thumbnailTimestampPct,omitempty"`
+	Meta                  map[string]interface{} `json:"meta,omitempty"`
+	Creator               string                 `json:"creator,omitempty"`
+	ScheduledDeletion     *time.Time             `json:"scheduledDeletion,omitempty"`
+}
+
+// StreamSigningKeyResponse represents the response from the signing keys
+// endpoint containing a single key.
+type StreamSigningKeyResponse struct {
+	Response
+	Result StreamSigningKey `json:"result"`
+}
+
+// StreamSigningKeysResponse represents the response from the signing keys
+// endpoint containing multiple keys.
+type StreamSigningKeysResponse struct {
+	Response
+	Result []StreamSigningKey `json:"result"`
+}
+
+// StreamSignedTokenResponse represents the response from the token
+// endpoint.
+type StreamSignedTokenResponse struct {
+	Response
+	Result struct {
+		Token string `json:"token"`
+	} `json:"result"`
+}
+
+// streamTokenRequest is the request body of the token endpoint.
+type streamTokenRequest struct {
+	StreamSignedTokenParams
+	Exp int64 `json:"exp,omitempty"`
+	NBF int64 `json:"nbf,omitempty"`
+}
+
+// streamTokenClaims is the payload of a locally signed playback token.
+type streamTokenClaims struct {
+	Sub string `json:"sub"`
+	Kid string `json:"kid"`
+	Exp int64  `json:"exp"`
+	NBF int64  `json:"nbf,omitempty"`
+	StreamSignedTokenParams
+}
+
+// UpdateVideo changes the playback restrictions and metadata of a video.
+// Videos requiring signed URLs can only be played with a token from
+// CreateSignedToken or SignStreamToken.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-update-video-details
+func (s *StreamService) UpdateVideo(ctx context.Context, accountID, videoID string, params StreamVideoUpdateParams) (StreamVideo, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return StreamVideo{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if videoID == "" {
+		return StreamVideo{}, fmt.Errorf(errMissingResourceID, "video")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/stream/"+videoID, params)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+
+	var r StreamVideoResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamVideo{}, fmt.Errorf("failed to unmarshal stream video JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateSigningKey creates a key for signing playback tokens locally with
+// SignStreamToken. The private key is only returned by this call and must be
+// stored by the caller.
+//
+// API reference: https://api.cloudflare.com/#stream-signing-keys-create-signing-keys
+func (s *StreamService) CreateSigningKey(ctx context.Context, accountID string) (StreamSigningKey, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return StreamSigningKey{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/stream/keys", nil)
+	if err != nil {
+		return StreamSigningKey{}, err
+	}
+
+	var r StreamSigningKeyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamSigningKey{}, fmt.Errorf("failed to unmarshal stream signing key JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListSigningKeys returns the signing keys of an account, without their
+// private keys.
+//
+// API reference: https://api.cloudflare.com/#stream-signing-keys-list-signing-keys
+func (s *StreamService) ListSigningKeys(ctx context.Context, accountID string) ([]StreamSigningKey, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []StreamSigningKey{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/stream/keys", nil)
+	if err != nil {
+		return []StreamSigningKey{}, err
+	}
+
+	var r StreamSigningKeysResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []StreamSigningKey{}, fmt.Errorf("failed to unmarshal stream signing key JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteSigningKey revokes a signing key. Tokens signed with it stop
+// working immediately.
+//
+// API reference: https://api.cloudflare.com/#stream-signing-keys-delete-signing-keys
+func (s *StreamService) DeleteSigningKey(ctx context.Context, accountID, keyID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if keyID == "" {
+		return fmt.Errorf(errMissingResourceID, "signing key")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/stream/keys/"+keyID, nil)
+	return err
+}
+
+// CreateSignedToken asks Cloudflare to sign a playback token for a video.
+// Use SignStreamToken to mint tokens without an API request.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-create-signed-url-tokens-for-videos
+func (s *StreamService) CreateSignedToken(ctx context.Context, accountID, videoID string, params StreamSignedTokenParams) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if videoID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "video")
+	}
+
+	body := streamTokenRequest{StreamSignedTokenParams: params}
+	if params.Expiry != nil {
+		body.Exp = params.Expiry.Unix()
+	}
+	if params.NotBefore != nil {
+		body.NBF = params.NotBefore.Unix()
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/stream/"+videoID+"/token", body)
+	if err != nil {
+		return "", err
+	}
+
+	var r StreamSignedTokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal stream token JSON data: %w", err)
+	}
+
+	return r.Result.Token, nil
+}
+
+// SignStreamToken mints a signed playback token for a video using a key
+// from CreateSigningKey, without making an API request. The token is used in
+// place of the video ID in playback URLs.
+func SignStreamToken(key StreamSigningKey, videoID string, params StreamSignedTokenParams) (string, error) {
+	if key.ID == "" || key.PEM == "" {
+		return "", errors.New("signing key ID and PEM must be provided")
+	}
+
+	if videoID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "video")
+	}
+
+	privateKey, err := parseStreamSigningKey(key.PEM)
+	if err != nil {
+		return "", err
+	}
+
+	claims := streamTokenClaims{
+		Sub:                     videoID,
+		Kid:                     key.ID,
+		Exp:                     time.Now().Add(time.Hour).Unix(),
+		StreamSignedTokenParams: params,
+	}
+	if params.Expiry != nil {
+		claims.Exp = params.Expiry.Unix()
+	}
+	if params.NotBefore != nil {
+		claims.NBF = params.NotBefore.Unix()
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": key.ID})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal stream token claims: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign stream token: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parseStreamSigningKey decodes the private key of a signing key. The API
+// returns the PEM block base64 encoded, but a plain PEM block is accepted
+// too.
+func parseStreamSigningKey(key string) (*rsa.PrivateKey, error) {
+	data := []byte(key)
+	if !strings.Contains(key, "-----BEGIN") {
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signing key: %w", err)
+		}
+		data = decoded
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("signing key is not a PEM block")
+	}
+
+	if privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return privateKey, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signing key: %w", err)
+	}
+
+	privateKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not an RSA key")
+	}
+
+	return privateKey, nil
+}
@@ -0,0 +1,172 @@
+package cloudflare
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testStreamSigningKey returns a new RSA key and the signing key the API
+// would return for it, with the PEM block base64 encoded.
+func testStreamSigningKey(t *testing.T) (*rsa.PrivateKey, StreamSigningKey) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %s", err)
+	}
+
+	block := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	return privateKey, StreamSigningKey{
+		ID:  "8f926b2b8f8f4b3e9f5e2b4b8e9f5e2b",
+		PEM: base64.StdEncoding.EncodeToString(block),
+	}
+}
+
+// decodeStreamToken verifies the signature of token with key and returns its
+// header and claims.
+func decodeStreamToken(t *testing.T, token string, key *rsa.PublicKey) (map[string]string, map[string]interface{}) {
+	t.Helper()
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		t.Fatalf("got %d token parts, want 3", len(parts))
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("failed to decode signature: %s", err)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+		t.Fatalf("invalid signature: %s", err)
+	}
+
+	var header map[string]string
+	var claims map[string]interface{}
+	for i, v := range []interface{}{&header, &claims} {
+		data, err := base64.RawURLEncoding.DecodeString(parts[i])
+		if err != nil {
+			t.Fatalf("failed to decode token part %d: %s", i, err)
+		}
+		if err := json.Unmarshal(data, v); err != nil {
+			t.Fatalf("failed to unmarshal token part %d: %s", i, err)
+		}
+	}
+
+	return header, claims
+}
+
+func TestSignStreamToken(t *testing.T) {
+	privateKey, key := testStreamSigningKey(t)
+
+	expiry := time.Unix(1700003600, 0)
+	notBefore := time.Unix(1700000000, 0)
+	token, err := SignStreamToken(key, "ea95132c15732412d22c1476fa83f27a", StreamSignedTokenParams{
+		Expiry:       &expiry,
+		NotBefore:    &notBefore,
+		Downloadable: true,
+		AccessRules: []StreamAccessRule{
+			{Type: "ip.geoip.country", Action: "allow", Country: []string{"GB"}},
+			{Type: "any", Action: "block"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	header, claims := decodeStreamToken(t, token, &privateKey.PublicKey)
+
+	if want := map[string]string{"alg": "RS256", "kid": key.ID}; !reflect.DeepEqual(header, want) {
+		t.Errorf("got header %v, want %v", header, want)
+	}
+
+	want := map[string]interface{}{
+		"sub":          "ea95132c15732412d22c1476fa83f27a",
+		"kid":          key.ID,
+		"exp":          float64(1700003600),
+		"nbf":          float64(1700000000),
+		"downloadable": true,
+		"accessRules": []interface{}{
+			map[string]interface{}{"type": "ip.geoip.country", "action": "allow", "country": []interface{}{"GB"}},
+			map[string]interface{}{"type": "any", "action": "block"},
+		},
+	}
+	if !reflect.DeepEqual(claims, want) {
+		t.Errorf("got claims %v, want %v", claims, want)
+	}
+}
+
+func TestSignStreamToken_DefaultExpiry(t *testing.T) {
+	privateKey, key := testStreamSigningKey(t)
+
+	token, err := SignStreamToken(key, "ea95132c15732412d22c1476fa83f27a", StreamSignedTokenParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, claims := decodeStreamToken(t, token, &privateKey.PublicKey)
+
+	exp := time.Unix(int64(claims["exp"].(float64)), 0)
+	if d := time.Until(exp); d < 59*time.Minute || d > time.Hour {
+		t.Errorf("got expiry in %s, want an hour", d)
+	}
+
+	for _, claim := range []string{"nbf", "downloadable", "accessRules"} {
+		if _, ok := claims[claim]; ok {
+			t.Errorf("unexpected claim %s", claim)
+		}
+	}
+}
+
+func TestSignStreamToken_PKCS8Key(t *testing.T) {
+	privateKey, key := testStreamSigningKey(t)
+
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %s", err)
+	}
+	key.PEM = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	token, err := SignStreamToken(key, "ea95132c15732412d22c1476fa83f27a", StreamSignedTokenParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	decodeStreamToken(t, token, &privateKey.PublicKey)
+}
+
+func TestSignStreamToken_Errors(t *testing.T) {
+	_, key := testStreamSigningKey(t)
+
+	tests := map[string]struct {
+		key     StreamSigningKey
+		videoID string
+		err     string
+	}{
+		"missing key ID":  {StreamSigningKey{PEM: key.PEM}, "abc", "signing key ID and PEM must be provided"},
+		"missing PEM":     {StreamSigningKey{ID: key.ID}, "abc", "signing key ID and PEM must be provided"},
+		"missing video":   {key, "", "video ID is empty"},
+		"invalid base64":  {StreamSigningKey{ID: key.ID, PEM: "not base64!"}, "abc", "failed to decode signing key"},
+		"not a PEM block": {StreamSigningKey{ID: key.ID, PEM: base64.StdEncoding.EncodeToString([]byte("key"))}, "abc", "signing key is not a PEM block"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := SignStreamToken(tc.key, tc.videoID, StreamSignedTokenParams{})
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("got error %v, want %q", err, tc.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type StreamService service
+
+// StreamVideo is a video stored in Cloudflare Stream.
+type StreamVideo struct {
+	UID                   string                 `json:"uid"`
+	Creator               string                 `json:"creator,omitempty"`
+	Thumbnail             string                 `json:"thumbnail"`
+	ThumbnailTimestampPct float64                `json:"thumbnailTimestampPct"`
+	ReadyToStream         bool                   `json:"readyToStream"`
+	Status                StreamVideoStatus      `json:"status"`
+	Meta                  map[string]interface{} `json:"meta"`
+	Created               *time.Time             `json:"created,omitempty"`
+	Modified              *time.Time             `json:"modified,omitempty"`
+	Uploaded              *time.Time             `json:"uploaded,omitempty"`
+	UploadExpiry          *time.Time             `json:"uploadExpiry,omitempty"`
+	ScheduledDeletion     *time.Time             `json:"scheduledDeletion,omitempty"`
+	Size                  int64                  `json:"size"`
+	Preview               string                 `json:"preview"`
+	AllowedOrigins        []string               `json:"allowedOrigins"`
+	RequireSignedURLs     bool                   `json:"requireSignedURLs"`
+	MaxSizeBytes          int64                  `json:"maxSizeBytes,omitempty"`
+	MaxDurationSeconds    int                    `json:"maxDurationSeconds,omitempty"`
+	Duration              float64                `json:"duration"`
+	Input                 StreamVideoInput       `json:"input"`
+	Playback              StreamVideoPlayback    `json:"playback"`
+}
+
+// StreamVideoStatus reports the processing state of a video. State is one
+// of "pendingupload", "downloading", "queued", "inprogress", "ready" or
+// "error".
+type StreamVideoStatus struct {
+	State           string `json:"state"`
+	PctComplete     string `json:"pctComplete,omitempty"`
+	ErrorReasonCode string `json:"errorReasonCode,omitempty"`
+	ErrorReasonText string `json:"errorReasonText,omitempty"`
+}
+
+// StreamVideoInput holds the dimensions of the uploaded video.
+type StreamVideoInput struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// StreamVideoPlayback holds the manifest URLs a video is played from.
+type StreamVideoPlayback struct {
+	HLS  string `json:"hls"`
+	Dash string `json:"dash"`
+}
+
+// StreamListParams contains the filters available when listing videos.
+// Status filters by processing state and Type by "vod" or "live".
+type StreamListParams struct {
+	Search  string     `url:"search,omitempty"`
+	Status  string     `url:"status,omitempty"`
+	Creator string     `url:"creator,omitempty"`
+	Type    string     `url:"type,omitempty"`
+	Before  *time.Time `url:"before,omitempty"`
+	After   *time.Time `url:"after,omitempty"`
+	Asc     bool       `url:"asc,omitempty"`
+
+	CursorPaginationParams
+}
+
+// StreamCopyParams contains the options used when copying a video from a
+// URL.
+type StreamCopyParams struct {
+	URL                   string                 `json:"url"`
+	Creator               string                 `json:"creator,omitempty"`
+	Meta                  map[string]interface{} `json:"meta,omitempty"`
+	ThumbnailTimestampPct float64                `json:"thumbnailTimestampPct,omitempty"`
+	AllowedOrigins        []string               `json:"allowedOrigins,omitempty"`
+	RequireSignedURLs     bool                   `json:"requireSignedURLs,omitempty"`
+	ScheduledDeletion     *time.Time             `json:"scheduledDeletion,omitempty"`
+}
+
+// StreamUploadParams contains the options used when uploading a video.
+//
+// Size is the length of the video in bytes and must be provided. ChunkSize
+// is the number of bytes sent per request; it must be at least 5 MiB and a
+// multiple of 256 KiB, and defaults to 50 MiB. Each chunk is held in memory
+// while it is sent.
+type StreamUploadParams struct {
+	Size                  int64
+	ChunkSize             int
+	Name                  string
+	Creator               string
+	ThumbnailTimestampPct float64
+	AllowedOrigins        []string
+	RequireSignedURLs     bool
+	MaxDurationSeconds    int
+	ScheduledDeletion     *time.Time
+}
+
+// StreamUpload is a resumable upload. The data is sent to URL, which stays
+// valid until the upload completes or expires, and VideoID identifies the
+// video being created.
+type StreamUpload struct {
+	URL     string
+	VideoID string
+	Size    int64
+}
+
+// StreamVideoResponse represents the response from the Stream endpoint
+// containing a single video.
+type StreamVideoResponse struct {
+	Response
+	Result StreamVideo `json:"result"`
+}
+
+// StreamVideosResponse represents the response from the Stream endpoint
+// containing multiple videos.
+type StreamVideosResponse struct {
+	Response
+	Result []StreamVideo `json:"result"`
+}
+
+// ListVideos returns the videos of an account that match the provided
+// `StreamListParams`, newest first unless Asc is set. Every video is fetched
+// unless params.Cursor is set. Videos are paged by creation time, so
+// ResultInfo.Cursor holds the creation time of the last video returned, which
+// the next page continues from.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-list-videos
+func (s *StreamService) ListVideos(ctx context.Context, accountID string, params StreamListParams) ([]StreamVideo, ResultInfo, error) {
+	var videos []StreamVideo
+	var info ResultInfo
+	err := s.ForEachVideosPage(ctx, accountID, params, func(page []StreamVideo, pageInfo ResultInfo) bool {
+		videos = append(videos, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []StreamVideo{}, ResultInfo{}, err
+	}
+
+	return videos, info, nil
+}
+
+// ForEachVideosPage is like ListVideos but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-list-videos
+func (s *StreamService) ForEachVideosPage(ctx context.Context, accountID string, params StreamListParams, fn func(items []StreamVideo, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	// The next page holds the videos created before, or after when listing
+	// in ascending order, the last one returned.
+	cursorParam := "before"
+	if params.Asc {
+		cursorParam = "after"
+	}
+
+	return s.client.listCursorPages(ctx, "/accounts/"+accountID+"/stream", cursorParam, &params, &params.CursorPaginationParams, func(res []byte) (ResultInfo, error) {
+		var r StreamVideosResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal stream video JSON data: %w", err)
+		}
+		var info ResultInfo
+		if n := len(r.Result); n > 0 && r.Result[n-1].Created != nil {
+			info.Cursor = r.Result[n-1].Created.Format(time.RFC3339Nano)
+		}
+		if !fn(r.Result, info) {
+			return ResultInfo{}, errStopPagination
+		}
+		return info, nil
+	})
+}
+
+// GetVideo fetches a single video.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-retrieve-video-details
+func (s *StreamService) GetVideo(ctx context.Context, accountID, videoID string) (StreamVideo, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return StreamVideo{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if videoID == "" {
+		return StreamVideo{}, fmt.Errorf(errMissingResourceID, "video")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/stream/"+videoID, nil)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+
+	var r StreamVideoResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamVideo{}, fmt.Errorf("failed to unmarshal stream video JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CopyFromURL creates a video by downloading it from a URL. The video is
+// processed asynchronously; its Status reports when it is ready.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-upload-videos-from-a-url
+func (s *StreamService) CopyFromURL(ctx context.Context, accountID string, params StreamCopyParams) (StreamVideo, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return StreamVideo{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.URL == "" {
+		return StreamVideo{}, errors.New("url must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/stream/copy", params)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+
+	var r StreamVideoResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamVideo{}, fmt.Errorf("failed to unmarshal stream video JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteVideo removes a video and its playback data.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-delete-video
+func (s *StreamService) DeleteVideo(ctx context.Context, accountID, videoID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if videoID == "" {
+		return fmt.Errorf(errMissingResourceID, "video")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/stream/"+videoID, nil)
+	return err
+}
+
+// Upload uploads a video from r using the tus resumable upload protocol and
+// returns it once every byte has been sent. Failed chunks are retried
+// following the client's RetryPolicy. To resume uploads across process
+// restarts use CreateUpload and ContinueUpload instead.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-initiate-video-uploads-using-tus
+func (s *StreamService) Upload(ctx context.Context, accountID string, r io.Reader, params StreamUploadParams) (StreamVideo, error) {
+	upload, err := s.CreateUpload(ctx, accountID, params)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+
+	err = s.ContinueUpload(ctx, upload, r, params.ChunkSize)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+
+	return s.GetVideo(ctx, accountID, upload.VideoID)
+}
+
+// CreateUpload starts a resumable upload without sending any data. The
+// returned StreamUpload can be stored and passed to ContinueUpload, by this or
+// another process, until the upload completes.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-initiate-video-uploads-using-tus
+func (s *StreamService) CreateUpload(ctx context.Context, accountID string, params StreamUploadParams) (StreamUpload, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return StreamUpload{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Size <= 0 {
+		return StreamUpload{}, errors.New("upload size must be provided")
+	}
+
+	metadata := map[string]string{}
+	if params.Name != "" {
+		metadata["name"] = params.Name
+	}
+	if params.ThumbnailTimestampPct > 0 {
+		metadata["thumbnailtimestamppct"] = strconv.FormatFloat(params.ThumbnailTimestampPct, 'f', -1, 64)
+	}
+	if len(params.AllowedOrigins) > 0 {
+		metadata["allowedorigins"] = strings.Join(params.AllowedOrigins, ",")
+	}
+	if params.RequireSignedURLs {
+		metadata["requiresignedurls"] = ""
+	}
+	if params.MaxDurationSeconds > 0 {
+		metadata["maxdurationseconds"] = strconv.Itoa(params.MaxDurationSeconds)
+	}
+	if params.ScheduledDeletion != nil {
+		metadata["scheduleddeletion"] = params.ScheduledDeletion.UTC().Format(time.RFC3339)
+	}
+
+	headers := make(http.Header)
+	if params.Creator != "" {
+		headers.Set("Upload-Creator", params.Creator)
+	}
+
+	location, resp, err := s.client.tusCreate(ctx, "/accounts/"+accountID+"/stream", params.Size, metadata, headers)
+	if err != nil {
+		return StreamUpload{}, err
+	}
+
+	return StreamUpload{
+		URL:     location,
+		VideoID: resp.Header.Get("Stream-Media-Id"),
+		Size:    params.Size,
+	}, nil
+}
+
+// ContinueUpload sends the rest of an upload created with CreateUpload. r
+// must read the video from its first byte; the part the server already has
+// is skipped, by seeking if r is an io.Seeker. chunkSize may be zero to use
+// the default.
+func (s *StreamService) ContinueUpload(ctx context.Context, upload StreamUpload, r io.Reader, chunkSize int) error {
+	if upload.URL == "" {
+		return errors.New("upload url must be provided")
+	}
+
+	return s.client.tusUpload(ctx, upload.URL, r, upload.Size, chunkSize)
+}
@@ -0,0 +1,183 @@
+package cloudflare
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TransformURLRewrite describes how a URL rewrite rule changes the path and
+// query string of a request. Each part is either a static value or an
+// expression evaluated per request, such as
+// `regex_replace(http.request.uri.path, "^/old/", "/new/")`. Parts left
+// empty are not changed; set Query to a pointer to "" to remove the query
+// string.
+type TransformURLRewrite struct {
+	Path            string
+	PathExpression  string
+	Query           *string
+	QueryExpression string
+}
+
+// TransformHeader is a single header modification of a header transform
+// rule. Use TransformSetHeader, TransformSetHeaderExpression,
+// TransformAddHeader and TransformRemoveHeader to build one.
+type TransformHeader struct {
+	Name       string
+	Operation  string
+	Value      string
+	Expression string
+}
+
+// TransformSetHeader sets header name to a static value, replacing any
+// existing values.
+func TransformSetHeader(name, value string) TransformHeader {
+	return TransformHeader{Name: name, Operation: "set", Value: value}
+}
+
+// TransformSetHeaderExpression sets header name to the result of an
+// expression evaluated per request, such as `ip.src`.
+func TransformSetHeaderExpression(name, expression string) TransformHeader {
+	return TransformHeader{Name: name, Operation: "set", Expression: expression}
+}
+
+// TransformAddHeader adds a value to header name, keeping existing values.
+// It is only available in response header transform rules.
+func TransformAddHeader(name, value string) TransformHeader {
+	return TransformHeader{Name: name, Operation: "add", Value: value}
+}
+
+// TransformRemoveHeader removes header name.
+func TransformRemoveHeader(name string) TransformHeader {
+	return TransformHeader{Name: name, Operation: "remove"}
+}
+
+// TransformURLRewriteRule returns a rule of the http_request_transform phase
+// rewriting the URL of requests matching expression.
+func TransformURLRewriteRule(expression, description string, rewrite TransformURLRewrite) (RulesetRule, error) {
+	if expression == "" {
+		return RulesetRule{}, errors.New("expression must be provided")
+	}
+
+	uri := &RulesetRuleActionParametersURI{}
+
+	switch {
+	case rewrite.Path != "" && rewrite.PathExpression != "":
+		return RulesetRule{}, errors.New("path and path expression are mutually exclusive")
+	case rewrite.Path != "":
+		if !strings.HasPrefix(rewrite.Path, "/") {
+			return RulesetRule{}, fmt.Errorf("path must start with a slash: %q", rewrite.Path)
+		}
+		uri.Path = &RulesetRuleActionParametersURIPath{Value: rewrite.Path}
+	case rewrite.PathExpression != "":
+		uri.Path = &RulesetRuleActionParametersURIPath{Expression: rewrite.PathExpression}
+	}
+
+	switch {
+	case rewrite.Query != nil && rewrite.QueryExpression != "":
+		return RulesetRule{}, errors.New("query and query expression are mutually exclusive")
+	case rewrite.Query != nil:
+		uri.Query = &RulesetRuleActionParametersURIQuery{Value: rewrite.Query}
+	case rewrite.QueryExpression != "":
+		uri.Query = &RulesetRuleActionParametersURIQuery{Expression: rewrite.QueryExpression}
+	}
+
+	if uri.Path == nil && uri.Query == nil {
+		return RulesetRule{}, errors.New("a path or query rewrite must be provided")
+	}
+
+	return RulesetRule{
+		Action:           RulesetRuleActionRewrite,
+		Expression:       expression,
+		Description:      description,
+		Enabled:          Bool(true),
+		ActionParameters: &RulesetRuleActionParameters{URI: uri},
+	}, nil
+}
+
+// TransformRequestHeadersRule returns a rule of the
+// http_request_late_transform phase modifying the headers of requests
+// matching expression before they are sent to the origin.
+func TransformRequestHeadersRule(expression, description string, headers ...TransformHeader) (RulesetRule, error) {
+	return transformHeadersRule(expression, description, false, headers)
+}
+
+// TransformResponseHeadersRule returns a rule of the
+// http_response_headers_transform phase modifying the headers of responses
+// to requests matching expression.
+func TransformResponseHeadersRule(expression, description string, headers ...TransformHeader) (RulesetRule, error) {
+	return transformHeadersRule(expression, description, true, headers)
+}
+
+func transformHeadersRule(expression, description string, response bool, headers []TransformHeader) (RulesetRule, error) {
+	if expression == "" {
+		return RulesetRule{}, errors.New("expression must be provided")
+	}
+
+	if len(headers) == 0 {
+		return RulesetRule{}, errors.New("at least one header must be provided")
+	}
+
+	params := make(map[string]RulesetRuleActionParametersHTTPHeader, len(headers))
+	for _, h := range headers {
+		if !isValidHeaderName(h.Name) {
+			return RulesetRule{}, fmt.Errorf("invalid header name: %q", h.Name)
+		}
+
+		if _, ok := params[h.Name]; ok {
+			return RulesetRule{}, fmt.Errorf("header %s is modified more than once", h.Name)
+		}
+
+		switch h.Operation {
+		case "set":
+			if (h.Value == "") == (h.Expression == "") {
+				return RulesetRule{}, fmt.Errorf("header %s: exactly one of value and expression must be set", h.Name)
+			}
+		case "add":
+			if !response {
+				return RulesetRule{}, fmt.Errorf("header %s: add is only available for response headers", h.Name)
+			}
+			if h.Value == "" && h.Expression == "" {
+				return RulesetRule{}, fmt.Errorf("header %s: a value or expression must be set", h.Name)
+			}
+		case "remove":
+			if h.Value != "" || h.Expression != "" {
+				return RulesetRule{}, fmt.Errorf("header %s: remove does not take a value", h.Name)
+			}
+		default:
+			return RulesetRule{}, fmt.Errorf("header %s: invalid operation %q", h.Name, h.Operation)
+		}
+
+		params[h.Name] = RulesetRuleActionParametersHTTPHeader{
+			Operation:  h.Operation,
+			Value:      h.Value,
+			Expression: h.Expression,
+		}
+	}
+
+	return RulesetRule{
+		Action:           RulesetRuleActionRewrite,
+		Expression:       expression,
+		Description:      description,
+		Enabled:          Bool(true),
+		ActionParameters: &RulesetRuleActionParameters{Headers: params},
+	}, nil
+}
+
+// isValidHeaderName reports whether name is a valid HTTP header field name.
+func isValidHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, c := range name {
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' {
+			continue
+		}
+		if !strings.ContainsRune("!#$%&'*+-.^_`|~", c) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,226 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TunnelRoute sends traffic for a private network to a tunnel.
+type TunnelRoute struct {
+	ID               string     `json:"id"`
+	Network          string     `json:"network"`
+	TunnelID         string     `json:"tunnel_id"`
+	TunnelName       string     `json:"tunnel_name,omitempty"`
+	Comment          string     `json:"comment"`
+	VirtualNetworkID string     `json:"virtual_network_id,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TunnelRouteResponse represents the response from the tunnel routes
+// endpoint containing a single route.
+type TunnelRouteResponse struct {
+	Response
+	Result TunnelRoute `json:"result"`
+}
+
+// TunnelRoutesResponse represents the response from the tunnel routes
+// endpoint containing multiple routes.
+type TunnelRoutesResponse struct {
+	Response
+	Result     []TunnelRoute `json:"result"`
+	ResultInfo ResultInfo    `json:"result_info"`
+}
+
+// TunnelRouteListParams contains the filters available when listing tunnel
+// routes.
+type TunnelRouteListParams struct {
+	TunnelID         string     `url:"tunnel_id,omitempty"`
+	VirtualNetworkID string     `url:"virtual_network_id,omitempty"`
+	Comment          string     `url:"comment,omitempty"`
+	IsDeleted        *bool      `url:"is_deleted,omitempty"`
+	NetworkSubset    string     `url:"network_subset,omitempty"`
+	NetworkSuperset  string     `url:"network_superset,omitempty"`
+	ExistedAt        *time.Time `url:"existed_at,omitempty"`
+
+	PaginationParams
+}
+
+// TunnelRouteParams contains the fields used to create or update a tunnel
+// route. Network is a CIDR such as "10.0.0.0/8".
+type TunnelRouteParams struct {
+	Network          string `json:"network,omitempty"`
+	TunnelID         string `json:"tunnel_id,omitempty"`
+	Comment          string `json:"comment,omitempty"`
+	VirtualNetworkID string `json:"virtual_network_id,omitempty"`
+}
+
+// TunnelRouteForIPParams selects the virtual network searched by
+// GetRouteForIP.
+type TunnelRouteForIPParams struct {
+	VirtualNetworkID string `url:"virtual_network_id,omitempty"`
+}
+
+// ListRoutes returns the private network routes of an account.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-list-tunnel-routes
+func (s *TunnelsService) ListRoutes(ctx context.Context, accountID string, params TunnelRouteListParams) ([]TunnelRoute, ResultInfo, error) {
+	var routes []TunnelRoute
+	var info ResultInfo
+	err := s.ForEachRoutesPage(ctx, accountID, params, func(page []TunnelRoute, pageInfo ResultInfo) bool {
+		routes = append(routes, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []TunnelRoute{}, ResultInfo{}, err
+	}
+
+	return routes, info, nil
+}
+
+// ForEachRoutesPage is like ListRoutes but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-list-tunnel-routes
+func (s *TunnelsService) ForEachRoutesPage(ctx context.Context, accountID string, params TunnelRouteListParams, fn func(items []TunnelRoute, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/teamnet/routes", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r TunnelRoutesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal tunnel route JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetRoute fetches a single tunnel route.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-get-tunnel-route
+func (s *TunnelsService) GetRoute(ctx context.Context, accountID, routeID string) (TunnelRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if routeID == "" {
+		return TunnelRoute{}, fmt.Errorf(errMissingResourceID, "route")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/teamnet/routes/"+routeID, nil)
+	if err != nil {
+		return TunnelRoute{}, err
+	}
+
+	var r TunnelRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelRoute{}, fmt.Errorf("failed to unmarshal tunnel route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetRouteForIP returns the most specific route containing an IP address.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-get-tunnel-route-by-ip
+func (s *TunnelsService) GetRouteForIP(ctx context.Context, accountID, ip string, params TunnelRouteForIPParams) (TunnelRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if ip == "" {
+		return TunnelRoute{}, errors.New("ip address must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/teamnet/routes/ip/"+ip, params), nil)
+	if err != nil {
+		return TunnelRoute{}, err
+	}
+
+	var r TunnelRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelRoute{}, fmt.Errorf("failed to unmarshal tunnel route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateRoute routes a private network through a tunnel.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-create-tunnel-route
+func (s *TunnelsService) CreateRoute(ctx context.Context, accountID string, params TunnelRouteParams) (TunnelRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Network == "" || params.TunnelID == "" {
+		return TunnelRoute{}, errors.New("network and tunnel ID are required to create a tunnel route")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/teamnet/routes", params)
+	if err != nil {
+		return TunnelRoute{}, err
+	}
+
+	var r TunnelRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelRoute{}, fmt.Errorf("failed to unmarshal tunnel route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateRoute modifies an existing tunnel route.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-update-tunnel-route
+func (s *TunnelsService) UpdateRoute(ctx context.Context, accountID, routeID string, params TunnelRouteParams) (TunnelRoute, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelRoute{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if routeID == "" {
+		return TunnelRoute{}, fmt.Errorf(errMissingResourceID, "route")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/teamnet/routes/"+routeID, params)
+	if err != nil {
+		return TunnelRoute{}, err
+	}
+
+	var r TunnelRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelRoute{}, fmt.Errorf("failed to unmarshal tunnel route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteRoute removes a tunnel route.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-delete-tunnel-route
+func (s *TunnelsService) DeleteRoute(ctx context.Context, accountID, routeID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if routeID == "" {
+		return fmt.Errorf(errMissingResourceID, "route")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/teamnet/routes/"+routeID, nil)
+	return err
+}
@@ -0,0 +1,173 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// TunnelVirtualNetwork isolates overlapping private networks routed through
+// tunnels.
+type TunnelVirtualNetwork struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Comment          string     `json:"comment"`
+	IsDefaultNetwork bool       `json:"is_default_network"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TunnelVirtualNetworkResponse represents the response from the virtual
+// networks endpoint containing a single network.
+type TunnelVirtualNetworkResponse struct {
+	Response
+	Result TunnelVirtualNetwork `json:"result"`
+}
+
+// TunnelVirtualNetworksResponse represents the response from the virtual
+// networks endpoint containing multiple networks.
+type TunnelVirtualNetworksResponse struct {
+	Response
+	Result     []TunnelVirtualNetwork `json:"result"`
+	ResultInfo ResultInfo             `json:"result_info"`
+}
+
+// TunnelVirtualNetworkListParams contains the filters available when
+// listing virtual networks.
+type TunnelVirtualNetworkListParams struct {
+	ID        string `url:"id,omitempty"`
+	Name      string `url:"name,omitempty"`
+	IsDefault *bool  `url:"is_default,omitempty"`
+	IsDeleted *bool  `url:"is_deleted,omitempty"`
+
+	PaginationParams
+}
+
+// TunnelVirtualNetworkCreateParams contains the details needed to create a
+// virtual network.
+type TunnelVirtualNetworkCreateParams struct {
+	Name      string `json:"name"`
+	Comment   string `json:"comment,omitempty"`
+	IsDefault bool   `json:"is_default,omitempty"`
+}
+
+// TunnelVirtualNetworkUpdateParams contains the fields that can be changed
+// on a virtual network.
+type TunnelVirtualNetworkUpdateParams struct {
+	Name             string `json:"name,omitempty"`
+	Comment          string `json:"comment,omitempty"`
+	IsDefaultNetwork *bool  `json:"is_default_network,omitempty"`
+}
+
+// ListVirtualNetworks returns the virtual networks of an account. Every page
+// is fetched unless params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-list-virtual-networks
+func (s *TunnelsService) ListVirtualNetworks(ctx context.Context, accountID string, params TunnelVirtualNetworkListParams) ([]TunnelVirtualNetwork, ResultInfo, error) {
+	var networks []TunnelVirtualNetwork
+	var info ResultInfo
+	err := s.ForEachVirtualNetworksPage(ctx, accountID, params, func(page []TunnelVirtualNetwork, pageInfo ResultInfo) bool {
+		networks = append(networks, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []TunnelVirtualNetwork{}, ResultInfo{}, err
+	}
+
+	return networks, info, nil
+}
+
+// ForEachVirtualNetworksPage is like ListVirtualNetworks but passes each page
+// of results to fn as it is fetched instead of collecting them. Paging stops
+// early when fn returns false.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-list-virtual-networks
+func (s *TunnelsService) ForEachVirtualNetworksPage(ctx context.Context, accountID string, params TunnelVirtualNetworkListParams, fn func(items []TunnelVirtualNetwork, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/teamnet/virtual_networks", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r TunnelVirtualNetworksResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal tunnel virtual network JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// CreateVirtualNetwork creates a new virtual network.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-create-virtual-network
+func (s *TunnelsService) CreateVirtualNetwork(ctx context.Context, accountID string, params TunnelVirtualNetworkCreateParams) (TunnelVirtualNetwork, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelVirtualNetwork{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Name == "" {
+		return TunnelVirtualNetwork{}, errors.New("name is required to create a virtual network")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/teamnet/virtual_networks", params)
+	if err != nil {
+		return TunnelVirtualNetwork{}, err
+	}
+
+	var r TunnelVirtualNetworkResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelVirtualNetwork{}, fmt.Errorf("failed to unmarshal tunnel virtual network JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateVirtualNetwork modifies an existing virtual network.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-update-virtual-network
+func (s *TunnelsService) UpdateVirtualNetwork(ctx context.Context, accountID, virtualNetworkID string, params TunnelVirtualNetworkUpdateParams) (TunnelVirtualNetwork, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelVirtualNetwork{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if virtualNetworkID == "" {
+		return TunnelVirtualNetwork{}, fmt.Errorf(errMissingResourceID, "virtual network")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/teamnet/virtual_networks/"+virtualNetworkID, params)
+	if err != nil {
+		return TunnelVirtualNetwork{}, err
+	}
+
+	var r TunnelVirtualNetworkResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelVirtualNetwork{}, fmt.Errorf("failed to unmarshal tunnel virtual network JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteVirtualNetwork removes a virtual network. Networks still used by
+// routes cannot be deleted.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-delete-virtual-network
+func (s *TunnelsService) DeleteVirtualNetwork(ctx context.Context, accountID, virtualNetworkID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if virtualNetworkID == "" {
+		return fmt.Errorf(errMissingResourceID, "virtual network")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/teamnet/virtual_networks/"+virtualNetworkID, nil)
+	return err
+}
@@ -0,0 +1,450 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type TunnelsService service
+
+// Tunnel is a Cloudflare Tunnel run by cloudflared.
+type Tunnel struct {
+	ID              string             `json:"id,omitempty"`
+	AccountTag      string             `json:"account_tag,omitempty"`
+	Name            string             `json:"name"`
+	TunnelType      string             `json:"tun_type,omitempty"`
+	Status          string             `json:"status,omitempty"`
+	RemoteConfig    bool               `json:"remote_config,omitempty"`
+	CreatedAt       *time.Time         `json:"created_at,omitempty"`
+	DeletedAt       *time.Time         `json:"deleted_at,omitempty"`
+	ConnsActiveAt   *time.Time         `json:"conns_active_at,omitempty"`
+	ConnsInactiveAt *time.Time         `json:"conns_inactive_at,omitempty"`
+	Connections     []TunnelConnection `json:"connections,omitempty"`
+}
+
+// TunnelConnection is a single connection between cloudflared and a
+// Cloudflare data centre.
+type TunnelConnection struct {
+	ID                 string     `json:"id"`
+	ColoName           string     `json:"colo_name"`
+	ClientID           string     `json:"client_id"`
+	ClientVersion      string     `json:"client_version"`
+	OpenedAt           *time.Time `json:"opened_at,omitempty"`
+	OriginIP           string     `json:"origin_ip"`
+	IsPendingReconnect bool       `json:"is_pending_reconnect"`
+}
+
+// TunnelClient is a cloudflared instance connected to a tunnel.
+type TunnelClient struct {
+	ID            string             `json:"id"`
+	Features      []string           `json:"features"`
+	Version       string             `json:"version"`
+	Arch          string             `json:"arch"`
+	ConfigVersion int                `json:"config_version"`
+	RunAt         *time.Time         `json:"run_at,omitempty"`
+	Connections   []TunnelConnection `json:"conns"`
+}
+
+// TunnelConfiguration is the remotely managed configuration of a tunnel.
+type TunnelConfiguration struct {
+	Ingress       []TunnelIngressRule  `json:"ingress,omitempty"`
+	WarpRouting   *TunnelWarpRouting   `json:"warp-routing,omitempty"`
+	OriginRequest *TunnelOriginRequest `json:"originRequest,omitempty"`
+}
+
+// TunnelIngressRule routes requests for Hostname and Path to Service. The
+// last rule must match everything, for example with Service set to
+// "http_status:404".
+type TunnelIngressRule struct {
+	Hostname      string               `json:"hostname,omitempty"`
+	Path          string               `json:"path,omitempty"`
+	Service       string               `json:"service"`
+	OriginRequest *TunnelOriginRequest `json:"originRequest,omitempty"`
+}
+
+// TunnelWarpRouting controls whether WARP clients can reach private networks
+// through the tunnel.
+type TunnelWarpRouting struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TunnelOriginRequest configures how cloudflared connects to origins.
+// Timeouts are in seconds.
+type TunnelOriginRequest struct {
+	ConnectTimeout         *int                `json:"connectTimeout,omitempty"`
+	TLSTimeout             *int                `json:"tlsTimeout,omitempty"`
+	TCPKeepAlive           *int                `json:"tcpKeepAlive,omitempty"`
+	NoHappyEyeballs        *bool               `json:"noHappyEyeballs,omitempty"`
+	KeepAliveConnections   *int                `json:"keepAliveConnections,omitempty"`
+	KeepAliveTimeout       *int                `json:"keepAliveTimeout,omitempty"`
+	HTTPHostHeader         *string             `json:"httpHostHeader,omitempty"`
+	OriginServerName       *string             `json:"originServerName,omitempty"`
+	CAPool                 *string             `json:"caPool,omitempty"`
+	NoTLSVerify            *bool               `json:"noTLSVerify,omitempty"`
+	DisableChunkedEncoding *bool               `json:"disableChunkedEncoding,omitempty"`
+	BastionMode            *bool               `json:"bastionMode,omitempty"`
+	ProxyAddress           *string             `json:"proxyAddress,omitempty"`
+	ProxyPort              *uint               `json:"proxyPort,omitempty"`
+	ProxyType              *string             `json:"proxyType,omitempty"`
+	HTTP2Origin            *bool               `json:"http2Origin,omitempty"`
+	Access                 *TunnelAccessConfig `json:"access,omitempty"`
+}
+
+// TunnelAccessConfig requires a valid Access JWT on requests to an origin.
+type TunnelAccessConfig struct {
+	Required bool     `json:"required,omitempty"`
+	TeamName string   `json:"teamName"`
+	AudTag   []string `json:"audTag"`
+}
+
+// TunnelConfigurationResult is a versioned tunnel configuration.
+type TunnelConfigurationResult struct {
+	TunnelID  string              `json:"tunnel_id,omitempty"`
+	Version   int                 `json:"version"`
+	Config    TunnelConfiguration `json:"config"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+}
+
+// TunnelResponse represents the response from the tunnels endpoint
+// containing a single tunnel.
+type TunnelResponse struct {
+	Response
+	Result Tunnel `json:"result"`
+}
+
+// TunnelsResponse represents the response from the tunnels endpoint
+// containing multiple tunnels.
+type TunnelsResponse struct {
+	Response
+	Result     []Tunnel   `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
+}
+
+// TunnelTokenResponse represents the response from the tunnel token
+// endpoint.
+type TunnelTokenResponse struct {
+	Response
+	Result string `json:"result"`
+}
+
+// TunnelConnectionsResponse represents the response from the tunnel
+// connections endpoint.
+type TunnelConnectionsResponse struct {
+	Response
+	Result []TunnelClient `json:"result"`
+}
+
+// TunnelConfigurationResponse represents the response from the tunnel
+// configuration endpoint.
+type TunnelConfigurationResponse struct {
+	Response
+	Result TunnelConfigurationResult `json:"result"`
+}
+
+// TunnelListParams contains the filters available when listing tunnels.
+type TunnelListParams struct {
+	Name          string     `url:"name,omitempty"`
+	UUID          string     `url:"uuid,omitempty"`
+	IsDeleted     *bool      `url:"is_deleted,omitempty"`
+	ExistedAt     *time.Time `url:"existed_at,omitempty"`
+	IncludePrefix string     `url:"include_prefix,omitempty"`
+	ExcludePrefix string     `url:"exclude_prefix,omitempty"`
+
+	PaginationParams
+}
+
+// TunnelCreateParams contains the details needed to create a tunnel.
+//
+// Secret is the base64 encoded secret of at least 32 bytes that cloudflared
+// authenticates with. ConfigSource is "local" for tunnels configured by a
+// cloudflared config file or "cloudflare" for tunnels managed with
+// UpdateConfiguration.
+type TunnelCreateParams struct {
+	Name         string `json:"name"`
+	Secret       string `json:"tunnel_secret,omitempty"`
+	ConfigSource string `json:"config_src,omitempty"`
+}
+
+// TunnelUpdateParams contains the fields that can be changed on a tunnel.
+type TunnelUpdateParams struct {
+	Name   string `json:"name,omitempty"`
+	Secret string `json:"tunnel_secret,omitempty"`
+}
+
+// TunnelCleanupParams selects the connections removed by
+// CleanupConnections.
+type TunnelCleanupParams struct {
+	// ClientID only removes the connections of a single cloudflared
+	// instance. All stale connections are removed when empty.
+	ClientID string `url:"client_id,omitempty"`
+}
+
+// tunnelConfigurationParams is the request body used to update a tunnel
+// configuration.
+type tunnelConfigurationParams struct {
+	Config TunnelConfiguration `json:"config"`
+}
+
+// List returns the tunnels of an account.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-list-cloudflare-tunnels
+func (s *TunnelsService) List(ctx context.Context, accountID string, params TunnelListParams) ([]Tunnel, ResultInfo, error) {
+	var tunnels []Tunnel
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []Tunnel, pageInfo ResultInfo) bool {
+		tunnels = append(tunnels, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []Tunnel{}, ResultInfo{}, err
+	}
+
+	return tunnels, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-list-cloudflare-tunnels
+func (s *TunnelsService) ForEachPage(ctx context.Context, accountID string, params TunnelListParams, fn func(items []Tunnel, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/cfd_tunnel", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r TunnelsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal tunnel JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-get-cloudflare-tunnel
+func (s *TunnelsService) Get(ctx context.Context, accountID, tunnelID string) (Tunnel, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Tunnel{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return Tunnel{}, fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID, nil)
+	if err != nil {
+		return Tunnel{}, err
+	}
+
+	var r TunnelResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Tunnel{}, fmt.Errorf("failed to unmarshal tunnel JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-create-cloudflare-tunnel
+func (s *TunnelsService) Create(ctx context.Context, accountID string, params TunnelCreateParams) (Tunnel, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Tunnel{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.Name == "" {
+		return Tunnel{}, errors.New("name is required to create a tunnel")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/cfd_tunnel", params)
+	if err != nil {
+		return Tunnel{}, err
+	}
+
+	var r TunnelResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Tunnel{}, fmt.Errorf("failed to unmarshal tunnel JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update renames a tunnel or rotates its secret.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-update-cloudflare-tunnel
+func (s *TunnelsService) Update(ctx context.Context, accountID, tunnelID string, params TunnelUpdateParams) (Tunnel, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return Tunnel{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return Tunnel{}, fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID, params)
+	if err != nil {
+		return Tunnel{}, err
+	}
+
+	var r TunnelResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Tunnel{}, fmt.Errorf("failed to unmarshal tunnel JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a tunnel. Tunnels with active connections must have them
+// cleaned up first.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-delete-cloudflare-tunnel
+func (s *TunnelsService) Delete(ctx context.Context, accountID, tunnelID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID, nil)
+	return err
+}
+
+// CleanupConnections removes stale connections of a tunnel, for example
+// after a cloudflared instance was stopped without shutting down cleanly.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-clean-up-cloudflare-tunnel-connections
+func (s *TunnelsService) CleanupConnections(ctx context.Context, accountID, tunnelID string, params TunnelCleanupParams) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, buildURI("/accounts/"+accountID+"/cfd_tunnel/"+tunnelID+"/connections", params), nil)
+	return err
+}
+
+// Connections returns the cloudflared instances connected to a tunnel and
+// their connections.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-list-cloudflare-tunnel-connections
+func (s *TunnelsService) Connections(ctx context.Context, accountID, tunnelID string) ([]TunnelClient, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []TunnelClient{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return []TunnelClient{}, fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID+"/connections", nil)
+	if err != nil {
+		return []TunnelClient{}, err
+	}
+
+	var r TunnelConnectionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []TunnelClient{}, fmt.Errorf("failed to unmarshal tunnel connections JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Token returns the token used to run a tunnel with `cloudflared tunnel run
+// --token`.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-get-a-cloudflare-tunnel-token
+func (s *TunnelsService) Token(ctx context.Context, accountID, tunnelID string) (string, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return "", fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return "", fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID+"/token", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r TunnelTokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal tunnel token JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetConfiguration returns the remotely managed configuration of a tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-configuration-get-configuration
+func (s *TunnelsService) GetConfiguration(ctx context.Context, accountID, tunnelID string) (TunnelConfigurationResult, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelConfigurationResult{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return TunnelConfigurationResult{}, fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID+"/configurations", nil)
+	if err != nil {
+		return TunnelConfigurationResult{}, err
+	}
+
+	var r TunnelConfigurationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelConfigurationResult{}, fmt.Errorf("failed to unmarshal tunnel configuration JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateConfiguration replaces the remotely managed configuration of a
+// tunnel. Connected cloudflared instances pick up the new version
+// automatically.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-configuration-put-configuration
+func (s *TunnelsService) UpdateConfiguration(ctx context.Context, accountID, tunnelID string, config TunnelConfiguration) (TunnelConfigurationResult, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TunnelConfigurationResult{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if tunnelID == "" {
+		return TunnelConfigurationResult{}, fmt.Errorf(errMissingResourceID, "tunnel")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/cfd_tunnel/"+tunnelID+"/configurations", tunnelConfigurationParams{Config: config})
+	if err != nil {
+		return TunnelConfigurationResult{}, err
+	}
+
+	var r TunnelConfigurationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TunnelConfigurationResult{}, fmt.Errorf("failed to unmarshal tunnel configuration JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
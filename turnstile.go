@@ -0,0 +1,255 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type TurnstileService service
+
+// TurnstileWidgetMode selects how a Turnstile widget challenges visitors.
+type TurnstileWidgetMode string
+
+const (
+	TurnstileWidgetModeManaged        TurnstileWidgetMode = "managed"
+	TurnstileWidgetModeNonInteractive TurnstileWidgetMode = "non-interactive"
+	TurnstileWidgetModeInvisible      TurnstileWidgetMode = "invisible"
+)
+
+// TurnstileWidget is a Turnstile widget, identified by its sitekey, that can
+// be embedded on the listed Domains. Secret is the key used to verify the
+// tokens it issues. ClearanceLevel is "no_clearance", "jschallenge",
+// "managed" or "interactive".
+type TurnstileWidget struct {
+	SiteKey        string              `json:"sitekey,omitempty"`
+	Secret         string              `json:"secret,omitempty"`
+	Name           string              `json:"name"`
+	Domains        []string            `json:"domains"`
+	Mode           TurnstileWidgetMode `json:"mode"`
+	BotFightMode   bool                `json:"bot_fight_mode"`
+	Region         string              `json:"region,omitempty"`
+	ClearanceLevel string              `json:"clearance_level,omitempty"`
+	Offlabel       bool                `json:"offlabel"`
+	CreatedOn      *time.Time          `json:"created_on,omitempty"`
+	ModifiedOn     *time.Time          `json:"modified_on,omitempty"`
+}
+
+// TurnstileWidgetListParams contains the options available when listing
+// Turnstile widgets. Order is "id", "sitekey", "name", "created_on" or
+// "modified_on" and Direction is "asc" or "desc".
+type TurnstileWidgetListParams struct {
+	Order string `url:"order,omitempty"`
+	PaginationParams
+}
+
+// turnstileRotateSecretParams is the request body of the rotate secret
+// endpoint.
+type turnstileRotateSecretParams struct {
+	InvalidateImmediately bool `json:"invalidate_immediately"`
+}
+
+// TurnstileWidgetResponse represents the response from the Turnstile
+// widgets endpoint containing a single widget.
+type TurnstileWidgetResponse struct {
+	Response
+	Result TurnstileWidget `json:"result"`
+}
+
+// TurnstileWidgetsResponse represents the response from the Turnstile
+// widgets endpoint containing multiple widgets.
+type TurnstileWidgetsResponse struct {
+	Response
+	Result     []TurnstileWidget `json:"result"`
+	ResultInfo ResultInfo        `json:"result_info"`
+}
+
+// List returns the Turnstile widgets of an account.
+//
+// API reference: https://api.cloudflare.com/#turnstile-list-turnstile-widgets
+func (s *TurnstileService) List(ctx context.Context, accountID string, params TurnstileWidgetListParams) ([]TurnstileWidget, ResultInfo, error) {
+	var widgets []TurnstileWidget
+	var info ResultInfo
+	err := s.ForEachPage(ctx, accountID, params, func(page []TurnstileWidget, pageInfo ResultInfo) bool {
+		widgets = append(widgets, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []TurnstileWidget{}, ResultInfo{}, err
+	}
+
+	return widgets, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#turnstile-list-turnstile-widgets
+func (s *TurnstileService) ForEachPage(ctx context.Context, accountID string, params TurnstileWidgetListParams, fn func(items []TurnstileWidget, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/challenges/widgets", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r TurnstileWidgetsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal turnstile widget JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single Turnstile widget.
+//
+// API reference: https://api.cloudflare.com/#turnstile-turnstile-widget-details
+func (s *TurnstileService) Get(ctx context.Context, accountID, siteKey string) (TurnstileWidget, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TurnstileWidget{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if siteKey == "" {
+		return TurnstileWidget{}, fmt.Errorf(errMissingResourceID, "turnstile widget")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/challenges/widgets/"+siteKey, nil)
+	if err != nil {
+		return TurnstileWidget{}, err
+	}
+
+	var r TurnstileWidgetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TurnstileWidget{}, fmt.Errorf("failed to unmarshal turnstile widget JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a Turnstile widget. The sitekey and secret of the new
+// widget are set in the result.
+//
+// API reference: https://api.cloudflare.com/#turnstile-create-a-turnstile-widget
+func (s *TurnstileService) Create(ctx context.Context, accountID string, widget TurnstileWidget) (TurnstileWidget, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TurnstileWidget{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if err := validateTurnstileWidget(widget); err != nil {
+		return TurnstileWidget{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/challenges/widgets", widget)
+	if err != nil {
+		return TurnstileWidget{}, err
+	}
+
+	var r TurnstileWidgetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TurnstileWidget{}, fmt.Errorf("failed to unmarshal turnstile widget JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the name, domains and settings of a Turnstile widget.
+//
+// API reference: https://api.cloudflare.com/#turnstile-update-a-turnstile-widget
+func (s *TurnstileService) Update(ctx context.Context, accountID, siteKey string, widget TurnstileWidget) (TurnstileWidget, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TurnstileWidget{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if siteKey == "" {
+		return TurnstileWidget{}, fmt.Errorf(errMissingResourceID, "turnstile widget")
+	}
+
+	if err := validateTurnstileWidget(widget); err != nil {
+		return TurnstileWidget{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/challenges/widgets/"+siteKey, widget)
+	if err != nil {
+		return TurnstileWidget{}, err
+	}
+
+	var r TurnstileWidgetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TurnstileWidget{}, fmt.Errorf("failed to unmarshal turnstile widget JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// RotateSecret generates a new secret for a Turnstile widget. Unless
+// invalidateImmediately is set, the previous secret remains valid for two
+// hours so servers can be updated without rejecting tokens.
+//
+// API reference: https://api.cloudflare.com/#turnstile-rotate-secret-for-a-turnstile-widget
+func (s *TurnstileService) RotateSecret(ctx context.Context, accountID, siteKey string, invalidateImmediately bool) (TurnstileWidget, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return TurnstileWidget{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if siteKey == "" {
+		return TurnstileWidget{}, fmt.Errorf(errMissingResourceID, "turnstile widget")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/challenges/widgets/"+siteKey+"/rotate_secret", turnstileRotateSecretParams{InvalidateImmediately: invalidateImmediately})
+	if err != nil {
+		return TurnstileWidget{}, err
+	}
+
+	var r TurnstileWidgetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return TurnstileWidget{}, fmt.Errorf("failed to unmarshal turnstile widget JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a Turnstile widget.
+//
+// API reference: https://api.cloudflare.com/#turnstile-delete-a-turnstile-widget
+func (s *TurnstileService) Delete(ctx context.Context, accountID, siteKey string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if siteKey == "" {
+		return fmt.Errorf(errMissingResourceID, "turnstile widget")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/challenges/widgets/"+siteKey, nil)
+	return err
+}
+
+// validateTurnstileWidget checks the fields required to create or update a
+// widget.
+func validateTurnstileWidget(widget TurnstileWidget) error {
+	if widget.Name == "" {
+		return errors.New("name must be provided")
+	}
+
+	if len(widget.Domains) == 0 {
+		return errors.New("at least one domain must be provided")
+	}
+
+	switch widget.Mode {
+	case TurnstileWidgetModeManaged, TurnstileWidgetModeNonInteractive, TurnstileWidgetModeInvisible:
+	default:
+		return fmt.Errorf("invalid turnstile widget mode: %q", widget.Mode)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// turnstileVerifyURL is the siteverify endpoint Turnstile tokens are
+// validated against.
+const turnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+
+// TurnstileVerifyResponse is the result of validating a Turnstile token.
+// ErrorCodes explains why a token was rejected, for example
+// "invalid-input-response" or "timeout-or-duplicate". Action and CData are
+// the values set by the page rendering the widget.
+type TurnstileVerifyResponse struct {
+	Success     bool       `json:"success"`
+	ChallengeTS *time.Time `json:"challenge_ts,omitempty"`
+	Hostname    string     `json:"hostname"`
+	ErrorCodes  []string   `json:"error-codes"`
+	Action      string     `json:"action"`
+	CData       string     `json:"cdata"`
+}
+
+// Verify validates a token issued by a Turnstile widget using the widget's
+// secret. remoteIP, the address of the visitor that solved the challenge, is
+// optional. A token can only be verified once.
+//
+// A nil error does not mean the token is valid: callers must check Success.
+// Verify does not send the client's API credentials and can be used by a
+// client created without any.
+func (s *TurnstileService) Verify(ctx context.Context, secret, token, remoteIP string) (TurnstileVerifyResponse, error) {
+	if secret == "" || token == "" {
+		return TurnstileVerifyResponse{}, errors.New("secret and token must be provided")
+	}
+
+	form := url.Values{}
+	form.Set("secret", secret)
+	form.Set("response", token)
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, turnstileVerifyURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("HTTP request creation failed: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if s.client.UserAgent != "" {
+		req.Header.Set("User-Agent", s.client.UserAgent)
+	}
+
+	resp, err := s.client.Client().Do(req)
+	if err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("could not read response body: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return TurnstileVerifyResponse{}, fmt.Errorf("HTTP status %d: siteverify request failed", resp.StatusCode)
+	}
+
+	var r TurnstileVerifyResponse
+	err = json.Unmarshal(body, &r)
+	if err != nil {
+		return TurnstileVerifyResponse{}, fmt.Errorf("failed to unmarshal turnstile verify JSON data: %w", err)
+	}
+
+	return r, nil
+}
@@ -0,0 +1,232 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// tusVersion is the version of the tus resumable upload protocol spoken
+	// by the upload endpoints.
+	tusVersion = "1.0.0"
+
+	// tusChunkAlignment is the multiple every chunk but the last must be.
+	tusChunkAlignment = 256 * 1024
+
+	// tusMinChunkSize is the smallest chunk accepted by the upload endpoints.
+	tusMinChunkSize = 5 * 1024 * 1024
+
+	// defaultTusChunkSize is used when no chunk size is given.
+	defaultTusChunkSize = 50 * 1024 * 1024
+)
+
+// tusCreate starts a resumable upload of length bytes at uri and returns the
+// absolute URL the data is sent to, taken from the Location header, along
+// with the response. metadata is encoded into the Upload-Metadata header; keys
+// with empty values are sent without a value.
+func (c *Client) tusCreate(ctx context.Context, uri string, length int64, metadata map[string]string, headers http.Header) (string, *http.Response, error) {
+	h := make(http.Header)
+	copyHeader(h, headers)
+	h.Set("Tus-Resumable", tusVersion)
+	h.Set("Upload-Length", strconv.FormatInt(length, 10))
+	if m := tusMetadata(metadata); m != "" {
+		h.Set("Upload-Metadata", m)
+	}
+
+	resp, err := c.uploadRequest(ctx, http.MethodPost, uri, nil, h)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if resp.Header.Get("Location") == "" {
+		return "", nil, errors.New("upload creation response did not include a location")
+	}
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid upload location: %w", err)
+	}
+
+	// A relative location is relative to the URL that was requested, which
+	// already includes the path of the API.
+	base := c.BaseURL
+	if resp.Request != nil {
+		base = resp.Request.URL
+	}
+	location = base.ResolveReference(location)
+
+	if location.Scheme != "https" && !isSameOrigin(location, c.BaseURL) {
+		return "", nil, fmt.Errorf("refusing insecure upload location %s", location.Redacted())
+	}
+
+	return location.String(), resp, nil
+}
+
+// tusOffset returns the number of bytes of an upload the server has
+// received.
+func (c *Client) tusOffset(ctx context.Context, location string) (int64, error) {
+	resp, err := c.uploadRequest(ctx, http.MethodHead, location, nil, http.Header{"Tus-Resumable": []string{tusVersion}})
+	if err != nil {
+		return 0, err
+	}
+
+	return parseTusOffset(resp)
+}
+
+// tusUpload sends the remainder of an upload of length bytes to location in
+// chunks of chunkSize, starting at the offset already received by the server.
+// r must be positioned at the start of the data: if it is an io.Seeker it is
+// moved to the offset, otherwise the bytes before it are skipped. Chunks that
+// fail are retried following the client's RetryPolicy, resuming from the
+// offset the server reports.
+func (c *Client) tusUpload(ctx context.Context, location string, r io.Reader, length int64, chunkSize int) error {
+	if chunkSize == 0 {
+		chunkSize = defaultTusChunkSize
+	}
+
+	if chunkSize < tusMinChunkSize || chunkSize%tusChunkAlignment != 0 {
+		return fmt.Errorf("chunk size must be at least %d bytes and a multiple of %d bytes", tusMinChunkSize, tusChunkAlignment)
+	}
+
+	offset, err := c.tusOffset(ctx, location)
+	if err != nil {
+		return err
+	}
+
+	if offset > 0 {
+		if seeker, ok := r.(io.Seeker); ok {
+			_, err = seeker.Seek(offset, io.SeekStart)
+		} else {
+			_, err = io.CopyN(io.Discard, r, offset)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to skip to upload offset %d: %w", offset, err)
+		}
+	}
+
+	buf := make([]byte, chunkSize)
+	for offset < length {
+		n := int64(chunkSize)
+		if length-offset < n {
+			n = length - offset
+		}
+
+		if _, err := io.ReadFull(r, buf[:n]); err != nil {
+			return fmt.Errorf("failed to read upload data at offset %d: %w", offset, err)
+		}
+
+		offset, err = c.tusSendChunk(ctx, location, offset, buf[:n])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// tusSendChunk sends a chunk starting at offset and returns the new offset.
+// If the request fails the server is asked how much of the chunk it received
+// and the rest is sent again.
+func (c *Client) tusSendChunk(ctx context.Context, location string, offset int64, chunk []byte) (int64, error) {
+	end := offset + int64(len(chunk))
+
+	attempt := 0
+	for {
+		resp, err := c.uploadRequest(ctx, http.MethodPatch, location, bytes.NewReader(chunk), http.Header{
+			"Tus-Resumable": []string{tusVersion},
+			"Upload-Offset": []string{strconv.FormatInt(offset, 10)},
+			"Content-Type":  []string{"application/offset+octet-stream"},
+		})
+		if err == nil {
+			next, perr := parseTusOffset(resp)
+			if perr != nil {
+				return 0, perr
+			}
+			if next == end {
+				return next, nil
+			}
+			err = fmt.Errorf("server reported offset %d after chunk ending at %d", next, end)
+		}
+
+		if resp != nil && resp.StatusCode >= http.StatusBadRequest && resp.StatusCode < http.StatusInternalServerError &&
+			resp.StatusCode != http.StatusConflict && resp.StatusCode != http.StatusTooManyRequests {
+			return 0, err
+		}
+
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+
+		if attempt >= c.RetryPolicy.MaxRetries {
+			return 0, fmt.Errorf("upload failed at offset %d after %d retries: %w", offset, attempt, err)
+		}
+		attempt++
+
+		sleepDuration := time.Duration(math.Pow(2, float64(attempt-1)) * float64(c.RetryPolicy.MinRetryDelay))
+		if sleepDuration > c.RetryPolicy.MaxRetryDelay {
+			sleepDuration = c.RetryPolicy.MaxRetryDelay
+		}
+		c.Logger.Printf("upload chunk at offset %d failed (%s), retrying in %s", offset, err, sleepDuration)
+
+		select {
+		case <-time.After(sleepDuration):
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+
+		current, err := c.tusOffset(ctx, location)
+		if err != nil {
+			continue
+		}
+		if current < offset || current > end {
+			return 0, fmt.Errorf("server reported offset %d outside of chunk %d-%d", current, offset, end)
+		}
+		if current == end {
+			return current, nil
+		}
+
+		chunk = chunk[current-offset:]
+		offset = current
+	}
+}
+
+// parseTusOffset reads the Upload-Offset header of a tus response.
+func parseTusOffset(resp *http.Response) (int64, error) {
+	offset, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid upload offset in response: %w", err)
+	}
+	return offset, nil
+}
+
+// tusMetadata encodes metadata as an Upload-Metadata header value. Keys are
+// sorted so the header is deterministic.
+func tusMetadata(metadata map[string]string) string {
+	keys := make([]string, 0, len(metadata))
+	for k := range metadata {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if metadata[k] == "" {
+			pairs = append(pairs, k)
+			continue
+		}
+		pairs = append(pairs, k+" "+base64.StdEncoding.EncodeToString([]byte(metadata[k])))
+	}
+
+	return strings.Join(pairs, ",")
+}
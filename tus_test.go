@@ -0,0 +1,239 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// tusServer is a minimal tus server storing a single upload.
+type tusServer struct {
+	data    []byte
+	patches []string
+	// failPatches makes the given number of PATCH requests fail after
+	// storing half of their data.
+	failPatches int
+}
+
+func (s *tusServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Tus-Resumable") != tusVersion {
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	}
+
+	w.Header().Set("Tus-Resumable", tusVersion)
+
+	switch r.Method {
+	case http.MethodHead:
+		w.Header().Set("Upload-Offset", strconv.Itoa(len(s.data)))
+	case http.MethodPatch:
+		offset, _ := strconv.Atoi(r.Header.Get("Upload-Offset"))
+		chunk, _ := io.ReadAll(r.Body)
+		s.patches = append(s.patches, fmt.Sprintf("%d+%d", offset, len(chunk)))
+
+		if offset != len(s.data) {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		if s.failPatches > 0 {
+			s.failPatches--
+			s.data = append(s.data, chunk[:len(chunk)/2]...)
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+
+		s.data = append(s.data, chunk...)
+		w.Header().Set("Upload-Offset", strconv.Itoa(len(s.data)))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// testUploadData returns size bytes of data that differ between chunks.
+func testUploadData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i / 1024)
+	}
+	return data
+}
+
+func TestStreamCreateUpload_ResolvesLocation(t *testing.T) {
+	client, mux := setup(t)
+
+	var metadata string
+	mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/stream", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Upload-Length") != "1024" {
+			t.Errorf("got Upload-Length %q, want 1024", r.Header.Get("Upload-Length"))
+		}
+		metadata = r.Header.Get("Upload-Metadata")
+		w.Header().Set("Location", "/tus/abc?tusv2=true")
+		w.Header().Set("Stream-Media-Id", "abc")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	upload, err := client.Stream.CreateUpload(context.Background(), testAccountID, StreamUploadParams{
+		Size:              1024,
+		Name:              "video.mp4",
+		RequireSignedURLs: true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The location is resolved against the request URL, not appended to the
+	// base URL which would repeat /client/v4.
+	want := strings.TrimSuffix(client.BaseURL.String(), "/client/v4") + "/tus/abc?tusv2=true"
+	if upload.URL != want {
+		t.Errorf("got URL %q, want %q", upload.URL, want)
+	}
+	if upload.VideoID != "abc" {
+		t.Errorf("got video ID %q, want abc", upload.VideoID)
+	}
+
+	if want := "name dmlkZW8ubXA0,requiresignedurls"; metadata != want {
+		t.Errorf("got metadata %q, want %q", metadata, want)
+	}
+}
+
+func TestStreamCreateUpload_RejectsInsecureLocation(t *testing.T) {
+	client, mux := setup(t)
+
+	mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/stream", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "http://upload.example.com/tus/abc")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	_, err := client.Stream.CreateUpload(context.Background(), testAccountID, StreamUploadParams{Size: 1024})
+	if err == nil || !strings.Contains(err.Error(), "insecure upload location") {
+		t.Errorf("got error %v, want insecure upload location", err)
+	}
+}
+
+func TestStreamContinueUpload_Resumes(t *testing.T) {
+	client, mux := setup(t)
+
+	data := testUploadData(12 * 1024 * 1024)
+	server := &tusServer{data: append([]byte(nil), data[:5*1024*1024]...)}
+	mux.Handle("/tus/abc", server)
+
+	upload := StreamUpload{URL: client.BaseURL.Scheme + "://" + client.BaseURL.Host + "/tus/abc", Size: int64(len(data))}
+	err := client.Stream.ContinueUpload(context.Background(), upload, bytes.NewReader(data), tusMinChunkSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The first 5MiB were already uploaded, leaving a full chunk and a short
+	// last chunk.
+	want := []string{"5242880+5242880", "10485760+2097152"}
+	if strings.Join(server.patches, ",") != strings.Join(want, ",") {
+		t.Errorf("got patches %v, want %v", server.patches, want)
+	}
+
+	if !bytes.Equal(server.data, data) {
+		t.Error("uploaded data does not match")
+	}
+}
+
+func TestStreamContinueUpload_NonSeekableReader(t *testing.T) {
+	client, mux := setup(t)
+
+	data := testUploadData(6 * 1024 * 1024)
+	server := &tusServer{data: append([]byte(nil), data[:1024*1024]...)}
+	mux.Handle("/tus/abc", server)
+
+	upload := StreamUpload{URL: client.BaseURL.Scheme + "://" + client.BaseURL.Host + "/tus/abc", Size: int64(len(data))}
+	err := client.Stream.ContinueUpload(context.Background(), upload, io.LimitReader(bytes.NewReader(data), int64(len(data))), 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !bytes.Equal(server.data, data) {
+		t.Error("uploaded data does not match")
+	}
+}
+
+func TestStreamContinueUpload_RetriesPartialChunk(t *testing.T) {
+	client, mux := setup(t)
+
+	data := testUploadData(6 * 1024 * 1024)
+	server := &tusServer{failPatches: 1}
+	mux.Handle("/tus/abc", server)
+
+	upload := StreamUpload{URL: client.BaseURL.Scheme + "://" + client.BaseURL.Host + "/tus/abc", Size: int64(len(data))}
+	err := client.Stream.ContinueUpload(context.Background(), upload, bytes.NewReader(data), tusMinChunkSize)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Only the half of the first chunk the server didn't store is resent.
+	want := []string{"0+5242880", "2621440+2621440", "5242880+1048576"}
+	if strings.Join(server.patches, ",") != strings.Join(want, ",") {
+		t.Errorf("got patches %v, want %v", server.patches, want)
+	}
+
+	if !bytes.Equal(server.data, data) {
+		t.Error("uploaded data does not match")
+	}
+}
+
+func TestStreamContinueUpload_ChunkSize(t *testing.T) {
+	client, _ := setup(t)
+
+	upload := StreamUpload{URL: client.BaseURL.String() + "/tus/abc", Size: 1024}
+	for _, chunkSize := range []int{tusChunkAlignment, tusMinChunkSize + 1, tusMinChunkSize - tusChunkAlignment} {
+		err := client.Stream.ContinueUpload(context.Background(), upload, bytes.NewReader(nil), chunkSize)
+		if err == nil || !strings.Contains(err.Error(), "chunk size must be") {
+			t.Errorf("chunk size %d: got error %v, want chunk size error", chunkSize, err)
+		}
+	}
+}
+
+func TestUploadRequest_Credentials(t *testing.T) {
+	client, _ := setup(t)
+
+	var auth []string
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		auth = append(auth, r.URL.Host+"="+r.Header.Get("Authorization"))
+		return &http.Response{StatusCode: http.StatusNoContent, Header: http.Header{}, Body: http.NoBody, Request: r}, nil
+	})
+	ctx := WithRoundTripper(context.Background(), transport)
+
+	for _, uri := range []string{
+		"/accounts/" + testAccountID + "/stream",
+		"https://upload.videodelivery.net/tus/abc",
+		"https://upload.example.com/tus/abc",
+	} {
+		if _, err := client.uploadRequest(ctx, http.MethodHead, uri, nil, nil); err != nil {
+			t.Fatalf("%s: unexpected error: %s", uri, err)
+		}
+	}
+
+	want := []string{
+		client.BaseURL.Host + "=Bearer deadbeef",
+		"upload.videodelivery.net=Bearer deadbeef",
+		"upload.example.com=",
+	}
+	if strings.Join(auth, ",") != strings.Join(want, ",") {
+		t.Errorf("got credentials %v, want %v", auth, want)
+	}
+
+	_, err := client.uploadRequest(ctx, http.MethodHead, "http://upload.videodelivery.net/tus/abc", nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "insecure URL") {
+		t.Errorf("got error %v, want insecure URL", err)
+	}
+}
+
+// roundTripFunc is an http.RoundTripper calling itself.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
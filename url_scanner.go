@@ -0,0 +1,311 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type URLScannerService service
+
+// defaultURLScanPollInterval is how often WaitForScan checks on a scan when
+// no interval is given.
+const defaultURLScanPollInterval = 5 * time.Second
+
+// URLScanStatus is the state of a URL scan.
+type URLScanStatus string
+
+const (
+	URLScanStatusQueued     URLScanStatus = "Queued"
+	URLScanStatusInProgress URLScanStatus = "InProgress"
+	URLScanStatusFinished   URLScanStatus = "Finished"
+	URLScanStatusFailed     URLScanStatus = "Failed"
+)
+
+// URLScanSubmitParams contains the options of a new URL scan. Visibility is
+// either "Public", the default, or "Unlisted". ScreenshotsResolutions selects
+// the screenshots taken, from "desktop", "mobile" and "tablet".
+type URLScanSubmitParams struct {
+	URL                    string            `json:"url"`
+	Visibility             string            `json:"visibility,omitempty"`
+	CustomHeaders          map[string]string `json:"customHeaders,omitempty"`
+	ScreenshotsResolutions []string          `json:"screenshotsResolutions,omitempty"`
+}
+
+// URLScanSubmission identifies a submitted URL scan.
+type URLScanSubmission struct {
+	UUID       string     `json:"uuid"`
+	URL        string     `json:"url"`
+	Visibility string     `json:"visibility"`
+	Time       *time.Time `json:"time,omitempty"`
+}
+
+// URLScan is the report of a URL scan. Only Task is populated until the scan
+// has finished.
+type URLScan struct {
+	Task     URLScanTask     `json:"task"`
+	Page     URLScanPage     `json:"page"`
+	Verdicts URLScanVerdicts `json:"verdicts"`
+	Meta     URLScanMeta     `json:"meta"`
+}
+
+// URLScanTask describes the progress of a URL scan.
+type URLScanTask struct {
+	UUID        string                  `json:"uuid"`
+	URL         string                  `json:"url"`
+	Status      URLScanStatus           `json:"status"`
+	Success     bool                    `json:"success"`
+	Visibility  string                  `json:"visibility"`
+	Time        *time.Time              `json:"time,omitempty"`
+	TimeEnd     *time.Time              `json:"timeEnd,omitempty"`
+	Errors      []URLScanTaskError      `json:"errors,omitempty"`
+	Screenshots []URLScanScreenshotInfo `json:"screenshots,omitempty"`
+}
+
+// URLScanTaskError is an error encountered while scanning.
+type URLScanTaskError struct {
+	Message string `json:"message"`
+}
+
+// URLScanScreenshotInfo describes a screenshot taken during a scan. The
+// image itself is fetched with Screenshot.
+type URLScanScreenshotInfo struct {
+	Resolution string `json:"resolution"`
+	Hash       string `json:"hash,omitempty"`
+	Phash      string `json:"phash,omitempty"`
+}
+
+// URLScanPage describes the page that was scanned.
+type URLScanPage struct {
+	URL     string `json:"url"`
+	Domain  string `json:"domain"`
+	Title   string `json:"title"`
+	Status  string `json:"status"`
+	IP      string `json:"ip"`
+	ASN     string `json:"asn"`
+	ASNName string `json:"asnname"`
+	Country string `json:"country"`
+	Server  string `json:"server"`
+}
+
+// URLScanVerdicts holds the verdicts reached by a scan.
+type URLScanVerdicts struct {
+	Overall URLScanVerdict `json:"overall"`
+}
+
+// URLScanVerdict reports whether a scan found the page to be malicious.
+type URLScanVerdict struct {
+	Malicious             bool              `json:"malicious"`
+	Categories            []URLScanCategory `json:"categories,omitempty"`
+	PhishingSpoofedBrands []string          `json:"phishing,omitempty"`
+}
+
+// URLScanCategory is a content or security category.
+type URLScanCategory struct {
+	ID              int    `json:"id"`
+	SuperCategoryID int    `json:"super_category_id,omitempty"`
+	Name            string `json:"name"`
+}
+
+// URLScanMeta holds the output of the scan's processors.
+type URLScanMeta struct {
+	Processors URLScanProcessors `json:"processors"`
+}
+
+// URLScanProcessors holds the technologies detected on the page.
+type URLScanProcessors struct {
+	Tech []URLScanTechnology `json:"tech"`
+}
+
+// URLScanTechnology is a technology detected on the scanned page.
+type URLScanTechnology struct {
+	Name       string            `json:"name"`
+	Slug       string            `json:"slug"`
+	Website    string            `json:"website,omitempty"`
+	Confidence int               `json:"confidence"`
+	Categories []URLScanCategory `json:"categories,omitempty"`
+}
+
+// URLScanSearchParams contains the filters available when searching prior
+// scans.
+type URLScanSearchParams struct {
+	ScanID       string     `url:"scanId,omitempty"`
+	URL          string     `url:"url,omitempty"`
+	Hostname     string     `url:"hostname,omitempty"`
+	Path         string     `url:"path,omitempty"`
+	PageURL      string     `url:"page_url,omitempty"`
+	PageHostname string     `url:"page_hostname,omitempty"`
+	PagePath     string     `url:"page_path,omitempty"`
+	DateStart    *time.Time `url:"date_start,omitempty"`
+	DateEnd      *time.Time `url:"date_end,omitempty"`
+	AccountScans bool       `url:"account_scans,omitempty"`
+	Limit        int        `url:"limit,omitempty"`
+	NextCursor   string     `url:"next_cursor,omitempty"`
+}
+
+// URLScanSearchResult is a scan matching a search.
+type URLScanSearchResult struct {
+	UUID       string     `json:"uuid"`
+	URL        string     `json:"url"`
+	Success    bool       `json:"success"`
+	Visibility string     `json:"visibility"`
+	Time       *time.Time `json:"time,omitempty"`
+}
+
+// urlScanScreenshotParams is the query string of the screenshot endpoint.
+type urlScanScreenshotParams struct {
+	Resolution string `url:"resolution,omitempty"`
+}
+
+// URLScanSubmissionResponse represents the response from submitting a scan.
+type URLScanSubmissionResponse struct {
+	Response
+	Result URLScanSubmission `json:"result"`
+}
+
+// URLScanResponse represents the response from the scan report endpoint.
+type URLScanResponse struct {
+	Response
+	Result struct {
+		Scan URLScan `json:"scan"`
+	} `json:"result"`
+}
+
+// URLScanSearchResponse represents the response from the scan search
+// endpoint.
+type URLScanSearchResponse struct {
+	Response
+	Result struct {
+		Tasks []URLScanSearchResult `json:"tasks"`
+	} `json:"result"`
+}
+
+// SubmitScan queues a URL to be scanned. The scan runs asynchronously; use
+// WaitForScan to block until its report is available.
+//
+// API reference: https://api.cloudflare.com/#url-scanner-create-scan
+func (s *URLScannerService) SubmitScan(ctx context.Context, accountID string, params URLScanSubmitParams) (URLScanSubmission, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return URLScanSubmission{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if params.URL == "" {
+		return URLScanSubmission{}, errors.New("url must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/urlscanner/scan", params)
+	if err != nil {
+		return URLScanSubmission{}, err
+	}
+
+	var r URLScanSubmissionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return URLScanSubmission{}, fmt.Errorf("failed to unmarshal url scan JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetScan fetches the report of a scan. Until the scan has finished only
+// the Task is populated, with Status reporting its progress.
+//
+// API reference: https://api.cloudflare.com/#url-scanner-get-scan
+func (s *URLScannerService) GetScan(ctx context.Context, accountID, scanID string) (URLScan, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return URLScan{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scanID == "" {
+		return URLScan{}, fmt.Errorf(errMissingResourceID, "url scan")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/urlscanner/scan/"+scanID, nil)
+	if err != nil {
+		return URLScan{}, err
+	}
+
+	var r URLScanResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return URLScan{}, fmt.Errorf("failed to unmarshal url scan JSON data: %w", err)
+	}
+
+	return r.Result.Scan, nil
+}
+
+// WaitForScan polls a scan every interval, or five seconds if interval is
+// zero, until it finishes and returns its report. An error is returned if the
+// scan fails or ctx is done first.
+func (s *URLScannerService) WaitForScan(ctx context.Context, accountID, scanID string, interval time.Duration) (URLScan, error) {
+	if interval <= 0 {
+		interval = defaultURLScanPollInterval
+	}
+
+	for {
+		scan, err := s.GetScan(ctx, accountID, scanID)
+		if err != nil {
+			return URLScan{}, err
+		}
+
+		switch scan.Task.Status {
+		case URLScanStatusFinished:
+			return scan, nil
+		case URLScanStatusFailed:
+			return scan, fmt.Errorf("url scan %s failed", scanID)
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return URLScan{}, ctx.Err()
+		}
+	}
+}
+
+// Screenshot returns the PNG screenshot taken by a scan at a resolution,
+// which defaults to "desktop".
+//
+// API reference: https://api.cloudflare.com/#url-scanner-get-scan-screenshot
+func (s *URLScannerService) Screenshot(ctx context.Context, accountID, scanID, resolution string) ([]byte, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scanID == "" {
+		return nil, fmt.Errorf(errMissingResourceID, "url scan")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/urlscanner/scan/"+scanID+"/screenshot", urlScanScreenshotParams{Resolution: resolution}), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SearchScans returns the prior scans matching the provided
+// `URLScanSearchParams`.
+//
+// API reference: https://api.cloudflare.com/#url-scanner-search-scans
+func (s *URLScannerService) SearchScans(ctx context.Context, accountID string, params URLScanSearchParams) ([]URLScanSearchResult, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []URLScanSearchResult{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, buildURI("/accounts/"+accountID+"/urlscanner/scan", params), nil)
+	if err != nil {
+		return []URLScanSearchResult{}, err
+	}
+
+	var r URLScanSearchResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []URLScanSearchResult{}, fmt.Errorf("failed to unmarshal url scan JSON data: %w", err)
+	}
+
+	return r.Result.Tasks, nil
+}
@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type UserService service
+
+// User describes a user account.
+type User struct {
+	ID                 string     `json:"id,omitempty"`
+	Email              string     `json:"email,omitempty"`
+	FirstName          string     `json:"first_name,omitempty"`
+	LastName           string     `json:"last_name,omitempty"`
+	Username           string     `json:"username,omitempty"`
+	Telephone          string     `json:"telephone,omitempty"`
+	Country            string     `json:"country,omitempty"`
+	Zipcode            string     `json:"zipcode,omitempty"`
+	CreatedOn          *time.Time `json:"created_on,omitempty"`
+	ModifiedOn         *time.Time `json:"modified_on,omitempty"`
+	TwoFA              bool       `json:"two_factor_authentication_enabled,omitempty"`
+	TwoFALock          bool       `json:"two_factor_authentication_locked,omitempty"`
+	Suspended          bool       `json:"suspended,omitempty"`
+	HasProZones        bool       `json:"has_pro_zones,omitempty"`
+	HasBusinessZones   bool       `json:"has_business_zones,omitempty"`
+	HasEnterpriseZones bool       `json:"has_enterprise_zones,omitempty"`
+	Betas              []string   `json:"betas,omitempty"`
+	Accounts           []Account  `json:"organizations,omitempty"`
+}
+
+// UserResponse wraps a response containing User accounts.
+type UserResponse struct {
+	Response
+	Result User `json:"result"`
+}
+
+// UserUpdateParams contains the profile fields of the user that can be
+// changed. Empty values are left untouched.
+type UserUpdateParams struct {
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Telephone string `json:"telephone,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Zipcode   string `json:"zipcode,omitempty"`
+}
+
+// Get fetches the details of the user the credentials belong to.
+//
+// API reference: https://api.cloudflare.com/#user-user-details
+func (s *UserService) Get(ctx context.Context) (User, error) {
+	res, err := s.client.Call(ctx, http.MethodGet, "/user", nil)
+	if err != nil {
+		return User{}, err
+	}
+
+	var r UserResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to unmarshal user JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update modifies the profile of the user the credentials belong to.
+//
+// API reference: https://api.cloudflare.com/#user-edit-user
+func (s *UserService) Update(ctx context.Context, params UserUpdateParams) (User, error) {
+	res, err := s.client.Call(ctx, http.MethodPatch, "/user", params)
+	if err != nil {
+		return User{}, err
+	}
+
+	var r UserResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return User{}, fmt.Errorf("failed to unmarshal user JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// AuditLogs returns the audit log entries for actions performed by the user
+// that match the provided `AuditLogListParams`. Every page is fetched unless
+// params.Page is set.
+//
+// API reference: https://api.cloudflare.com/#audit-logs-get-user-audit-logs
+func (s *UserService) AuditLogs(ctx context.Context, params AuditLogListParams) ([]AuditLog, ResultInfo, error) {
+	var logs []AuditLog
+	var info ResultInfo
+	err := s.ForEachAuditLogsPage(ctx, params, func(page []AuditLog, pageInfo ResultInfo) bool {
+		logs = append(logs, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []AuditLog{}, ResultInfo{}, err
+	}
+
+	return logs, info, nil
+}
+
+// ForEachAuditLogsPage is like AuditLogs but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#audit-logs-get-user-audit-logs
+func (s *UserService) ForEachAuditLogsPage(ctx context.Context, params AuditLogListParams, fn func(items []AuditLog, info ResultInfo) bool) error {
+	return s.client.listPages(ctx, "/user/audit_logs", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r AuditLogsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal audit log JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
@@ -0,0 +1,209 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+type UserAgentRulesService service
+
+// UserAgentRule represents a User-Agent blocking rule.
+type UserAgentRule struct {
+	ID            string                     `json:"id"`
+	Description   string                     `json:"description"`
+	Mode          string                     `json:"mode"`
+	Configuration UserAgentRuleConfiguration `json:"configuration"`
+	Paused        bool                       `json:"paused"`
+}
+
+// UserAgentRuleConfiguration represents a User-Agent blocking rule
+// configuration. Target is always "ua" and Value is the exact User-Agent to
+// match.
+type UserAgentRuleConfiguration struct {
+	Target string `json:"target"`
+	Value  string `json:"value"`
+}
+
+// UserAgentRuleParams contains the fields used to create or update a
+// User-Agent blocking rule. Mode is one of "block", "challenge",
+// "js_challenge" or "managed_challenge".
+type UserAgentRuleParams struct {
+	Description   string                     `json:"description,omitempty"`
+	Mode          string                     `json:"mode"`
+	Configuration UserAgentRuleConfiguration `json:"configuration"`
+	Paused        bool                       `json:"paused"`
+}
+
+// UserAgentRuleResponse represents a response from the User-Agent blocking
+// endpoint containing a single rule.
+type UserAgentRuleResponse struct {
+	Response
+	Result UserAgentRule `json:"result"`
+}
+
+// UserAgentRulesResponse represents a response from the User-Agent blocking
+// endpoint containing multiple rules.
+type UserAgentRulesResponse struct {
+	Response
+	Result     []UserAgentRule `json:"result"`
+	ResultInfo ResultInfo      `json:"result_info"`
+}
+
+// UserAgentRuleListParams contains the filters available when listing
+// User-Agent blocking rules.
+type UserAgentRuleListParams struct {
+	Description string `url:"description,omitempty"`
+	UserAgent   string `url:"ua_search,omitempty"`
+
+	PaginationParams
+}
+
+// List returns all User-Agent blocking rules for a zone that match the provided
+// `UserAgentRuleListParams`.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-list-user-agent-blocking-rules
+func (s *UserAgentRulesService) List(ctx context.Context, zoneID string, params UserAgentRuleListParams) ([]UserAgentRule, ResultInfo, error) {
+	var rules []UserAgentRule
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []UserAgentRule, pageInfo ResultInfo) bool {
+		rules = append(rules, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []UserAgentRule{}, ResultInfo{}, err
+	}
+
+	return rules, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-list-user-agent-blocking-rules
+func (s *UserAgentRulesService) ForEachPage(ctx context.Context, zoneID string, params UserAgentRuleListParams, fn func(items []UserAgentRule, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/firewall/ua_rules", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r UserAgentRulesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal user agent rule JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single User-Agent blocking rule.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-get-a-user-agent-blocking-rule
+func (s *UserAgentRulesService) Get(ctx context.Context, zoneID, ruleID string) (UserAgentRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return UserAgentRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if ruleID == "" {
+		return UserAgentRule{}, fmt.Errorf(errMissingResourceID, "user agent rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/firewall/ua_rules/"+ruleID, nil)
+	if err != nil {
+		return UserAgentRule{}, err
+	}
+
+	var r UserAgentRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserAgentRule{}, fmt.Errorf("failed to unmarshal user agent rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new User-Agent blocking rule.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-create-a-user-agent-blocking-rule
+func (s *UserAgentRulesService) Create(ctx context.Context, zoneID string, params UserAgentRuleParams) (UserAgentRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return UserAgentRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.Mode == "" || params.Configuration.Value == "" {
+		return UserAgentRule{}, errors.New("mode and a User-Agent value are required for a user agent rule")
+	}
+
+	if params.Configuration.Target == "" {
+		params.Configuration.Target = "ua"
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/firewall/ua_rules", params)
+	if err != nil {
+		return UserAgentRule{}, err
+	}
+
+	var r UserAgentRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserAgentRule{}, fmt.Errorf("failed to unmarshal user agent rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces an existing User-Agent blocking rule.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-update-a-user-agent-blocking-rule
+func (s *UserAgentRulesService) Update(ctx context.Context, zoneID, ruleID string, params UserAgentRuleParams) (UserAgentRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return UserAgentRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if ruleID == "" {
+		return UserAgentRule{}, fmt.Errorf(errMissingResourceID, "user agent rule")
+	}
+
+	if params.Mode == "" || params.Configuration.Value == "" {
+		return UserAgentRule{}, errors.New("mode and a User-Agent value are required for a user agent rule")
+	}
+
+	if params.Configuration.Target == "" {
+		params.Configuration.Target = "ua"
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/firewall/ua_rules/"+ruleID, params)
+	if err != nil {
+		return UserAgentRule{}, err
+	}
+
+	var r UserAgentRuleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserAgentRule{}, fmt.Errorf("failed to unmarshal user agent rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a User-Agent blocking rule.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-delete-a-user-agent-blocking-rule
+func (s *UserAgentRulesService) Delete(ctx context.Context, zoneID, ruleID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if ruleID == "" {
+		return fmt.Errorf(errMissingResourceID, "user agent rule")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/firewall/ua_rules/"+ruleID, nil)
+	return err
+}
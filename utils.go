@@ -1,6 +1,10 @@
 package cloudflare
 
-import "regexp"
+import (
+	"regexp"
+
+	"github.com/google/go-querystring/query"
+)
 
 // Int64 returns a pointer to the int64 value passed in.
 func Int64(v int64) *int64 {
@@ -98,3 +102,18 @@ func isValidZoneIdentifier(s string) bool {
 	matches, _ := regexp.MatchString(`^[0-9a-fA-F]{32}$`, s)
 	return matches
 }
+
+func isValidAccountIdentifier(s string) bool {
+	matches, _ := regexp.MatchString(`^[0-9a-fA-F]{32}$`, s)
+	return matches
+}
+
+// buildURI appends params, encoded as a query string, to path. params must be
+// a struct (or pointer to one) using `url` tags; empty values are omitted.
+func buildURI(path string, params interface{}) string {
+	v, _ := query.Values(params)
+	if q := v.Encode(); q != "" {
+		return path + "?" + q
+	}
+	return path
+}
@@ -0,0 +1,241 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// WaitingRoomRule lets requests matching Expression bypass a waiting room.
+// Action is always "bypass_waiting_room".
+type WaitingRoomRule struct {
+	ID          string     `json:"id,omitempty"`
+	Version     string     `json:"version,omitempty"`
+	Action      string     `json:"action"`
+	Expression  string     `json:"expression"`
+	Description string     `json:"description,omitempty"`
+	Enabled     *bool      `json:"enabled,omitempty"`
+	LastUpdated *time.Time `json:"last_updated,omitempty"`
+}
+
+// WaitingRoomSettings are the waiting room settings shared by every
+// waiting room of a zone.
+type WaitingRoomSettings struct {
+	SearchEngineCrawlerBypass bool `json:"search_engine_crawler_bypass"`
+}
+
+// WaitingRoomRulesResponse represents the response from the waiting room
+// rules endpoints, which always return every rule of the waiting room.
+type WaitingRoomRulesResponse struct {
+	Response
+	Result []WaitingRoomRule `json:"result"`
+}
+
+// WaitingRoomSettingsResponse represents the response from the waiting
+// room settings endpoint.
+type WaitingRoomSettingsResponse struct {
+	Response
+	Result WaitingRoomSettings `json:"result"`
+}
+
+// ListRules returns the bypass rules of a waiting room in the order they are
+// evaluated.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-list-waiting-room-rules
+func (s *WaitingRoomsService) ListRules(ctx context.Context, zoneID, waitingRoomID string) ([]WaitingRoomRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []WaitingRoomRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return []WaitingRoomRule{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules", nil)
+	if err != nil {
+		return []WaitingRoomRule{}, err
+	}
+
+	var r WaitingRoomRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WaitingRoomRule{}, fmt.Errorf("failed to unmarshal waiting room rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateRule appends a bypass rule to a waiting room and returns every rule.
+// Action defaults to "bypass_waiting_room".
+//
+// API reference: https://api.cloudflare.com/#waiting-room-create-waiting-room-rule
+func (s *WaitingRoomsService) CreateRule(ctx context.Context, zoneID, waitingRoomID string, rule WaitingRoomRule) ([]WaitingRoomRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []WaitingRoomRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return []WaitingRoomRule{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if rule.Action == "" {
+		rule.Action = "bypass_waiting_room"
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules", rule)
+	if err != nil {
+		return []WaitingRoomRule{}, err
+	}
+
+	var r WaitingRoomRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WaitingRoomRule{}, fmt.Errorf("failed to unmarshal waiting room rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ReplaceRules replaces every bypass rule of a waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-replace-waiting-room-rules
+func (s *WaitingRoomsService) ReplaceRules(ctx context.Context, zoneID, waitingRoomID string, rules []WaitingRoomRule) ([]WaitingRoomRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []WaitingRoomRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return []WaitingRoomRule{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	body := make([]WaitingRoomRule, len(rules))
+	copy(body, rules)
+	for i := range body {
+		if body[i].Action == "" {
+			body[i].Action = "bypass_waiting_room"
+		}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules", body)
+	if err != nil {
+		return []WaitingRoomRule{}, err
+	}
+
+	var r WaitingRoomRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WaitingRoomRule{}, fmt.Errorf("failed to unmarshal waiting room rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateRule changes a single bypass rule and returns every rule.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-patch-waiting-room-rule
+func (s *WaitingRoomsService) UpdateRule(ctx context.Context, zoneID, waitingRoomID, ruleID string, rule WaitingRoomRule) ([]WaitingRoomRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []WaitingRoomRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return []WaitingRoomRule{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if ruleID == "" {
+		return []WaitingRoomRule{}, fmt.Errorf(errMissingResourceID, "waiting room rule")
+	}
+
+	if rule.Action == "" {
+		rule.Action = "bypass_waiting_room"
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules/"+ruleID, rule)
+	if err != nil {
+		return []WaitingRoomRule{}, err
+	}
+
+	var r WaitingRoomRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WaitingRoomRule{}, fmt.Errorf("failed to unmarshal waiting room rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteRule removes a bypass rule and returns the rules that remain.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-delete-waiting-room-rule
+func (s *WaitingRoomsService) DeleteRule(ctx context.Context, zoneID, waitingRoomID, ruleID string) ([]WaitingRoomRule, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []WaitingRoomRule{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return []WaitingRoomRule{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if ruleID == "" {
+		return []WaitingRoomRule{}, fmt.Errorf(errMissingResourceID, "waiting room rule")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/rules/"+ruleID, nil)
+	if err != nil {
+		return []WaitingRoomRule{}, err
+	}
+
+	var r WaitingRoomRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WaitingRoomRule{}, fmt.Errorf("failed to unmarshal waiting room rule JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetSettings returns the waiting room settings of a zone.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-get-zone-level-waiting-room-settings
+func (s *WaitingRoomsService) GetSettings(ctx context.Context, zoneID string) (WaitingRoomSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoomSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/waiting_rooms/settings", nil)
+	if err != nil {
+		return WaitingRoomSettings{}, err
+	}
+
+	var r WaitingRoomSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoomSettings{}, fmt.Errorf("failed to unmarshal waiting room settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSettings replaces the waiting room settings of a zone.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-update-zone-level-waiting-room-settings
+func (s *WaitingRoomsService) UpdateSettings(ctx context.Context, zoneID string, settings WaitingRoomSettings) (WaitingRoomSettings, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoomSettings{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/waiting_rooms/settings", settings)
+	if err != nil {
+		return WaitingRoomSettings{}, err
+	}
+
+	var r WaitingRoomSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoomSettings{}, fmt.Errorf("failed to unmarshal waiting room settings JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
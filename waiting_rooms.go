@@ -0,0 +1,548 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type WaitingRoomsService service
+
+// WaitingRoomQueueingMethod selects the order users leave a waiting room.
+type WaitingRoomQueueingMethod string
+
+const (
+	WaitingRoomQueueingMethodFIFO     WaitingRoomQueueingMethod = "fifo"
+	WaitingRoomQueueingMethodRandom   WaitingRoomQueueingMethod = "random"
+	WaitingRoomQueueingMethodPassthru WaitingRoomQueueingMethod = "passthrough"
+	WaitingRoomQueueingMethodReject   WaitingRoomQueueingMethod = "reject"
+)
+
+// WaitingRoom queues visitors to a host and path once the number of active
+// users reaches TotalActiveUsers, admitting NewUsersPerMinute users as
+// others leave.
+type WaitingRoom struct {
+	ID                         string                    `json:"id,omitempty"`
+	Name                       string                    `json:"name"`
+	Description                string                    `json:"description,omitempty"`
+	Host                       string                    `json:"host"`
+	Path                       string                    `json:"path,omitempty"`
+	AdditionalRoutes           []WaitingRoomRoute        `json:"additional_routes,omitempty"`
+	Suspended                  bool                      `json:"suspended"`
+	QueueAll                   bool                      `json:"queue_all"`
+	NewUsersPerMinute          int                       `json:"new_users_per_minute"`
+	TotalActiveUsers           int                       `json:"total_active_users"`
+	SessionDuration            int                       `json:"session_duration,omitempty"`
+	DisableSessionRenewal      bool                      `json:"disable_session_renewal"`
+	QueueingMethod             WaitingRoomQueueingMethod `json:"queueing_method,omitempty"`
+	QueueingStatusCode         int                       `json:"queueing_status_code,omitempty"`
+	CustomPageHTML             string                    `json:"custom_page_html,omitempty"`
+	DefaultTemplateLanguage    string                    `json:"default_template_language,omitempty"`
+	JSONResponseEnabled        bool                      `json:"json_response_enabled"`
+	CookieSuffix               string                    `json:"cookie_suffix,omitempty"`
+	CookieAttributes           *WaitingRoomCookieAttrs   `json:"cookie_attributes,omitempty"`
+	NextEventPrequeueStartTime *time.Time                `json:"next_event_prequeue_start_time,omitempty"`
+	NextEventStartTime         *time.Time                `json:"next_event_start_time,omitempty"`
+	CreatedOn                  *time.Time                `json:"created_on,omitempty"`
+	ModifiedOn                 *time.Time                `json:"modified_on,omitempty"`
+}
+
+// WaitingRoomRoute is an additional host and path covered by a waiting
+// room.
+type WaitingRoomRoute struct {
+	Host string `json:"host"`
+	Path string `json:"path,omitempty"`
+}
+
+// WaitingRoomCookieAttrs controls the attributes of the waiting room
+// cookie. SameSite is "auto", "lax", "none" or "strict" and Secure is "auto",
+// "always" or "never".
+type WaitingRoomCookieAttrs struct {
+	SameSite string `json:"samesite,omitempty"`
+	Secure   string `json:"secure,omitempty"`
+}
+
+// WaitingRoomStatus reports the current state of a waiting room. Status is
+// "event_prequeueing", "not_queueing" or "queueing".
+type WaitingRoomStatus struct {
+	Status                    string `json:"status"`
+	EventID                   string `json:"event_id"`
+	EstimatedQueuedUsers      int    `json:"estimated_queued_users"`
+	EstimatedTotalActiveUsers int    `json:"estimated_total_active_users"`
+	MaxEstimatedTimeMinutes   int    `json:"max_estimated_time_minutes"`
+}
+
+// WaitingRoomEvent temporarily replaces the queue settings of a waiting room
+// between EventStartTime and EventEndTime. Settings left nil are inherited
+// from the waiting room. Users arriving after PrequeueStartTime are queued
+// until the event starts.
+type WaitingRoomEvent struct {
+	ID                    string                     `json:"id,omitempty"`
+	Name                  string                     `json:"name"`
+	Description           string                     `json:"description,omitempty"`
+	EventStartTime        time.Time                  `json:"event_start_time"`
+	EventEndTime          time.Time                  `json:"event_end_time"`
+	PrequeueStartTime     *time.Time                 `json:"prequeue_start_time,omitempty"`
+	Suspended             bool                       `json:"suspended"`
+	ShuffleAtEventStart   bool                       `json:"shuffle_at_event_start"`
+	QueueingMethod        *WaitingRoomQueueingMethod `json:"queueing_method,omitempty"`
+	NewUsersPerMinute     *int                       `json:"new_users_per_minute,omitempty"`
+	TotalActiveUsers      *int                       `json:"total_active_users,omitempty"`
+	SessionDuration       *int                       `json:"session_duration,omitempty"`
+	DisableSessionRenewal *bool                      `json:"disable_session_renewal,omitempty"`
+	CustomPageHTML        *string                    `json:"custom_page_html,omitempty"`
+	CreatedOn             *time.Time                 `json:"created_on,omitempty"`
+	ModifiedOn            *time.Time                 `json:"modified_on,omitempty"`
+}
+
+// WaitingRoomListParams contains the options available when listing waiting
+// rooms.
+type WaitingRoomListParams struct {
+	PaginationParams
+}
+
+// WaitingRoomEventListParams contains the options available when listing
+// waiting room events.
+type WaitingRoomEventListParams struct {
+	PaginationParams
+}
+
+// waitingRoomPreviewParams is the request body of the preview endpoint.
+type waitingRoomPreviewParams struct {
+	CustomHTML string `json:"custom_html"`
+}
+
+// WaitingRoomResponse represents the response from the waiting rooms
+// endpoint containing a single waiting room.
+type WaitingRoomResponse struct {
+	Response
+	Result WaitingRoom `json:"result"`
+}
+
+// WaitingRoomsResponse represents the response from the waiting rooms
+// endpoint containing multiple waiting rooms.
+type WaitingRoomsResponse struct {
+	Response
+	Result     []WaitingRoom `json:"result"`
+	ResultInfo ResultInfo    `json:"result_info"`
+}
+
+// WaitingRoomStatusResponse represents the response from the waiting room
+// status endpoint.
+type WaitingRoomStatusResponse struct {
+	Response
+	Result WaitingRoomStatus `json:"result"`
+}
+
+// WaitingRoomPreviewResponse represents the response from the waiting room
+// preview endpoint.
+type WaitingRoomPreviewResponse struct {
+	Response
+	Result struct {
+		PreviewURL string `json:"preview_url"`
+	} `json:"result"`
+}
+
+// WaitingRoomEventResponse represents the response from the waiting room
+// events endpoint containing a single event.
+type WaitingRoomEventResponse struct {
+	Response
+	Result WaitingRoomEvent `json:"result"`
+}
+
+// WaitingRoomEventsResponse represents the response from the waiting room
+// events endpoint containing multiple events.
+type WaitingRoomEventsResponse struct {
+	Response
+	Result     []WaitingRoomEvent `json:"result"`
+	ResultInfo ResultInfo         `json:"result_info"`
+}
+
+// List returns the waiting rooms of a zone.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-list-waiting-rooms
+func (s *WaitingRoomsService) List(ctx context.Context, zoneID string, params WaitingRoomListParams) ([]WaitingRoom, ResultInfo, error) {
+	var rooms []WaitingRoom
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []WaitingRoom, pageInfo ResultInfo) bool {
+		rooms = append(rooms, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []WaitingRoom{}, ResultInfo{}, err
+	}
+
+	return rooms, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-list-waiting-rooms
+func (s *WaitingRoomsService) ForEachPage(ctx context.Context, zoneID string, params WaitingRoomListParams, fn func(items []WaitingRoom, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/waiting_rooms", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r WaitingRoomsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal waiting room JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-waiting-room-details
+func (s *WaitingRoomsService) Get(ctx context.Context, zoneID, waitingRoomID string) (WaitingRoom, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoom{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoom{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID, nil)
+	if err != nil {
+		return WaitingRoom{}, err
+	}
+
+	var r WaitingRoomResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoom{}, fmt.Errorf("failed to unmarshal waiting room JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-create-waiting-room
+func (s *WaitingRoomsService) Create(ctx context.Context, zoneID string, room WaitingRoom) (WaitingRoom, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoom{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if room.Name == "" || room.Host == "" {
+		return WaitingRoom{}, errors.New("name and host must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/waiting_rooms", room)
+	if err != nil {
+		return WaitingRoom{}, err
+	}
+
+	var r WaitingRoomResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoom{}, fmt.Errorf("failed to unmarshal waiting room JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces the configuration of a waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-update-waiting-room
+func (s *WaitingRoomsService) Update(ctx context.Context, zoneID, waitingRoomID string, room WaitingRoom) (WaitingRoom, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoom{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoom{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if room.Name == "" || room.Host == "" {
+		return WaitingRoom{}, errors.New("name and host must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID, room)
+	if err != nil {
+		return WaitingRoom{}, err
+	}
+
+	var r WaitingRoomResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoom{}, fmt.Errorf("failed to unmarshal waiting room JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a waiting room and its events and rules.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-delete-waiting-room
+func (s *WaitingRoomsService) Delete(ctx context.Context, zoneID, waitingRoomID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID, nil)
+	return err
+}
+
+// Status returns whether a waiting room is queueing users and the estimated
+// size of its queue.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-get-waiting-room-status
+func (s *WaitingRoomsService) Status(ctx context.Context, zoneID, waitingRoomID string) (WaitingRoomStatus, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoomStatus{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoomStatus{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/status", nil)
+	if err != nil {
+		return WaitingRoomStatus{}, err
+	}
+
+	var r WaitingRoomStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoomStatus{}, fmt.Errorf("failed to unmarshal waiting room status JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Preview uploads custom waiting room page HTML and returns a temporary URL
+// the rendered page can be viewed at.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-create-a-custom-waiting-room-page-preview
+func (s *WaitingRoomsService) Preview(ctx context.Context, zoneID, customHTML string) (string, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return "", fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/waiting_rooms/preview", waitingRoomPreviewParams{CustomHTML: customHTML})
+	if err != nil {
+		return "", err
+	}
+
+	var r WaitingRoomPreviewResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal waiting room preview JSON data: %w", err)
+	}
+
+	return r.Result.PreviewURL, nil
+}
+
+// ListEvents returns the events scheduled for a waiting room.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-list-events
+func (s *WaitingRoomsService) ListEvents(ctx context.Context, zoneID, waitingRoomID string, params WaitingRoomEventListParams) ([]WaitingRoomEvent, ResultInfo, error) {
+	var events []WaitingRoomEvent
+	var info ResultInfo
+	err := s.ForEachEventsPage(ctx, zoneID, waitingRoomID, params, func(page []WaitingRoomEvent, pageInfo ResultInfo) bool {
+		events = append(events, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []WaitingRoomEvent{}, ResultInfo{}, err
+	}
+
+	return events, info, nil
+}
+
+// ForEachEventsPage is like ListEvents but passes each page of results to fn
+// as it is fetched instead of collecting them. Paging stops early when fn
+// returns false.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-list-events
+func (s *WaitingRoomsService) ForEachEventsPage(ctx context.Context, zoneID, waitingRoomID string, params WaitingRoomEventListParams, fn func(items []WaitingRoomEvent, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/events", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r WaitingRoomEventsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal waiting room event JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// GetEvent fetches a single waiting room event.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-event-details
+func (s *WaitingRoomsService) GetEvent(ctx context.Context, zoneID, waitingRoomID, eventID string) (WaitingRoomEvent, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoomEvent{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoomEvent{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if eventID == "" {
+		return WaitingRoomEvent{}, fmt.Errorf(errMissingResourceID, "waiting room event")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/events/"+eventID, nil)
+	if err != nil {
+		return WaitingRoomEvent{}, err
+	}
+
+	var r WaitingRoomEventResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoomEvent{}, fmt.Errorf("failed to unmarshal waiting room event JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// PreviewEvent returns an event with the settings it inherits from its
+// waiting room filled in, as they will apply while the event is active.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-preview-active-event-details
+func (s *WaitingRoomsService) PreviewEvent(ctx context.Context, zoneID, waitingRoomID, eventID string) (WaitingRoomEvent, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoomEvent{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoomEvent{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if eventID == "" {
+		return WaitingRoomEvent{}, fmt.Errorf(errMissingResourceID, "waiting room event")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/events/"+eventID+"/details", nil)
+	if err != nil {
+		return WaitingRoomEvent{}, err
+	}
+
+	var r WaitingRoomEventResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoomEvent{}, fmt.Errorf("failed to unmarshal waiting room event JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateEvent schedules an event for a waiting room. Events of the same
+// waiting room cannot overlap.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-create-event
+func (s *WaitingRoomsService) CreateEvent(ctx context.Context, zoneID, waitingRoomID string, event WaitingRoomEvent) (WaitingRoomEvent, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoomEvent{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoomEvent{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if event.Name == "" {
+		return WaitingRoomEvent{}, errors.New("event name must be provided")
+	}
+
+	if !event.EventEndTime.After(event.EventStartTime) {
+		return WaitingRoomEvent{}, errors.New("event end time must be after its start time")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/events", event)
+	if err != nil {
+		return WaitingRoomEvent{}, err
+	}
+
+	var r WaitingRoomEventResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoomEvent{}, fmt.Errorf("failed to unmarshal waiting room event JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateEvent replaces the configuration of a waiting room event.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-update-event
+func (s *WaitingRoomsService) UpdateEvent(ctx context.Context, zoneID, waitingRoomID, eventID string, event WaitingRoomEvent) (WaitingRoomEvent, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WaitingRoomEvent{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return WaitingRoomEvent{}, fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if eventID == "" {
+		return WaitingRoomEvent{}, fmt.Errorf(errMissingResourceID, "waiting room event")
+	}
+
+	if event.Name == "" {
+		return WaitingRoomEvent{}, errors.New("event name must be provided")
+	}
+
+	if !event.EventEndTime.After(event.EventStartTime) {
+		return WaitingRoomEvent{}, errors.New("event end time must be after its start time")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/events/"+eventID, event)
+	if err != nil {
+		return WaitingRoomEvent{}, err
+	}
+
+	var r WaitingRoomEventResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WaitingRoomEvent{}, fmt.Errorf("failed to unmarshal waiting room event JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteEvent removes a waiting room event.
+//
+// API reference: https://api.cloudflare.com/#waiting-room-delete-event
+func (s *WaitingRoomsService) DeleteEvent(ctx context.Context, zoneID, waitingRoomID, eventID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if waitingRoomID == "" {
+		return fmt.Errorf(errMissingResourceID, "waiting room")
+	}
+
+	if eventID == "" {
+		return fmt.Errorf(errMissingResourceID, "waiting room event")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/waiting_rooms/"+waitingRoomID+"/events/"+eventID, nil)
+	return err
+}
@@ -0,0 +1,418 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type Web3Service service
+
+// Web3HostnameTarget is the gateway a Web3 hostname serves.
+type Web3HostnameTarget string
+
+const (
+	Web3HostnameTargetEthereum          Web3HostnameTarget = "ethereum"
+	Web3HostnameTargetIPFS              Web3HostnameTarget = "ipfs"
+	Web3HostnameTargetIPFSUniversalPath Web3HostnameTarget = "ipfs_universal_path"
+)
+
+// Web3Hostname is a hostname of a zone serving an Ethereum or IPFS gateway.
+// DNSLink is the IPFS path served by ipfs hostnames. Status is "active",
+// "pending", "deleting" or "error".
+type Web3Hostname struct {
+	ID          string             `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Status      string             `json:"status,omitempty"`
+	Target      Web3HostnameTarget `json:"target,omitempty"`
+	DNSLink     string             `json:"dnslink,omitempty"`
+	CreatedOn   *time.Time         `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time         `json:"modified_on,omitempty"`
+}
+
+// Web3HostnameUpdateParams contains the fields of a Web3 hostname that can
+// be changed after it is created.
+type Web3HostnameUpdateParams struct {
+	Description string `json:"description,omitempty"`
+	DNSLink     string `json:"dnslink,omitempty"`
+}
+
+// Web3ContentList controls the content an ipfs_universal_path hostname
+// refuses to serve. Action is "block".
+type Web3ContentList struct {
+	Action  string                 `json:"action"`
+	Entries []Web3ContentListEntry `json:"entries"`
+}
+
+// Web3ContentListEntry is a CID or content path on a content list. Type is
+// "cid" or "content_path".
+type Web3ContentListEntry struct {
+	ID          string     `json:"id,omitempty"`
+	Content     string     `json:"content"`
+	Type        string     `json:"type"`
+	Description string     `json:"description,omitempty"`
+	CreatedOn   *time.Time `json:"created_on,omitempty"`
+	ModifiedOn  *time.Time `json:"modified_on,omitempty"`
+}
+
+// Web3HostnameResponse represents the response from the Web3 hostnames
+// endpoint containing a single hostname.
+type Web3HostnameResponse struct {
+	Response
+	Result Web3Hostname `json:"result"`
+}
+
+// Web3HostnamesResponse represents the response from the Web3 hostnames
+// endpoint containing multiple hostnames.
+type Web3HostnamesResponse struct {
+	Response
+	Result []Web3Hostname `json:"result"`
+}
+
+// Web3ContentListResponse represents the response from the content list
+// endpoint.
+type Web3ContentListResponse struct {
+	Response
+	Result Web3ContentList `json:"result"`
+}
+
+// Web3ContentListEntryResponse represents the response from the content
+// list entries endpoint containing a single entry.
+type Web3ContentListEntryResponse struct {
+	Response
+	Result Web3ContentListEntry `json:"result"`
+}
+
+// Web3ContentListEntriesResponse represents the response from the content
+// list entries endpoint containing multiple entries.
+type Web3ContentListEntriesResponse struct {
+	Response
+	Result struct {
+		Entries []Web3ContentListEntry `json:"entries"`
+	} `json:"result"`
+}
+
+// ListHostnames returns the Web3 hostnames of a zone.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-list-web3-hostnames
+func (s *Web3Service) ListHostnames(ctx context.Context, zoneID string) ([]Web3Hostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []Web3Hostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/web3/hostnames", nil)
+	if err != nil {
+		return []Web3Hostname{}, err
+	}
+
+	var r Web3HostnamesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Web3Hostname{}, fmt.Errorf("failed to unmarshal web3 hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetHostname fetches a single Web3 hostname.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-web3-hostname-details
+func (s *Web3Service) GetHostname(ctx context.Context, zoneID, hostnameID string) (Web3Hostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3Hostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return Web3Hostname{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID, nil)
+	if err != nil {
+		return Web3Hostname{}, err
+	}
+
+	var r Web3HostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3Hostname{}, fmt.Errorf("failed to unmarshal web3 hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateHostname creates a Web3 hostname. Name must be a hostname of the
+// zone.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-create-web3-hostname
+func (s *Web3Service) CreateHostname(ctx context.Context, zoneID string, hostname Web3Hostname) (Web3Hostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3Hostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostname.Name == "" || hostname.Target == "" {
+		return Web3Hostname{}, errors.New("name and target must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/web3/hostnames", hostname)
+	if err != nil {
+		return Web3Hostname{}, err
+	}
+
+	var r Web3HostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3Hostname{}, fmt.Errorf("failed to unmarshal web3 hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateHostname changes the description or DNSLink of a Web3 hostname.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-edit-web3-hostname
+func (s *Web3Service) UpdateHostname(ctx context.Context, zoneID, hostnameID string, params Web3HostnameUpdateParams) (Web3Hostname, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3Hostname{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return Web3Hostname{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID, params)
+	if err != nil {
+		return Web3Hostname{}, err
+	}
+
+	var r Web3HostnameResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3Hostname{}, fmt.Errorf("failed to unmarshal web3 hostname JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteHostname removes a Web3 hostname.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-delete-web3-hostname
+func (s *Web3Service) DeleteHostname(ctx context.Context, zoneID, hostnameID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID, nil)
+	return err
+}
+
+// GetContentList returns the content list of an ipfs_universal_path
+// hostname.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-ipfs-universal-path-gateway-content-list-details
+func (s *Web3Service) GetContentList(ctx context.Context, zoneID, hostnameID string) (Web3ContentList, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3ContentList{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return Web3ContentList{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID+"/ipfs_universal_path/content_list", nil)
+	if err != nil {
+		return Web3ContentList{}, err
+	}
+
+	var r Web3ContentListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3ContentList{}, fmt.Errorf("failed to unmarshal web3 content list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateContentList replaces the content list of an ipfs_universal_path
+// hostname.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-update-ipfs-universal-path-gateway-content-list
+func (s *Web3Service) UpdateContentList(ctx context.Context, zoneID, hostnameID string, list Web3ContentList) (Web3ContentList, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3ContentList{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return Web3ContentList{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	if list.Action == "" {
+		list.Action = "block"
+	}
+
+	if list.Entries == nil {
+		list.Entries = []Web3ContentListEntry{}
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID+"/ipfs_universal_path/content_list", list)
+	if err != nil {
+		return Web3ContentList{}, err
+	}
+
+	var r Web3ContentListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3ContentList{}, fmt.Errorf("failed to unmarshal web3 content list JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// ListContentListEntries returns the entries of the content list of an
+// ipfs_universal_path hostname.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-list-ipfs-universal-path-gateway-content-list-entries
+func (s *Web3Service) ListContentListEntries(ctx context.Context, zoneID, hostnameID string) ([]Web3ContentListEntry, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []Web3ContentListEntry{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return []Web3ContentListEntry{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID+"/ipfs_universal_path/content_list"+"/entries", nil)
+	if err != nil {
+		return []Web3ContentListEntry{}, err
+	}
+
+	var r Web3ContentListEntriesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Web3ContentListEntry{}, fmt.Errorf("failed to unmarshal web3 content list entry JSON data: %w", err)
+	}
+
+	return r.Result.Entries, nil
+}
+
+// GetContentListEntry fetches a single content list entry.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-ipfs-universal-path-gateway-content-list-entry-details
+func (s *Web3Service) GetContentListEntry(ctx context.Context, zoneID, hostnameID, entryID string) (Web3ContentListEntry, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3ContentListEntry{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return Web3ContentListEntry{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	if entryID == "" {
+		return Web3ContentListEntry{}, fmt.Errorf(errMissingResourceID, "content list entry")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID+"/ipfs_universal_path/content_list"+"/entries/"+entryID, nil)
+	if err != nil {
+		return Web3ContentListEntry{}, err
+	}
+
+	var r Web3ContentListEntryResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3ContentListEntry{}, fmt.Errorf("failed to unmarshal web3 content list entry JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateContentListEntry adds an entry to the content list of an
+// ipfs_universal_path hostname.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-create-ipfs-universal-path-gateway-content-list-entry
+func (s *Web3Service) CreateContentListEntry(ctx context.Context, zoneID, hostnameID string, entry Web3ContentListEntry) (Web3ContentListEntry, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3ContentListEntry{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return Web3ContentListEntry{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	if entry.Content == "" || entry.Type == "" {
+		return Web3ContentListEntry{}, errors.New("content and type must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID+"/ipfs_universal_path/content_list"+"/entries", entry)
+	if err != nil {
+		return Web3ContentListEntry{}, err
+	}
+
+	var r Web3ContentListEntryResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3ContentListEntry{}, fmt.Errorf("failed to unmarshal web3 content list entry JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateContentListEntry replaces a content list entry.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-edit-ipfs-universal-path-gateway-content-list-entry
+func (s *Web3Service) UpdateContentListEntry(ctx context.Context, zoneID, hostnameID, entryID string, entry Web3ContentListEntry) (Web3ContentListEntry, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Web3ContentListEntry{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return Web3ContentListEntry{}, fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	if entryID == "" {
+		return Web3ContentListEntry{}, fmt.Errorf(errMissingResourceID, "content list entry")
+	}
+
+	if entry.Content == "" || entry.Type == "" {
+		return Web3ContentListEntry{}, errors.New("content and type must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID+"/ipfs_universal_path/content_list"+"/entries/"+entryID, entry)
+	if err != nil {
+		return Web3ContentListEntry{}, err
+	}
+
+	var r Web3ContentListEntryResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Web3ContentListEntry{}, fmt.Errorf("failed to unmarshal web3 content list entry JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteContentListEntry removes a content list entry.
+//
+// API reference: https://api.cloudflare.com/#web3-hostname-delete-ipfs-universal-path-gateway-content-list-entry
+func (s *Web3Service) DeleteContentListEntry(ctx context.Context, zoneID, hostnameID, entryID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if hostnameID == "" {
+		return fmt.Errorf(errMissingResourceID, "web3 hostname")
+	}
+
+	if entryID == "" {
+		return fmt.Errorf(errMissingResourceID, "content list entry")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/web3/hostnames/"+hostnameID+"/ipfs_universal_path/content_list"+"/entries/"+entryID, nil)
+	return err
+}
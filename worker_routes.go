@@ -0,0 +1,199 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type WorkerRoutesService service
+
+// WorkerRoute is used to map traffic matching a URL pattern to a worker.
+type WorkerRoute struct {
+	ID      string `json:"id,omitempty"`
+	Pattern string `json:"pattern"`
+	Script  string `json:"script,omitempty"`
+}
+
+// WorkerRouteResponse embeds Response struct and a single WorkerRoute.
+type WorkerRouteResponse struct {
+	Response
+	Result WorkerRoute `json:"result"`
+}
+
+// WorkerRoutesResponse embeds Response struct and slice of WorkerRoutes.
+type WorkerRoutesResponse struct {
+	Response
+	Result []WorkerRoute `json:"result"`
+}
+
+// ValidateWorkerRoutePattern checks a route pattern against the rules the API
+// enforces so mistakes are caught before a request is made. Patterns take the
+// form "[scheme://]host/path" where a wildcard may only appear at the start of
+// the host and the end of the path, and query strings and ports are not
+// supported.
+func ValidateWorkerRoutePattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("worker route pattern must not be empty")
+	}
+
+	p := pattern
+	if i := strings.Index(p, "://"); i >= 0 {
+		if scheme := p[:i]; scheme != "http" && scheme != "https" && scheme != "*" {
+			return fmt.Errorf("worker route pattern %q has an unsupported scheme %q", pattern, scheme)
+		}
+		p = p[i+3:]
+	}
+
+	if strings.ContainsAny(p, "?#") {
+		return fmt.Errorf("worker route pattern %q must not contain a query string or fragment", pattern)
+	}
+
+	slash := strings.Index(p, "/")
+	if slash < 0 {
+		return fmt.Errorf("worker route pattern %q must contain a path, e.g. %q", pattern, p+"/*")
+	}
+
+	host, path := p[:slash], p[slash:]
+	if host == "" || host == "*" {
+		return fmt.Errorf("worker route pattern %q must contain a hostname", pattern)
+	}
+
+	if strings.Contains(host, ":") {
+		return fmt.Errorf("worker route pattern %q must not contain a port", pattern)
+	}
+
+	if strings.Contains(strings.TrimPrefix(host, "*"), "*") {
+		return fmt.Errorf("worker route pattern %q may only use a wildcard at the start of the hostname", pattern)
+	}
+
+	if strings.Contains(strings.TrimSuffix(path, "*"), "*") {
+		return fmt.Errorf("worker route pattern %q may only use a wildcard at the end of the path", pattern)
+	}
+
+	return nil
+}
+
+// List returns all worker routes for a zone.
+//
+// API reference: https://api.cloudflare.com/#worker-routes-list-routes
+func (s *WorkerRoutesService) List(ctx context.Context, zoneID string) ([]WorkerRoute, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []WorkerRoute{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/workers/routes", nil)
+	if err != nil {
+		return []WorkerRoute{}, err
+	}
+
+	var r WorkerRoutesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WorkerRoute{}, fmt.Errorf("failed to unmarshal worker route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single worker route.
+//
+// API reference: https://api.cloudflare.com/#worker-routes-get-route
+func (s *WorkerRoutesService) Get(ctx context.Context, zoneID, routeID string) (WorkerRoute, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WorkerRoute{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if routeID == "" {
+		return WorkerRoute{}, fmt.Errorf(errMissingResourceID, "worker route")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/workers/routes/"+routeID, nil)
+	if err != nil {
+		return WorkerRoute{}, err
+	}
+
+	var r WorkerRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkerRoute{}, fmt.Errorf("failed to unmarshal worker route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create maps a URL pattern to a worker script. Leaving the script empty
+// disables workers for requests matching the pattern.
+//
+// API reference: https://api.cloudflare.com/#worker-routes-create-route
+func (s *WorkerRoutesService) Create(ctx context.Context, zoneID string, route WorkerRoute) (WorkerRoute, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WorkerRoute{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if err := ValidateWorkerRoutePattern(route.Pattern); err != nil {
+		return WorkerRoute{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/workers/routes", route)
+	if err != nil {
+		return WorkerRoute{}, err
+	}
+
+	var r WorkerRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkerRoute{}, fmt.Errorf("failed to unmarshal worker route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the pattern or script of an existing worker route.
+//
+// API reference: https://api.cloudflare.com/#worker-routes-update-route
+func (s *WorkerRoutesService) Update(ctx context.Context, zoneID, routeID string, route WorkerRoute) (WorkerRoute, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return WorkerRoute{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if routeID == "" {
+		return WorkerRoute{}, fmt.Errorf(errMissingResourceID, "worker route")
+	}
+
+	if err := ValidateWorkerRoutePattern(route.Pattern); err != nil {
+		return WorkerRoute{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/workers/routes/"+routeID, route)
+	if err != nil {
+		return WorkerRoute{}, err
+	}
+
+	var r WorkerRouteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkerRoute{}, fmt.Errorf("failed to unmarshal worker route JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a worker route.
+//
+// API reference: https://api.cloudflare.com/#worker-routes-delete-route
+func (s *WorkerRoutesService) Delete(ctx context.Context, zoneID, routeID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if routeID == "" {
+		return fmt.Errorf(errMissingResourceID, "worker route")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/workers/routes/"+routeID, nil)
+	return err
+}
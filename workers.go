@@ -0,0 +1,388 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type WorkersService service
+
+// WorkerCronTrigger holds a cron expression used to invoke a scheduled
+// worker.
+type WorkerCronTrigger struct {
+	Cron       string     `json:"cron"`
+	CreatedOn  *time.Time `json:"created_on,omitempty"`
+	ModifiedOn *time.Time `json:"modified_on,omitempty"`
+}
+
+// WorkerCronTriggerSchedules contains the schedules of a worker script.
+type WorkerCronTriggerSchedules struct {
+	Schedules []WorkerCronTrigger `json:"schedules"`
+}
+
+// WorkerCronTriggerResponse represents the response from the schedules
+// endpoint.
+type WorkerCronTriggerResponse struct {
+	Response
+	Result WorkerCronTriggerSchedules `json:"result"`
+}
+
+// GetSchedules returns the cron triggers of a worker script.
+//
+// API reference: https://api.cloudflare.com/#worker-cron-trigger-get-cron-triggers
+func (s *WorkersService) GetSchedules(ctx context.Context, accountID, scriptName string) ([]WorkerCronTrigger, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []WorkerCronTrigger{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return []WorkerCronTrigger{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/schedules", nil)
+	if err != nil {
+		return []WorkerCronTrigger{}, err
+	}
+
+	var r WorkerCronTriggerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WorkerCronTrigger{}, fmt.Errorf("failed to unmarshal worker cron trigger JSON data: %w", err)
+	}
+
+	return r.Result.Schedules, nil
+}
+
+// UpdateSchedules replaces the cron triggers of a worker script. Passing an
+// empty slice removes all triggers.
+//
+// API reference: https://api.cloudflare.com/#worker-cron-trigger-update-cron-triggers
+func (s *WorkersService) UpdateSchedules(ctx context.Context, accountID, scriptName string, schedules []WorkerCronTrigger) ([]WorkerCronTrigger, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []WorkerCronTrigger{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return []WorkerCronTrigger{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	// Only the cron expression is accepted by the API.
+	triggers := make([]WorkerCronTrigger, 0, len(schedules))
+	for _, schedule := range schedules {
+		triggers = append(triggers, WorkerCronTrigger{Cron: schedule.Cron})
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/schedules", triggers)
+	if err != nil {
+		return []WorkerCronTrigger{}, err
+	}
+
+	var r WorkerCronTriggerResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WorkerCronTrigger{}, fmt.Errorf("failed to unmarshal worker cron trigger JSON data: %w", err)
+	}
+
+	return r.Result.Schedules, nil
+}
+
+// WorkerSecretType is the kind of secret binding.
+type WorkerSecretType string
+
+const WorkerSecretTypeText WorkerSecretType = "secret_text"
+
+// WorkerSecret contains the name and type of a secret bound to a worker. The
+// value of a secret can never be read back once written.
+type WorkerSecret struct {
+	Name string           `json:"name"`
+	Type WorkerSecretType `json:"type"`
+}
+
+// WorkerSecretParams contains the name and value of a secret to bind to a
+// worker.
+type WorkerSecretParams struct {
+	Name string           `json:"name"`
+	Text string           `json:"text"`
+	Type WorkerSecretType `json:"type"`
+}
+
+// WorkerSecretResponse represents the response from the secrets endpoint
+// containing a single secret.
+type WorkerSecretResponse struct {
+	Response
+	Result WorkerSecret `json:"result"`
+}
+
+// WorkerSecretsResponse represents the response from the secrets endpoint
+// containing multiple secrets.
+type WorkerSecretsResponse struct {
+	Response
+	Result []WorkerSecret `json:"result"`
+}
+
+// workerSecretsPath returns the secrets path of a script, or of an
+// environment of a script when environment is set.
+func workerSecretsPath(accountID, scriptName, environment string) string {
+	if environment != "" {
+		return "/accounts/" + accountID + "/workers/services/" + scriptName + "/environments/" + environment + "/secrets"
+	}
+	return "/accounts/" + accountID + "/workers/scripts/" + scriptName + "/secrets"
+}
+
+// ListSecrets returns the secrets bound to a worker script.
+//
+// API reference: https://api.cloudflare.com/#worker-script-list-script-secrets
+func (s *WorkersService) ListSecrets(ctx context.Context, accountID, scriptName string) ([]WorkerSecret, error) {
+	return s.listSecrets(ctx, accountID, scriptName, "")
+}
+
+// ListEnvironmentSecrets returns the secrets bound to an environment of a
+// worker script.
+//
+// API reference: https://api.cloudflare.com/#worker-environment-list-environment-secrets
+func (s *WorkersService) ListEnvironmentSecrets(ctx context.Context, accountID, scriptName, environment string) ([]WorkerSecret, error) {
+	if environment == "" {
+		return []WorkerSecret{}, errors.New("environment name must be provided")
+	}
+	return s.listSecrets(ctx, accountID, scriptName, environment)
+}
+
+// PutSecret creates or replaces a secret bound to a worker script. The script
+// is redeployed with the new value.
+//
+// API reference: https://api.cloudflare.com/#worker-script-put-secret
+func (s *WorkersService) PutSecret(ctx context.Context, accountID, scriptName string, params WorkerSecretParams) (WorkerSecret, error) {
+	return s.putSecret(ctx, accountID, scriptName, "", params)
+}
+
+// PutEnvironmentSecret creates or replaces a secret bound to an environment of
+// a worker script.
+//
+// API reference: https://api.cloudflare.com/#worker-environment-put-environment-secret
+func (s *WorkersService) PutEnvironmentSecret(ctx context.Context, accountID, scriptName, environment string, params WorkerSecretParams) (WorkerSecret, error) {
+	if environment == "" {
+		return WorkerSecret{}, errors.New("environment name must be provided")
+	}
+	return s.putSecret(ctx, accountID, scriptName, environment, params)
+}
+
+// DeleteSecret removes a secret from a worker script.
+//
+// API reference: https://api.cloudflare.com/#worker-script-delete-secret
+func (s *WorkersService) DeleteSecret(ctx context.Context, accountID, scriptName, secretName string) error {
+	return s.deleteSecret(ctx, accountID, scriptName, "", secretName)
+}
+
+// DeleteEnvironmentSecret removes a secret from an environment of a worker
+// script.
+//
+// API reference: https://api.cloudflare.com/#worker-environment-delete-environment-secret
+func (s *WorkersService) DeleteEnvironmentSecret(ctx context.Context, accountID, scriptName, environment, secretName string) error {
+	if environment == "" {
+		return errors.New("environment name must be provided")
+	}
+	return s.deleteSecret(ctx, accountID, scriptName, environment, secretName)
+}
+
+func (s *WorkersService) listSecrets(ctx context.Context, accountID, scriptName, environment string) ([]WorkerSecret, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return []WorkerSecret{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return []WorkerSecret{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, workerSecretsPath(accountID, scriptName, environment), nil)
+	if err != nil {
+		return []WorkerSecret{}, err
+	}
+
+	var r WorkerSecretsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []WorkerSecret{}, fmt.Errorf("failed to unmarshal worker secret JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+func (s *WorkersService) putSecret(ctx context.Context, accountID, scriptName, environment string, params WorkerSecretParams) (WorkerSecret, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return WorkerSecret{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return WorkerSecret{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	if params.Name == "" {
+		return WorkerSecret{}, errors.New("secret name must be provided")
+	}
+
+	if params.Type == "" {
+		params.Type = WorkerSecretTypeText
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, workerSecretsPath(accountID, scriptName, environment), params)
+	if err != nil {
+		return WorkerSecret{}, err
+	}
+
+	var r WorkerSecretResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkerSecret{}, fmt.Errorf("failed to unmarshal worker secret JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+func (s *WorkersService) deleteSecret(ctx context.Context, accountID, scriptName, environment, secretName string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	if secretName == "" {
+		return errors.New("secret name must be provided")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, workerSecretsPath(accountID, scriptName, environment)+"/"+secretName, nil)
+	return err
+}
+
+// WorkersSubdomain is the workers.dev subdomain of an account.
+type WorkersSubdomain struct {
+	Name string `json:"subdomain"`
+}
+
+// WorkersSubdomainResponse represents the response from the account
+// subdomain endpoint.
+type WorkersSubdomainResponse struct {
+	Response
+	Result WorkersSubdomain `json:"result"`
+}
+
+// WorkerScriptSubdomain reports whether a script is published on the
+// account's workers.dev subdomain.
+type WorkerScriptSubdomain struct {
+	Enabled bool `json:"enabled"`
+}
+
+// WorkerScriptSubdomainResponse represents the response from the script
+// subdomain endpoint.
+type WorkerScriptSubdomainResponse struct {
+	Response
+	Result WorkerScriptSubdomain `json:"result"`
+}
+
+// GetSubdomain returns the workers.dev subdomain of an account.
+//
+// API reference: https://api.cloudflare.com/#worker-subdomain-get-subdomain
+func (s *WorkersService) GetSubdomain(ctx context.Context, accountID string) (WorkersSubdomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return WorkersSubdomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/workers/subdomain", nil)
+	if err != nil {
+		return WorkersSubdomain{}, err
+	}
+
+	var r WorkersSubdomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkersSubdomain{}, fmt.Errorf("failed to unmarshal workers subdomain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSubdomain creates or changes the workers.dev subdomain of an account.
+// Changing the subdomain changes the URL of every script published to it.
+//
+// API reference: https://api.cloudflare.com/#worker-subdomain-create-subdomain
+func (s *WorkersService) UpdateSubdomain(ctx context.Context, accountID, name string) (WorkersSubdomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return WorkersSubdomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if name == "" {
+		return WorkersSubdomain{}, errors.New("subdomain name must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/workers/subdomain", WorkersSubdomain{Name: name})
+	if err != nil {
+		return WorkersSubdomain{}, err
+	}
+
+	var r WorkersSubdomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkersSubdomain{}, fmt.Errorf("failed to unmarshal workers subdomain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetScriptSubdomain reports whether a worker script is published on the
+// account's workers.dev subdomain.
+//
+// API reference: https://api.cloudflare.com/#worker-script-get-subdomain
+func (s *WorkersService) GetScriptSubdomain(ctx context.Context, accountID, scriptName string) (WorkerScriptSubdomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return WorkerScriptSubdomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return WorkerScriptSubdomain{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/subdomain", nil)
+	if err != nil {
+		return WorkerScriptSubdomain{}, err
+	}
+
+	var r WorkerScriptSubdomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkerScriptSubdomain{}, fmt.Errorf("failed to unmarshal worker script subdomain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateScriptSubdomain enables or disables the workers.dev route of a worker
+// script.
+//
+// API reference: https://api.cloudflare.com/#worker-script-post-subdomain
+func (s *WorkersService) UpdateScriptSubdomain(ctx context.Context, accountID, scriptName string, enabled bool) (WorkerScriptSubdomain, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return WorkerScriptSubdomain{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return WorkerScriptSubdomain{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/subdomain", WorkerScriptSubdomain{Enabled: enabled})
+	if err != nil {
+		return WorkerScriptSubdomain{}, err
+	}
+
+	var r WorkerScriptSubdomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkerScriptSubdomain{}, fmt.Errorf("failed to unmarshal worker script subdomain JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
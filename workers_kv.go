@@ -0,0 +1,447 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type WorkersKVService service
+
+// workersKVBulkLimit is the maximum number of pairs or keys the bulk
+// endpoints accept in a single request.
+const workersKVBulkLimit = 10000
+
+// WorkersKVNamespace contains the unique identifier and title of a storage
+// namespace.
+type WorkersKVNamespace struct {
+	ID                  string `json:"id"`
+	Title               string `json:"title"`
+	SupportsURLEncoding *bool  `json:"supports_url_encoding,omitempty"`
+}
+
+// WorkersKVNamespaceResponse is the response received when creating or
+// updating a storage namespace.
+type WorkersKVNamespaceResponse struct {
+	Response
+	Result WorkersKVNamespace `json:"result"`
+}
+
+// WorkersKVNamespacesResponse contains a slice of storage namespaces
+// associated with an account.
+type WorkersKVNamespacesResponse struct {
+	Response
+	Result     []WorkersKVNamespace `json:"result"`
+	ResultInfo ResultInfo           `json:"result_info"`
+}
+
+// WorkersKVNamespaceListParams contains the options available when listing
+// storage namespaces.
+type WorkersKVNamespaceListParams struct {
+	Order string `url:"order,omitempty"`
+
+	PaginationParams
+}
+
+// WorkersKVKey is a key stored in a namespace along with its expiration and
+// metadata.
+type WorkersKVKey struct {
+	Name       string      `json:"name"`
+	Expiration int64       `json:"expiration,omitempty"`
+	Metadata   interface{} `json:"metadata,omitempty"`
+}
+
+// WorkersKVKeysResponse is the response received when listing keys in a
+// namespace.
+type WorkersKVKeysResponse struct {
+	Response
+	Result     []WorkersKVKey `json:"result"`
+	ResultInfo ResultInfo     `json:"result_info"`
+}
+
+// WorkersKVListKeysParams contains the options available when listing keys.
+// Limit must be between 10 and 1000 when set.
+type WorkersKVListKeysParams struct {
+	Prefix string `url:"prefix,omitempty"`
+	Limit  int    `url:"limit,omitempty"`
+
+	CursorPaginationParams
+}
+
+// WorkersKVMetadataResponse is the response received when fetching the
+// metadata of a key.
+type WorkersKVMetadataResponse struct {
+	Response
+	Result interface{} `json:"result"`
+}
+
+// WorkersKVPutParams contains the value of a key and optionally its metadata
+// and expiration. Expiration is an absolute unix timestamp whereas
+// ExpirationTTL is a number of seconds from now; only one should be set.
+type WorkersKVPutParams struct {
+	Value         []byte
+	Metadata      interface{}
+	Expiration    int64
+	ExpirationTTL int64
+}
+
+// WorkersKVPair is used in an array in the bulk write endpoint. Value must be
+// base64 encoded when Base64 is true.
+type WorkersKVPair struct {
+	Key           string      `json:"key"`
+	Value         string      `json:"value"`
+	Expiration    int64       `json:"expiration,omitempty"`
+	ExpirationTTL int64       `json:"expiration_ttl,omitempty"`
+	Metadata      interface{} `json:"metadata,omitempty"`
+	Base64        bool        `json:"base64,omitempty"`
+}
+
+// workersKVNamespaceParams is the payload used to create or rename a
+// namespace.
+type workersKVNamespaceParams struct {
+	Title string `json:"title"`
+}
+
+// ListNamespaces returns all storage namespaces of an account.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-list-namespaces
+func (s *WorkersKVService) ListNamespaces(ctx context.Context, accountID string, params WorkersKVNamespaceListParams) ([]WorkersKVNamespace, ResultInfo, error) {
+	var namespaces []WorkersKVNamespace
+	var info ResultInfo
+	err := s.ForEachNamespacesPage(ctx, accountID, params, func(page []WorkersKVNamespace, pageInfo ResultInfo) bool {
+		namespaces = append(namespaces, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []WorkersKVNamespace{}, ResultInfo{}, err
+	}
+
+	return namespaces, info, nil
+}
+
+// ForEachNamespacesPage is like ListNamespaces but passes each page of results
+// to fn as it is fetched instead of collecting them. Paging stops early when
+// fn returns false.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-list-namespaces
+func (s *WorkersKVService) ForEachNamespacesPage(ctx context.Context, accountID string, params WorkersKVNamespaceListParams, fn func(items []WorkersKVNamespace, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	return s.client.listPages(ctx, "/accounts/"+accountID+"/storage/kv/namespaces", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r WorkersKVNamespacesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal workers kv namespace JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// CreateNamespace creates a new storage namespace.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-create-a-namespace
+func (s *WorkersKVService) CreateNamespace(ctx context.Context, accountID, title string) (WorkersKVNamespace, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return WorkersKVNamespace{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if title == "" {
+		return WorkersKVNamespace{}, errors.New("title is required to create a namespace")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/storage/kv/namespaces", workersKVNamespaceParams{Title: title})
+	if err != nil {
+		return WorkersKVNamespace{}, err
+	}
+
+	var r WorkersKVNamespaceResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkersKVNamespace{}, fmt.Errorf("failed to unmarshal workers kv namespace JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// RenameNamespace changes the title of a storage namespace.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-rename-a-namespace
+func (s *WorkersKVService) RenameNamespace(ctx context.Context, accountID, namespaceID, title string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodPut, "/accounts/"+accountID+"/storage/kv/namespaces/"+namespaceID, workersKVNamespaceParams{Title: title})
+	return err
+}
+
+// DeleteNamespace removes a storage namespace and every key within it.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-remove-a-namespace
+func (s *WorkersKVService) DeleteNamespace(ctx context.Context, accountID, namespaceID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/storage/kv/namespaces/"+namespaceID, nil)
+	return err
+}
+
+// ListKeys returns the keys stored in a namespace, ordered lexicographically.
+//
+// Unless a cursor is provided, every key is fetched by following the cursors
+// returned by the API, Limit keys at a time. Otherwise a single page is
+// fetched and the returned ResultInfo.Cursor can be passed back in
+// `WorkersKVListKeysParams` to retrieve the next page; it is empty once all
+// keys have been listed.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-list-a-namespace-s-keys
+func (s *WorkersKVService) ListKeys(ctx context.Context, accountID, namespaceID string, params WorkersKVListKeysParams) ([]WorkersKVKey, ResultInfo, error) {
+	var keys []WorkersKVKey
+	var info ResultInfo
+	err := s.ForEachKeysPage(ctx, accountID, namespaceID, params, func(page []WorkersKVKey, pageInfo ResultInfo) bool {
+		keys = append(keys, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []WorkersKVKey{}, ResultInfo{}, err
+	}
+
+	return keys, info, nil
+}
+
+// ForEachKeysPage is like ListKeys but passes each page of results to fn as it
+// is fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-list-a-namespace-s-keys
+func (s *WorkersKVService) ForEachKeysPage(ctx context.Context, accountID, namespaceID string, params WorkersKVListKeysParams, fn func(items []WorkersKVKey, info ResultInfo) bool) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	return s.client.listCursorPages(ctx, "/accounts/"+accountID+"/storage/kv/namespaces/"+namespaceID+"/keys", "cursor", &params, &params.CursorPaginationParams, func(res []byte) (ResultInfo, error) {
+		var r WorkersKVKeysResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal workers kv key JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get returns the value associated with key in a namespace.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-read-key-value-pair
+func (s *WorkersKVService) Get(ctx context.Context, accountID, namespaceID, key string) ([]byte, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return nil, fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	if key == "" {
+		return nil, errors.New("key name must be provided")
+	}
+
+	return s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/storage/kv/namespaces/"+namespaceID+"/values/"+url.PathEscape(key), nil)
+}
+
+// GetMetadata returns the metadata associated with key in a namespace, or nil
+// if the key has none.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-read-the-metadata-for-a-key
+func (s *WorkersKVService) GetMetadata(ctx context.Context, accountID, namespaceID, key string) (interface{}, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return nil, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return nil, fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	if key == "" {
+		return nil, errors.New("key name must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/accounts/"+accountID+"/storage/kv/namespaces/"+namespaceID+"/metadata/"+url.PathEscape(key), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r WorkersKVMetadataResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal workers kv metadata JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Put writes a value, and optionally metadata and an expiration, to key in a
+// namespace, replacing any existing value.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-write-key-value-pair-with-metadata
+func (s *WorkersKVService) Put(ctx context.Context, accountID, namespaceID, key string, params WorkersKVPutParams) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	if key == "" {
+		return errors.New("key name must be provided")
+	}
+
+	v := url.Values{}
+	if params.Expiration > 0 {
+		v.Set("expiration", strconv.FormatInt(params.Expiration, 10))
+	}
+	if params.ExpirationTTL > 0 {
+		v.Set("expiration_ttl", strconv.FormatInt(params.ExpirationTTL, 10))
+	}
+
+	uri := "/accounts/" + accountID + "/storage/kv/namespaces/" + namespaceID + "/values/" + url.PathEscape(key)
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	// Values without metadata are sent as the raw request body whereas
+	// metadata requires a multipart form with both parts.
+	if params.Metadata == nil {
+		_, err := s.client.CallWithHeaders(ctx, http.MethodPut, uri, params.Value, http.Header{"Content-Type": []string{"application/octet-stream"}})
+		return err
+	}
+
+	metadata, err := json.Marshal(params.Metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal workers kv metadata: %w", err)
+	}
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	if err := w.WriteField("metadata", string(metadata)); err != nil {
+		return err
+	}
+	part, err := w.CreateFormField("value")
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(params.Value); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	_, err = s.client.CallWithHeaders(ctx, http.MethodPut, uri, body, http.Header{"Content-Type": []string{w.FormDataContentType()}})
+	return err
+}
+
+// Delete removes key from a namespace.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-delete-key-value-pair
+func (s *WorkersKVService) Delete(ctx context.Context, accountID, namespaceID, key string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	if key == "" {
+		return errors.New("key name must be provided")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/storage/kv/namespaces/"+namespaceID+"/values/"+url.PathEscape(key), nil)
+	return err
+}
+
+// BulkWrite writes multiple key-value pairs to a namespace. Pairs are split
+// into requests of at most 10,000 pairs, the limit of the API, and written in
+// order. If a request fails, the pairs of earlier requests will already have
+// been written.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-write-multiple-key-value-pairs
+func (s *WorkersKVService) BulkWrite(ctx context.Context, accountID, namespaceID string, pairs []WorkersKVPair) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	uri := "/accounts/" + accountID + "/storage/kv/namespaces/" + namespaceID + "/bulk"
+	for start := 0; start < len(pairs); start += workersKVBulkLimit {
+		end := start + workersKVBulkLimit
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+
+		if _, err := s.client.Call(ctx, http.MethodPut, uri, pairs[start:end]); err != nil {
+			return fmt.Errorf("failed to write pairs %d to %d: %w", start, end-1, err)
+		}
+	}
+
+	return nil
+}
+
+// BulkDelete removes multiple keys from a namespace. Keys are split into
+// requests of at most 10,000 keys, the limit of the API.
+//
+// API reference: https://api.cloudflare.com/#workers-kv-namespace-delete-multiple-key-value-pairs
+func (s *WorkersKVService) BulkDelete(ctx context.Context, accountID, namespaceID string, keys []string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if namespaceID == "" {
+		return fmt.Errorf(errMissingResourceID, "namespace")
+	}
+
+	uri := "/accounts/" + accountID + "/storage/kv/namespaces/" + namespaceID + "/bulk"
+	for start := 0; start < len(keys); start += workersKVBulkLimit {
+		end := start + workersKVBulkLimit
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		if _, err := s.client.Call(ctx, http.MethodDelete, uri, keys[start:end]); err != nil {
+			return fmt.Errorf("failed to delete keys %d to %d: %w", start, end-1, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// kvBulkHandler records the method and number of items of every bulk request,
+// failing the request with index failAt.
+func kvBulkHandler(t *testing.T, requests *[]string, failAt int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var items []json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&items); err != nil {
+			t.Errorf("failed to decode request body: %s", err)
+		}
+		*requests = append(*requests, r.Method+" "+strconv.Itoa(len(items)))
+
+		if len(*requests)-1 == failAt {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10001, "message": "bulk request failed"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "result": null}`)
+	}
+}
+
+func TestWorkersKVBulkWrite_Chunks(t *testing.T) {
+	pairs := make([]WorkersKVPair, workersKVBulkLimit+1)
+	for i := range pairs {
+		pairs[i] = WorkersKVPair{Key: "key-" + strconv.Itoa(i), Value: "value"}
+	}
+
+	t.Run("success", func(t *testing.T) {
+		client, mux := setup(t)
+
+		var requests []string
+		mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/storage/kv/namespaces/ns/bulk", kvBulkHandler(t, &requests, -1))
+
+		if err := client.WorkersKV.BulkWrite(context.Background(), testAccountID, "ns", pairs); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if want := []string{"PUT 10000", "PUT 1"}; !reflect.DeepEqual(requests, want) {
+			t.Errorf("got requests %v, want %v", requests, want)
+		}
+	})
+
+	t.Run("second chunk fails", func(t *testing.T) {
+		client, mux := setup(t)
+
+		var requests []string
+		mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/storage/kv/namespaces/ns/bulk", kvBulkHandler(t, &requests, 1))
+
+		err := client.WorkersKV.BulkWrite(context.Background(), testAccountID, "ns", pairs)
+		if err == nil || !strings.Contains(err.Error(), "failed to write pairs 10000 to 10000") {
+			t.Errorf("got error %v, want the failed chunk", err)
+		}
+		if len(requests) != 2 {
+			t.Errorf("got %d requests, want 2", len(requests))
+		}
+	})
+}
+
+func TestWorkersKVBulkDelete_Chunks(t *testing.T) {
+	keys := make([]string, workersKVBulkLimit+1)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		client, mux := setup(t)
+
+		var requests []string
+		mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/storage/kv/namespaces/ns/bulk", kvBulkHandler(t, &requests, -1))
+
+		if err := client.WorkersKV.BulkDelete(context.Background(), testAccountID, "ns", keys); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if want := []string{"DELETE 10000", "DELETE 1"}; !reflect.DeepEqual(requests, want) {
+			t.Errorf("got requests %v, want %v", requests, want)
+		}
+	})
+
+	t.Run("second chunk fails", func(t *testing.T) {
+		client, mux := setup(t)
+
+		var requests []string
+		mux.HandleFunc("/client/v4/accounts/"+testAccountID+"/storage/kv/namespaces/ns/bulk", kvBulkHandler(t, &requests, 1))
+
+		err := client.WorkersKV.BulkDelete(context.Background(), testAccountID, "ns", keys)
+		if err == nil || !strings.Contains(err.Error(), "failed to delete keys 10000 to 10000") {
+			t.Errorf("got error %v, want the failed chunk", err)
+		}
+		if len(requests) != 2 {
+			t.Errorf("got %d requests, want 2", len(requests))
+		}
+	})
+}
@@ -0,0 +1,231 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// workersTailProtocol is the WebSocket subprotocol spoken by tail sessions.
+const workersTailProtocol = "trace-v1"
+
+// WorkersTail is a tail session created for a worker script.
+type WorkersTail struct {
+	ID        string    `json:"id"`
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// WorkersTailResponse represents the response from the tails endpoint.
+type WorkersTailResponse struct {
+	Response
+	Result WorkersTail `json:"result"`
+}
+
+// WorkersTailFilter narrows the events delivered by a tail session. Only one
+// field should be set per filter.
+type WorkersTailFilter struct {
+	Outcome      []string `json:"outcome,omitempty"`
+	Method       []string `json:"method,omitempty"`
+	SamplingRate float64  `json:"sampling_rate,omitempty"`
+	ClientIP     []string `json:"client_ip,omitempty"`
+	Query        string   `json:"query,omitempty"`
+	HeaderKey    string   `json:"key,omitempty"`
+	HeaderValue  string   `json:"value,omitempty"`
+}
+
+// WorkersTailParams contains the options used when tailing a worker.
+type WorkersTailParams struct {
+	Filters []WorkersTailFilter `json:"filters"`
+	Debug   bool                `json:"debug"`
+}
+
+// WorkersTailEvent is a single invocation of a worker reported by a tail
+// session. Event holds the trigger specific details (request, scheduled, etc)
+// and is left raw as its shape depends on how the worker was invoked.
+type WorkersTailEvent struct {
+	Outcome        string                 `json:"outcome"`
+	ScriptName     string                 `json:"scriptName"`
+	Exceptions     []WorkersTailException `json:"exceptions"`
+	Logs           []WorkersTailLog       `json:"logs"`
+	EventTimestamp int64                  `json:"eventTimestamp"`
+	Event          json.RawMessage        `json:"event"`
+}
+
+// WorkersTailException is an uncaught exception thrown by a worker.
+type WorkersTailException struct {
+	Name      string `json:"name"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// WorkersTailLog is a console message logged by a worker.
+type WorkersTailLog struct {
+	Message   []interface{} `json:"message"`
+	Level     string        `json:"level"`
+	Timestamp int64         `json:"timestamp"`
+}
+
+// WorkersTailSession delivers the events of a running tail.
+type WorkersTailSession struct {
+	events chan WorkersTailEvent
+
+	mu  sync.Mutex
+	err error
+}
+
+// Events returns the channel events are delivered on. It is closed once the
+// session ends, after which Err reports why.
+func (t *WorkersTailSession) Events() <-chan WorkersTailEvent {
+	return t.events
+}
+
+// Err returns the error that ended the session, or nil if it was ended by
+// cancelling the context passed to Tail.
+func (t *WorkersTailSession) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+// Tail starts streaming the invocations of a worker script. Events are
+// delivered on the returned session's channel until ctx is cancelled, at which
+// point the tail is removed. If the WebSocket connection drops, a new tail is
+// created and connected to following the client's RetryPolicy.
+//
+// The channel is unbuffered beyond a small window so callers must keep reading
+// from it; events are not dropped while the consumer catches up. Messages
+// that cannot be decoded are reported to the client's Logger and skipped.
+//
+// API reference: https://api.cloudflare.com/#worker-tail-logs-start-tail
+func (s *WorkersService) Tail(ctx context.Context, accountID, scriptName string, params WorkersTailParams) (*WorkersTailSession, error) {
+	// Create the first tail up front so configuration errors are returned
+	// directly rather than through the session.
+	tail, err := s.CreateTail(ctx, accountID, scriptName)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Filters == nil {
+		params.Filters = []WorkersTailFilter{}
+	}
+
+	session := &WorkersTailSession{events: make(chan WorkersTailEvent, 16)}
+
+	// discard deletes the current tail, on a best-effort basis, so abandoned
+	// tails don't count against the script's tail limit. ctx may already be
+	// cancelled so the deletion gets its own deadline.
+	discard := func() {
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		_ = s.DeleteTail(cleanupCtx, accountID, scriptName, tail.ID)
+		cancel()
+		tail = WorkersTail{}
+	}
+
+	connect := func(ctx context.Context) (*websocket.Conn, error) {
+		if tail.ID == "" || time.Now().After(tail.ExpiresAt) {
+			t, err := s.CreateTail(ctx, accountID, scriptName)
+			if err != nil {
+				return nil, err
+			}
+			tail = t
+		}
+
+		conn, err := s.client.dialWebSocket(ctx, tail.URL, []string{workersTailProtocol})
+		if err != nil {
+			// Start from a fresh tail on the next attempt.
+			discard()
+			return nil, err
+		}
+
+		if err := conn.WriteJSON(params); err != nil {
+			conn.Close()
+			discard()
+			return nil, fmt.Errorf("failed to send tail filters: %w", err)
+		}
+
+		return conn, nil
+	}
+
+	handle := func(msg []byte) error {
+		// A malformed event is skipped rather than ending the session.
+		var event WorkersTailEvent
+		if err := json.Unmarshal(msg, &event); err != nil {
+			s.client.Logger.Printf("skipping workers tail event: failed to unmarshal JSON data: %s", err)
+			return nil
+		}
+
+		select {
+		case session.events <- event:
+		case <-ctx.Done():
+		}
+		return nil
+	}
+
+	go func() {
+		err := s.client.streamWebSocket(ctx, connect, handle)
+
+		if tail.ID != "" {
+			discard()
+		}
+
+		session.mu.Lock()
+		session.err = err
+		session.mu.Unlock()
+		close(session.events)
+	}()
+
+	return session, nil
+}
+
+// CreateTail starts a tail session for a worker script. Most callers should
+// use Tail which also connects to the session and decodes its events.
+//
+// API reference: https://api.cloudflare.com/#worker-tail-logs-start-tail
+func (s *WorkersService) CreateTail(ctx context.Context, accountID, scriptName string) (WorkersTail, error) {
+	if !isValidAccountIdentifier(accountID) {
+		return WorkersTail{}, fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return WorkersTail{}, fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/tails", nil)
+	if err != nil {
+		return WorkersTail{}, err
+	}
+
+	var r WorkersTailResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return WorkersTail{}, fmt.Errorf("failed to unmarshal workers tail JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteTail ends a tail session.
+//
+// API reference: https://api.cloudflare.com/#worker-tail-logs-delete-tail
+func (s *WorkersService) DeleteTail(ctx context.Context, accountID, scriptName, tailID string) error {
+	if !isValidAccountIdentifier(accountID) {
+		return fmt.Errorf(errInvalidAccountIdentifier, accountID)
+	}
+
+	if scriptName == "" {
+		return fmt.Errorf(errMissingResourceID, "script")
+	}
+
+	if tailID == "" {
+		return fmt.Errorf(errMissingResourceID, "tail")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/accounts/"+accountID+"/workers/scripts/"+scriptName+"/tails/"+tailID, nil)
+	return err
+}
@@ -0,0 +1,101 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestWorkersTail_ReconnectsAndSkipsMalformedEvents(t *testing.T) {
+	client, mux := setup(t)
+
+	var mu sync.Mutex
+	var calls []string
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, call)
+	}
+
+	tails, conns := 0, 0
+	tailsPath := "/client/v4/accounts/" + testAccountID + "/workers/scripts/my-script/tails"
+	mux.HandleFunc(tailsPath, func(w http.ResponseWriter, r *http.Request) {
+		tails++
+		record(fmt.Sprintf("create tail-%d", tails))
+		fmt.Fprintf(w, `{"success": true, "result": {"id": "tail-%d", "url": "ws://%s/tail/%d", "expires_at": %q}}`,
+			tails, r.Host, tails, time.Now().Add(time.Hour).Format(time.RFC3339))
+	})
+	mux.HandleFunc(tailsPath+"/", func(w http.ResponseWriter, r *http.Request) {
+		record("delete " + r.URL.Path[len(tailsPath)+1:])
+		fmt.Fprint(w, `{"success": true, "result": null}`)
+	})
+
+	upgrader := websocket.Upgrader{Subprotocols: []string{workersTailProtocol}}
+	mux.HandleFunc("/tail/", func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Errorf("failed to upgrade: %s", err)
+			return
+		}
+		defer conn.Close()
+
+		var params WorkersTailParams
+		if err := conn.ReadJSON(&params); err != nil {
+			t.Errorf("failed to read filters: %s", err)
+			return
+		}
+
+		mu.Lock()
+		conns++
+		first := conns == 1
+		mu.Unlock()
+
+		if first {
+			// The first connection sends a malformed event and an event,
+			// then drops.
+			_ = conn.WriteMessage(websocket.TextMessage, []byte("not json"))
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"outcome": "ok", "scriptName": "first"}`))
+			return
+		}
+
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"outcome": "ok", "scriptName": "second"}`))
+		_, _, _ = conn.ReadMessage()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	session, err := client.Workers.Tail(ctx, testAccountID, "my-script", WorkersTailParams{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var scripts []string
+	for event := range session.Events() {
+		scripts = append(scripts, event.ScriptName)
+		if len(scripts) == 2 {
+			cancel()
+		}
+	}
+
+	if err := session.Err(); err != nil {
+		t.Errorf("unexpected session error: %s", err)
+	}
+	if want := []string{"first", "second"}; !reflect.DeepEqual(scripts, want) {
+		t.Errorf("got events from %v, want %v", scripts, want)
+	}
+
+	// The dropped connection's tail is reused for the reconnect as it hasn't
+	// expired, and the tail is removed once the session ends.
+	mu.Lock()
+	defer mu.Unlock()
+	if want := []string{"create tail-1", "delete tail-1"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("got calls %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,103 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ZoneHold prevents a zone's hostname, and optionally its subdomains, from
+// being added as a zone by another account. HoldAfter is when a hold that is
+// being released takes effect again.
+type ZoneHold struct {
+	Hold              bool       `json:"hold"`
+	IncludeSubdomains bool       `json:"include_subdomains,omitempty"`
+	HoldAfter         *time.Time `json:"hold_after,omitempty"`
+}
+
+// ZoneHoldResponse represents the response from the zone hold endpoint.
+type ZoneHoldResponse struct {
+	Response
+	Result ZoneHold `json:"result"`
+}
+
+// zoneHoldCreateParams contains the query parameters of a new zone hold.
+type zoneHoldCreateParams struct {
+	IncludeSubdomains bool `url:"include_subdomains,omitempty"`
+}
+
+// zoneHoldDeleteParams contains the query parameters of a zone hold
+// release.
+type zoneHoldDeleteParams struct {
+	HoldAfter *time.Time `url:"hold_after,omitempty"`
+}
+
+// GetHold returns the hold of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-holds-get-zone-hold
+func (s *ZonesService) GetHold(ctx context.Context, zoneID string) (ZoneHold, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneHold{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/hold", nil)
+	if err != nil {
+		return ZoneHold{}, err
+	}
+
+	var r ZoneHoldResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneHold{}, fmt.Errorf("failed to unmarshal zone hold JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateHold places a hold on a zone. When includeSubdomains is true,
+// subdomains of the zone are also prevented from being added as zones.
+//
+// API reference: https://api.cloudflare.com/#zone-holds-create-zone-hold
+func (s *ZonesService) CreateHold(ctx context.Context, zoneID string, includeSubdomains bool) (ZoneHold, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneHold{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, buildURI("/zones/"+zoneID+"/hold", zoneHoldCreateParams{IncludeSubdomains: includeSubdomains}), nil)
+	if err != nil {
+		return ZoneHold{}, err
+	}
+
+	var r ZoneHoldResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneHold{}, fmt.Errorf("failed to unmarshal zone hold JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// DeleteHold releases the hold of a zone. When holdAfter is set the hold is
+// only released until that time, after which it is automatically restored.
+//
+// API reference: https://api.cloudflare.com/#zone-holds-remove-zone-hold
+func (s *ZonesService) DeleteHold(ctx context.Context, zoneID string, holdAfter *time.Time) (ZoneHold, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneHold{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodDelete, buildURI("/zones/"+zoneID+"/hold", zoneHoldDeleteParams{HoldAfter: holdAfter}), nil)
+	if err != nil {
+		return ZoneHold{}, err
+	}
+
+	var r ZoneHoldResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneHold{}, fmt.Errorf("failed to unmarshal zone hold JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -0,0 +1,206 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type ZoneLockdownsService service
+
+// ZoneLockdown represents a Zone Lockdown rule. A rule only permits access to
+// the provided URL patterns from the provided IP addresses or ranges.
+type ZoneLockdown struct {
+	ID             string               `json:"id"`
+	Description    string               `json:"description"`
+	URLs           []string             `json:"urls"`
+	Configurations []ZoneLockdownConfig `json:"configurations"`
+	Paused         bool                 `json:"paused"`
+	Priority       int                  `json:"priority,omitempty"`
+	CreatedOn      *time.Time           `json:"created_on,omitempty"`
+	ModifiedOn     *time.Time           `json:"modified_on,omitempty"`
+}
+
+// ZoneLockdownConfig represents a Zone Lockdown config. Target is either "ip"
+// or "ip_range".
+type ZoneLockdownConfig struct {
+	Target string `json:"target"`
+	Value  string `json:"value"`
+}
+
+// ZoneLockdownParams contains the fields used to create or update a Zone
+// Lockdown rule.
+type ZoneLockdownParams struct {
+	Description    string               `json:"description"`
+	URLs           []string             `json:"urls"`
+	Configurations []ZoneLockdownConfig `json:"configurations"`
+	Paused         bool                 `json:"paused"`
+	Priority       int                  `json:"priority,omitempty"`
+}
+
+// ZoneLockdownResponse represents a response from the Zone Lockdown endpoint.
+type ZoneLockdownResponse struct {
+	Response
+	Result ZoneLockdown `json:"result"`
+}
+
+// ZoneLockdownsResponse represents a response from the List Zone Lockdown
+// endpoint.
+type ZoneLockdownsResponse struct {
+	Response
+	Result     []ZoneLockdown `json:"result"`
+	ResultInfo ResultInfo     `json:"result_info"`
+}
+
+// ZoneLockdownListParams contains the filters available when listing Zone
+// Lockdown rules.
+type ZoneLockdownListParams struct {
+	Description string `url:"description,omitempty"`
+	URISearch   string `url:"uri_search,omitempty"`
+	IPSearch    string `url:"ip_search,omitempty"`
+	Priority    int    `url:"priority,omitempty"`
+
+	PaginationParams
+}
+
+// List returns all Zone Lockdown rules for a zone that match the provided
+// `ZoneLockdownListParams`.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-list-zone-lockdown-rules
+func (s *ZoneLockdownsService) List(ctx context.Context, zoneID string, params ZoneLockdownListParams) ([]ZoneLockdown, ResultInfo, error) {
+	var lockdowns []ZoneLockdown
+	var info ResultInfo
+	err := s.ForEachPage(ctx, zoneID, params, func(page []ZoneLockdown, pageInfo ResultInfo) bool {
+		lockdowns = append(lockdowns, page...)
+		info = pageInfo
+		return true
+	})
+	if err != nil {
+		return []ZoneLockdown{}, ResultInfo{}, err
+	}
+
+	return lockdowns, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-list-zone-lockdown-rules
+func (s *ZoneLockdownsService) ForEachPage(ctx context.Context, zoneID string, params ZoneLockdownListParams, fn func(items []ZoneLockdown, info ResultInfo) bool) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	return s.client.listPages(ctx, "/zones/"+zoneID+"/firewall/lockdowns", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r ZoneLockdownsResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal zone lockdown JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
+}
+
+// Get fetches a single Zone Lockdown rule.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-get-a-zone-lockdown-rule
+func (s *ZoneLockdownsService) Get(ctx context.Context, zoneID, lockdownID string) (ZoneLockdown, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneLockdown{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if lockdownID == "" {
+		return ZoneLockdown{}, fmt.Errorf(errMissingResourceID, "zone lockdown")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/firewall/lockdowns/"+lockdownID, nil)
+	if err != nil {
+		return ZoneLockdown{}, err
+	}
+
+	var r ZoneLockdownResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneLockdown{}, fmt.Errorf("failed to unmarshal zone lockdown JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Create creates a new Zone Lockdown rule.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-create-a-zone-lockdown-rule
+func (s *ZoneLockdownsService) Create(ctx context.Context, zoneID string, params ZoneLockdownParams) (ZoneLockdown, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneLockdown{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(params.URLs) == 0 || len(params.Configurations) == 0 {
+		return ZoneLockdown{}, errors.New("at least one URL and configuration are required for a zone lockdown")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/firewall/lockdowns", params)
+	if err != nil {
+		return ZoneLockdown{}, err
+	}
+
+	var r ZoneLockdownResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneLockdown{}, fmt.Errorf("failed to unmarshal zone lockdown JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update replaces an existing Zone Lockdown rule.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-update-a-zone-lockdown-rule
+func (s *ZoneLockdownsService) Update(ctx context.Context, zoneID, lockdownID string, params ZoneLockdownParams) (ZoneLockdown, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneLockdown{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if lockdownID == "" {
+		return ZoneLockdown{}, fmt.Errorf(errMissingResourceID, "zone lockdown")
+	}
+
+	if len(params.URLs) == 0 || len(params.Configurations) == 0 {
+		return ZoneLockdown{}, errors.New("at least one URL and configuration are required for a zone lockdown")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/firewall/lockdowns/"+lockdownID, params)
+	if err != nil {
+		return ZoneLockdown{}, err
+	}
+
+	var r ZoneLockdownResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneLockdown{}, fmt.Errorf("failed to unmarshal zone lockdown JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Delete removes a Zone Lockdown rule.
+//
+// API reference: https://api.cloudflare.com/#zone-lockdown-delete-a-zone-lockdown-rule
+func (s *ZoneLockdownsService) Delete(ctx context.Context, zoneID, lockdownID string) error {
+	if !isValidZoneIdentifier(zoneID) {
+		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if lockdownID == "" {
+		return fmt.Errorf(errMissingResourceID, "zone lockdown")
+	}
+
+	_, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID+"/firewall/lockdowns/"+lockdownID, nil)
+	return err
+}
@@ -0,0 +1,378 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type ZoneSettingsService service
+
+// ZoneSettingID is the identifier of an individual zone setting.
+type ZoneSettingID string
+
+const (
+	ZoneSettingAdvancedDDoS            ZoneSettingID = "advanced_ddos"
+	ZoneSettingAlwaysOnline            ZoneSettingID = "always_online"
+	ZoneSettingAlwaysUseHTTPS          ZoneSettingID = "always_use_https"
+	ZoneSettingAutomaticHTTPSRewrites  ZoneSettingID = "automatic_https_rewrites"
+	ZoneSettingBrotli                  ZoneSettingID = "brotli"
+	ZoneSettingBrowserCacheTTL         ZoneSettingID = "browser_cache_ttl"
+	ZoneSettingBrowserCheck            ZoneSettingID = "browser_check"
+	ZoneSettingCacheLevel              ZoneSettingID = "cache_level"
+	ZoneSettingChallengeTTL            ZoneSettingID = "challenge_ttl"
+	ZoneSettingCiphers                 ZoneSettingID = "ciphers"
+	ZoneSettingDevelopmentMode         ZoneSettingID = "development_mode"
+	ZoneSettingEarlyHints              ZoneSettingID = "early_hints"
+	ZoneSettingEmailObfuscation        ZoneSettingID = "email_obfuscation"
+	ZoneSettingHotlinkProtection       ZoneSettingID = "hotlink_protection"
+	ZoneSettingHTTP2                   ZoneSettingID = "http2"
+	ZoneSettingHTTP3                   ZoneSettingID = "http3"
+	ZoneSettingIPGeolocation           ZoneSettingID = "ip_geolocation"
+	ZoneSettingIPv6                    ZoneSettingID = "ipv6"
+	ZoneSettingMaxUpload               ZoneSettingID = "max_upload"
+	ZoneSettingMinTLSVersion           ZoneSettingID = "min_tls_version"
+	ZoneSettingMinify                  ZoneSettingID = "minify"
+	ZoneSettingMirage                  ZoneSettingID = "mirage"
+	ZoneSettingMobileRedirect          ZoneSettingID = "mobile_redirect"
+	ZoneSettingOpportunisticEncryption ZoneSettingID = "opportunistic_encryption"
+	ZoneSettingOpportunisticOnion      ZoneSettingID = "opportunistic_onion"
+	ZoneSettingOriginErrorPagePassThru ZoneSettingID = "origin_error_page_pass_thru"
+	ZoneSettingPolish                  ZoneSettingID = "polish"
+	ZoneSettingPrefetchPreload         ZoneSettingID = "prefetch_preload"
+	ZoneSettingPseudoIPv4              ZoneSettingID = "pseudo_ipv4"
+	ZoneSettingRocketLoader            ZoneSettingID = "rocket_loader"
+	ZoneSettingSecurityHeader          ZoneSettingID = "security_header"
+	ZoneSettingSecurityLevel           ZoneSettingID = "security_level"
+	ZoneSettingServerSideExclude       ZoneSettingID = "server_side_exclude"
+	ZoneSettingSortQueryStringForCache ZoneSettingID = "sort_query_string_for_cache"
+	ZoneSettingSSL                     ZoneSettingID = "ssl"
+	ZoneSettingTLS13                   ZoneSettingID = "tls_1_3"
+	ZoneSettingTLSClientAuth           ZoneSettingID = "tls_client_auth"
+	ZoneSettingTrueClientIPHeader      ZoneSettingID = "true_client_ip_header"
+	ZoneSettingWAF                     ZoneSettingID = "waf"
+	ZoneSettingWebP                    ZoneSettingID = "webp"
+	ZoneSettingWebsockets              ZoneSettingID = "websockets"
+	ZoneSettingZeroRTT                 ZoneSettingID = "0rtt"
+)
+
+// ZoneSettingToggle is the value of settings that can only be switched on or
+// off.
+type ZoneSettingToggle string
+
+const (
+	ZoneSettingOn  ZoneSettingToggle = "on"
+	ZoneSettingOff ZoneSettingToggle = "off"
+)
+
+// ZoneSettingSSLValue is the value of the "ssl" setting.
+type ZoneSettingSSLValue string
+
+const (
+	ZoneSettingSSLOff      ZoneSettingSSLValue = "off"
+	ZoneSettingSSLFlexible ZoneSettingSSLValue = "flexible"
+	ZoneSettingSSLFull     ZoneSettingSSLValue = "full"
+	ZoneSettingSSLStrict   ZoneSettingSSLValue = "strict"
+)
+
+// ZoneSettingSecurityLevelValue is the value of the "security_level" setting.
+type ZoneSettingSecurityLevelValue string
+
+const (
+	ZoneSettingSecurityLevelOff         ZoneSettingSecurityLevelValue = "essentially_off"
+	ZoneSettingSecurityLevelLow         ZoneSettingSecurityLevelValue = "low"
+	ZoneSettingSecurityLevelMedium      ZoneSettingSecurityLevelValue = "medium"
+	ZoneSettingSecurityLevelHigh        ZoneSettingSecurityLevelValue = "high"
+	ZoneSettingSecurityLevelUnderAttack ZoneSettingSecurityLevelValue = "under_attack"
+)
+
+// ZoneSettingCacheLevelValue is the value of the "cache_level" setting.
+type ZoneSettingCacheLevelValue string
+
+const (
+	ZoneSettingCacheLevelBypass     ZoneSettingCacheLevelValue = "bypass"
+	ZoneSettingCacheLevelBasic      ZoneSettingCacheLevelValue = "basic"
+	ZoneSettingCacheLevelSimplified ZoneSettingCacheLevelValue = "simplified"
+	ZoneSettingCacheLevelAggressive ZoneSettingCacheLevelValue = "aggressive"
+)
+
+// ZoneSettingMinTLSVersionValue is the value of the "min_tls_version"
+// setting.
+type ZoneSettingMinTLSVersionValue string
+
+const (
+	ZoneSettingMinTLSVersion10 ZoneSettingMinTLSVersionValue = "1.0"
+	ZoneSettingMinTLSVersion11 ZoneSettingMinTLSVersionValue = "1.1"
+	ZoneSettingMinTLSVersion12 ZoneSettingMinTLSVersionValue = "1.2"
+	ZoneSettingMinTLSVersion13 ZoneSettingMinTLSVersionValue = "1.3"
+)
+
+// ZoneSetting contains a single zone setting and its value. Value holds a
+// ZoneSettingToggle, a number, a string or one of the structured setting
+// ZoneSetting*Value types depending on the setting.
+type ZoneSetting struct {
+	ID            ZoneSettingID `json:"id"`
+	Value         interface{}   `json:"value"`
+	Editable      bool          `json:"editable,omitempty"`
+	ModifiedOn    *time.Time    `json:"modified_on,omitempty"`
+	TimeRemaining int           `json:"time_remaining,omitempty"`
+}
+
+// ZoneSettingMinifyValue is the value of the "minify" setting.
+type ZoneSettingMinifyValue struct {
+	CSS  ZoneSettingToggle `json:"css"`
+	HTML ZoneSettingToggle `json:"html"`
+	JS   ZoneSettingToggle `json:"js"`
+}
+
+// ZoneSettingMobileRedirectValue is the value of the "mobile_redirect" setting.
+type ZoneSettingMobileRedirectValue struct {
+	Status          ZoneSettingToggle `json:"status"`
+	MobileSubdomain string            `json:"mobile_subdomain"`
+	StripURI        bool              `json:"strip_uri"`
+}
+
+// ZoneSettingStrictTransportSecurity contains the HSTS configuration of the
+// "security_header" setting.
+type ZoneSettingStrictTransportSecurity struct {
+	Enabled           bool `json:"enabled"`
+	MaxAge            int  `json:"max_age"`
+	IncludeSubdomains bool `json:"include_subdomains"`
+	Preload           bool `json:"preload"`
+	NoSniff           bool `json:"nosniff"`
+}
+
+// ZoneSettingSecurityHeaderValue is the value of the "security_header" setting.
+type ZoneSettingSecurityHeaderValue struct {
+	StrictTransportSecurity ZoneSettingStrictTransportSecurity `json:"strict_transport_security"`
+}
+
+// Toggle returns the value of an on/off setting. The second return value
+// reports whether the setting holds an on/off value.
+func (z ZoneSetting) Toggle() (ZoneSettingToggle, bool) {
+	v, ok := z.Value.(string)
+	if !ok || (v != string(ZoneSettingOn) && v != string(ZoneSettingOff)) {
+		return "", false
+	}
+	return ZoneSettingToggle(v), true
+}
+
+// Number returns the value of a numeric setting such as "browser_cache_ttl".
+// The second return value reports whether the setting holds a number.
+func (z ZoneSetting) Number() (int, bool) {
+	switch v := z.Value.(type) {
+	case float64:
+		return int(v), true
+	case int:
+		return v, true
+	}
+	return 0, false
+}
+
+// DecodeValue unmarshals a structured setting value, such as "minify" or
+// "security_header", into out.
+func (z ZoneSetting) DecodeValue(out interface{}) error {
+	b, err := json.Marshal(z.Value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal zone setting value: %w", err)
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("failed to unmarshal zone setting value: %w", err)
+	}
+
+	return nil
+}
+
+// ZoneSettingResponse represents the response from the zone settings endpoint
+// containing a single setting.
+type ZoneSettingResponse struct {
+	Response
+	Result ZoneSetting `json:"result"`
+}
+
+// ZoneSettingsResponse represents the response from the zone settings endpoint
+// containing multiple settings.
+type ZoneSettingsResponse struct {
+	Response
+	Result []ZoneSetting `json:"result"`
+}
+
+// zoneSettingValue is the payload used to update a single setting.
+type zoneSettingValue struct {
+	Value interface{} `json:"value"`
+}
+
+// zoneSettingsItems is the payload used to update multiple settings at once.
+type zoneSettingsItems struct {
+	Items []ZoneSetting `json:"items"`
+}
+
+// List returns every setting of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-all-zone-settings
+func (s *ZoneSettingsService) List(ctx context.Context, zoneID string) ([]ZoneSetting, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []ZoneSetting{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/settings", nil)
+	if err != nil {
+		return []ZoneSetting{}, err
+	}
+
+	var r ZoneSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ZoneSetting{}, fmt.Errorf("failed to unmarshal zone setting JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Get fetches a single zone setting.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-zone-setting
+func (s *ZoneSettingsService) Get(ctx context.Context, zoneID string, settingID ZoneSettingID) (ZoneSetting, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneSetting{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if settingID == "" {
+		return ZoneSetting{}, fmt.Errorf(errMissingResourceID, "setting")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/settings/"+string(settingID), nil)
+	if err != nil {
+		return ZoneSetting{}, err
+	}
+
+	var r ZoneSettingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneSetting{}, fmt.Errorf("failed to unmarshal zone setting JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// Update changes the value of a single zone setting. Prefer UpdateToggle,
+// UpdateSSL, UpdateSecurityLevel, UpdateCacheLevel and UpdateMinTLSVersion
+// where they apply as they only accept values of their setting. Values of
+// those settings' types are rejected when passed for another setting.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-edit-zone-setting
+func (s *ZoneSettingsService) Update(ctx context.Context, zoneID string, settingID ZoneSettingID, value interface{}) (ZoneSetting, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZoneSetting{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if settingID == "" {
+		return ZoneSetting{}, fmt.Errorf(errMissingResourceID, "setting")
+	}
+
+	if err := validateZoneSettingValue(settingID, value); err != nil {
+		return ZoneSetting{}, err
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/settings/"+string(settingID), zoneSettingValue{Value: value})
+	if err != nil {
+		return ZoneSetting{}, err
+	}
+
+	var r ZoneSettingResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneSetting{}, fmt.Errorf("failed to unmarshal zone setting JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateToggle switches an on/off setting such as "always_online".
+func (s *ZoneSettingsService) UpdateToggle(ctx context.Context, zoneID string, settingID ZoneSettingID, value ZoneSettingToggle) (ZoneSetting, error) {
+	return s.Update(ctx, zoneID, settingID, value)
+}
+
+// UpdateSSL changes the SSL mode of a zone.
+func (s *ZoneSettingsService) UpdateSSL(ctx context.Context, zoneID string, value ZoneSettingSSLValue) (ZoneSetting, error) {
+	return s.Update(ctx, zoneID, ZoneSettingSSL, value)
+}
+
+// UpdateSecurityLevel changes the security level of a zone.
+func (s *ZoneSettingsService) UpdateSecurityLevel(ctx context.Context, zoneID string, value ZoneSettingSecurityLevelValue) (ZoneSetting, error) {
+	return s.Update(ctx, zoneID, ZoneSettingSecurityLevel, value)
+}
+
+// UpdateCacheLevel changes the cache level of a zone.
+func (s *ZoneSettingsService) UpdateCacheLevel(ctx context.Context, zoneID string, value ZoneSettingCacheLevelValue) (ZoneSetting, error) {
+	return s.Update(ctx, zoneID, ZoneSettingCacheLevel, value)
+}
+
+// UpdateMinTLSVersion changes the minimum TLS version accepted by a zone.
+func (s *ZoneSettingsService) UpdateMinTLSVersion(ctx context.Context, zoneID string, value ZoneSettingMinTLSVersionValue) (ZoneSetting, error) {
+	return s.Update(ctx, zoneID, ZoneSettingMinTLSVersion, value)
+}
+
+// BatchUpdate changes the values of multiple zone settings in a single request.
+// Only the ID and Value of each setting are used. The updated settings are
+// returned.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-edit-zone-settings-info
+func (s *ZoneSettingsService) BatchUpdate(ctx context.Context, zoneID string, settings []ZoneSetting) ([]ZoneSetting, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []ZoneSetting{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if len(settings) == 0 {
+		return []ZoneSetting{}, errors.New("at least one setting is required for a batch update")
+	}
+
+	items := make([]ZoneSetting, 0, len(settings))
+	for _, setting := range settings {
+		if err := validateZoneSettingValue(setting.ID, setting.Value); err != nil {
+			return []ZoneSetting{}, err
+		}
+		items = append(items, ZoneSetting{ID: setting.ID, Value: setting.Value})
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPatch, "/zones/"+zoneID+"/settings", zoneSettingsItems{Items: items})
+	if err != nil {
+		return []ZoneSetting{}, err
+	}
+
+	var r ZoneSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ZoneSetting{}, fmt.Errorf("failed to unmarshal zone setting JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// validateZoneSettingValue rejects a value whose type belongs to a setting
+// other than settingID.
+func validateZoneSettingValue(settingID ZoneSettingID, value interface{}) error {
+	var want ZoneSettingID
+	switch value.(type) {
+	case ZoneSettingSSLValue:
+		want = ZoneSettingSSL
+	case ZoneSettingSecurityLevelValue:
+		want = ZoneSettingSecurityLevel
+	case ZoneSettingCacheLevelValue:
+		want = ZoneSettingCacheLevel
+	case ZoneSettingMinTLSVersionValue:
+		want = ZoneSettingMinTLSVersion
+	default:
+		return nil
+	}
+
+	if settingID != want {
+		return fmt.Errorf("%T is a value of the %s setting, not %s", value, want, settingID)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestZoneSettingsUpdateSSL(t *testing.T) {
+	client, mux := setup(t)
+
+	var body string
+	mux.HandleFunc("/client/v4/zones/023e105f4ecef8ad9ca31a8372d0c353/settings/ssl", func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		fmt.Fprint(w, `{"success": true, "result": {"id": "ssl", "value": "strict", "editable": true}}`)
+	})
+
+	setting, err := client.ZoneSettings.UpdateSSL(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", ZoneSettingSSLStrict)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := `{"value":"strict"}`; body != want {
+		t.Errorf("got body %s, want %s", body, want)
+	}
+	if setting.ID != ZoneSettingSSL || setting.Value != "strict" {
+		t.Errorf("got setting %+v", setting)
+	}
+}
+
+func TestZoneSettingsUpdate_RejectsOtherSettingsValues(t *testing.T) {
+	client, _ := setup(t)
+
+	_, err := client.ZoneSettings.Update(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", ZoneSettingSecurityLevel, ZoneSettingSSLStrict)
+	if err == nil || !strings.Contains(err.Error(), "is a value of the ssl setting") {
+		t.Errorf("got error %v, want a setting mismatch", err)
+	}
+
+	_, err = client.ZoneSettings.BatchUpdate(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", []ZoneSetting{
+		{ID: ZoneSettingCacheLevel, Value: ZoneSettingMinTLSVersion12},
+	})
+	if err == nil || !strings.Contains(err.Error(), "is a value of the min_tls_version setting") {
+		t.Errorf("got error %v, want a setting mismatch", err)
+	}
+}
@@ -0,0 +1,233 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// SubscriptionComponent is the quantity of an add-on component of a
+// subscription, such as "page_rules" or "dedicated_certificates".
+type SubscriptionComponent struct {
+	Name    string  `json:"name"`
+	Value   int     `json:"value"`
+	Default int     `json:"default,omitempty"`
+	Price   float64 `json:"price,omitempty"`
+}
+
+// SubscriptionRatePlan is the rate plan a subscription is billed under.
+type SubscriptionRatePlan struct {
+	ID                string   `json:"id"`
+	PublicName        string   `json:"public_name,omitempty"`
+	Currency          string   `json:"currency,omitempty"`
+	Scope             string   `json:"scope,omitempty"`
+	Sets              []string `json:"sets,omitempty"`
+	IsContract        bool     `json:"is_contract,omitempty"`
+	ExternallyManaged bool     `json:"externally_managed,omitempty"`
+}
+
+// SubscriptionZone identifies the zone a subscription applies to.
+type SubscriptionZone struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// Subscription is a paid subscription of a zone or account.
+type Subscription struct {
+	ID                 string                  `json:"id"`
+	State              string                  `json:"state"`
+	Price              float64                 `json:"price"`
+	Currency           string                  `json:"currency"`
+	Frequency          string                  `json:"frequency"`
+	RatePlan           SubscriptionRatePlan    `json:"rate_plan"`
+	ComponentValues    []SubscriptionComponent `json:"component_values"`
+	Zone               *SubscriptionZone       `json:"zone,omitempty"`
+	CurrentPeriodStart *time.Time              `json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   *time.Time              `json:"current_period_end,omitempty"`
+}
+
+// SubscriptionParams contains the plan and billing frequency of a new or
+// changed subscription. Frequency is one of "weekly", "monthly",
+// "quarterly" or "yearly".
+type SubscriptionParams struct {
+	RatePlan        *SubscriptionRatePlan   `json:"rate_plan,omitempty"`
+	Frequency       string                  `json:"frequency,omitempty"`
+	ComponentValues []SubscriptionComponent `json:"component_values,omitempty"`
+}
+
+// SubscriptionResponse represents the response from the subscription
+// endpoints containing a single subscription.
+type SubscriptionResponse struct {
+	Response
+	Result Subscription `json:"result"`
+}
+
+// ZonePlanResponse represents the response from the available plans endpoint
+// containing a single plan.
+type ZonePlanResponse struct {
+	Response
+	Result ZonePlan `json:"result"`
+}
+
+// ZonePlansResponse represents the response from the available plans
+// endpoint containing multiple plans.
+type ZonePlansResponse struct {
+	Response
+	Result []ZonePlan `json:"result"`
+}
+
+// ZoneRatePlansResponse represents the response from the available rate
+// plans endpoint.
+type ZoneRatePlansResponse struct {
+	Response
+	Result []ZoneRatePlan `json:"result"`
+}
+
+// AvailablePlans returns the plans a zone can subscribe to.
+//
+// API reference: https://api.cloudflare.com/#zone-plan-available-plan-details
+func (s *ZonesService) AvailablePlans(ctx context.Context, zoneID string) ([]ZonePlan, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []ZonePlan{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/available_plans", nil)
+	if err != nil {
+		return []ZonePlan{}, err
+	}
+
+	var r ZonePlansResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ZonePlan{}, fmt.Errorf("failed to unmarshal zone plan JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetAvailablePlan fetches a single plan a zone can subscribe to.
+//
+// API reference: https://api.cloudflare.com/#zone-plan-available-plan-details
+func (s *ZonesService) GetAvailablePlan(ctx context.Context, zoneID, planID string) (ZonePlan, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return ZonePlan{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if planID == "" {
+		return ZonePlan{}, fmt.Errorf(errMissingResourceID, "zone plan")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/available_plans/"+planID, nil)
+	if err != nil {
+		return ZonePlan{}, err
+	}
+
+	var r ZonePlanResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZonePlan{}, fmt.Errorf("failed to unmarshal zone plan JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// AvailableRatePlans returns the rate plans a zone can subscribe to,
+// including their add-on components.
+//
+// API reference: https://api.cloudflare.com/#zone-rate-plan-list-available-rate-plans
+func (s *ZonesService) AvailableRatePlans(ctx context.Context, zoneID string) ([]ZoneRatePlan, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return []ZoneRatePlan{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/available_rate_plans", nil)
+	if err != nil {
+		return []ZoneRatePlan{}, err
+	}
+
+	var r ZoneRatePlansResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ZoneRatePlan{}, fmt.Errorf("failed to unmarshal zone rate plan JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// GetSubscription returns the subscription of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-zone-subscription-details
+func (s *ZonesService) GetSubscription(ctx context.Context, zoneID string) (Subscription, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Subscription{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID+"/subscription", nil)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("failed to unmarshal zone subscription JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// CreateSubscription subscribes a zone to a plan.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-create-zone-subscription
+func (s *ZonesService) CreateSubscription(ctx context.Context, zoneID string, params SubscriptionParams) (Subscription, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Subscription{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.RatePlan == nil || params.RatePlan.ID == "" {
+		return Subscription{}, errors.New("rate plan ID must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPost, "/zones/"+zoneID+"/subscription", params)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("failed to unmarshal zone subscription JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
+
+// UpdateSubscription changes the plan, frequency or components of the
+// subscription of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-update-zone-subscription
+func (s *ZonesService) UpdateSubscription(ctx context.Context, zoneID string, params SubscriptionParams) (Subscription, error) {
+	if !isValidZoneIdentifier(zoneID) {
+		return Subscription{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
+	}
+
+	if params.RatePlan == nil || params.RatePlan.ID == "" {
+		return Subscription{}, errors.New("rate plan ID must be provided")
+	}
+
+	res, err := s.client.Call(ctx, http.MethodPut, "/zones/"+zoneID+"/subscription", params)
+	if err != nil {
+		return Subscription{}, err
+	}
+
+	var r SubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Subscription{}, fmt.Errorf("failed to unmarshal zone subscription JSON data: %w", err)
+	}
+
+	return r.Result, nil
+}
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"net/http"
 	"time"
-
-	"github.com/google/go-querystring/query"
 )
 
 type ZonesService service
@@ -106,30 +104,19 @@ type ZoneResponse struct {
 // ZonesResponse represents the response from the Zone endpoint containing multiple zones.
 type ZonesResponse struct {
 	Response
-	Result []Zone `json:"result"`
+	Result     []Zone     `json:"result"`
+	ResultInfo ResultInfo `json:"result_info"`
 }
 
+// ZoneParams contains the filters available when listing zones.
 type ZoneParams struct {
 	Match       string `url:"match,omitempty"`
 	Name        string `url:"name,omitempty"`
 	AccountName string `url:"account.name,omitempty"`
 	Status      string `url:"status,omitempty"`
 	AccountID   string `url:"account.id,omitempty"`
-	Direction   string `url:"direction,omitempty"`
-
-	// ResultInfo
-}
-
-type Account struct {
-	ID       string           `json:"id,omitempty"`
-	Name     string           `json:"name,omitempty"`
-	Type     string           `json:"type,omitempty"`
-	Settings *AccountSettings `json:"settings,omitempty"`
-}
 
-// AccountSettings outlines the available options for an account.
-type AccountSettings struct {
-	EnforceTwoFactor bool `json:"enforce_twofactor"`
+	PaginationParams
 }
 
 // Get fetches a single zone.
@@ -140,10 +127,13 @@ func (s *ZonesService) Get(ctx context.Context, zoneID string) (Zone, error) {
 		return Zone{}, fmt.Errorf(errInvalidZoneIdentifer, zoneID)
 	}
 
-	res, _ := s.client.Call(context.Background(), http.MethodGet, "/zones/"+zoneID, nil)
+	res, err := s.client.Call(ctx, http.MethodGet, "/zones/"+zoneID, nil)
+	if err != nil {
+		return Zone{}, err
+	}
 
 	var r ZoneResponse
-	err := json.Unmarshal(res, &r)
+	err = json.Unmarshal(res, &r)
 	if err != nil {
 		return Zone{}, fmt.Errorf("failed to unmarshal zone JSON data: %w", err)
 	}
@@ -151,25 +141,41 @@ func (s *ZonesService) Get(ctx context.Context, zoneID string) (Zone, error) {
 	return r.Result, nil
 }
 
-// List returns all zones that match the provided `ZoneParams` struct.
+// List returns all zones that match the provided `ZoneParams` struct. Every
+// page is fetched unless params.Page is set.
 //
 // API reference: https://api.cloudflare.com/#zone-list-zones
-func (s *ZonesService) List(ctx context.Context, params ZoneParams) ([]Zone, error) {
-	v, _ := query.Values(params)
-	queryParams := v.Encode()
-	if queryParams != "" {
-		queryParams = "?" + queryParams
-	}
-
-	res, _ := s.client.Call(context.Background(), http.MethodGet, "/zones"+queryParams, nil)
-
-	var r ZonesResponse
-	err := json.Unmarshal(res, &r)
+func (s *ZonesService) List(ctx context.Context, params ZoneParams) ([]Zone, ResultInfo, error) {
+	var zones []Zone
+	var info ResultInfo
+	err := s.ForEachPage(ctx, params, func(page []Zone, pageInfo ResultInfo) bool {
+		zones = append(zones, page...)
+		info = pageInfo
+		return true
+	})
 	if err != nil {
-		return []Zone{}, fmt.Errorf("failed to unmarshal zone JSON data: %w", err)
+		return []Zone{}, ResultInfo{}, err
 	}
 
-	return r.Result, nil
+	return zones, info, nil
+}
+
+// ForEachPage is like List but passes each page of results to fn as it is
+// fetched instead of collecting them. Paging stops early when fn returns
+// false.
+//
+// API reference: https://api.cloudflare.com/#zone-list-zones
+func (s *ZonesService) ForEachPage(ctx context.Context, params ZoneParams, fn func(items []Zone, info ResultInfo) bool) error {
+	return s.client.listPages(ctx, "/zones", &params, &params.PaginationParams, func(res []byte) (ResultInfo, error) {
+		var r ZonesResponse
+		if err := json.Unmarshal(res, &r); err != nil {
+			return ResultInfo{}, fmt.Errorf("failed to unmarshal zone JSON data: %w", err)
+		}
+		if !fn(r.Result, r.ResultInfo) {
+			return ResultInfo{}, errStopPagination
+		}
+		return r.ResultInfo, nil
+	})
 }
 
 // Delete deletes a zone based on ID.
@@ -180,10 +186,13 @@ func (s *ZonesService) Delete(ctx context.Context, zoneID string) error {
 		return fmt.Errorf(errInvalidZoneIdentifer, zoneID)
 	}
 
-	res, _ := s.client.Call(context.Background(), http.MethodDelete, "/zones/"+zoneID, nil)
+	res, err := s.client.Call(ctx, http.MethodDelete, "/zones/"+zoneID, nil)
+	if err != nil {
+		return err
+	}
 
 	var r ZoneResponse
-	err := json.Unmarshal(res, &r)
+	err = json.Unmarshal(res, &r)
 	if err != nil {
 		return fmt.Errorf("failed to unmarshal zone JSON data: %w", err)
 	}